@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -29,11 +30,16 @@ func init() {
 	}
 }
 
+// ChatCompletionRequestBody returns the JSON body that is sent to the model provider for the chat completion request.
+func ChatCompletionRequestBody(cc *db.CreateChatCompletionRequest) ([]byte, error) {
+	return json.Marshal(cc.ToPublic())
+}
+
 func StreamChatCompletionRequest(ctx context.Context, l *slog.Logger, client *http.Client, url, apiKey string, cc *db.CreateChatCompletionRequest) (<-chan db.ChatCompletionResponseChunk, error) {
 	// Ensure that streaming is enabled.
 	cc.Stream = z.Pointer(true)
 
-	b, err := json.Marshal(cc.ToPublic())
+	b, err := ChatCompletionRequestBody(cc)
 	if err != nil {
 		return nil, err
 	}
@@ -61,13 +67,30 @@ func StreamChatCompletionRequest(ctx context.Context, l *slog.Logger, client *ht
 	return streamResponses(ctx, resp), nil
 }
 
+// StreamChatCompletionRequestWithCancel is like StreamChatCompletionRequest, but it also returns a function that cancels
+// the stream. Cancelling aborts the request to the model provider, and the stream is closed without an error once the
+// chunks that were already received are read. The stream must be cancelled, or read until it is closed, to release its
+// resources.
+func StreamChatCompletionRequestWithCancel(ctx context.Context, l *slog.Logger, client *http.Client, url, apiKey string, cc *db.CreateChatCompletionRequest) (<-chan db.ChatCompletionResponseChunk, context.CancelFunc, error) {
+	ctx, cancelCause := context.WithCancelCause(ctx)
+	cancel := func() { cancelCause(errStreamCancelled) }
+
+	stream, err := StreamChatCompletionRequest(ctx, l, client, url, apiKey, cc)
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+
+	return stream, cancel, nil
+}
+
 func MakeChatCompletionRequest(ctx context.Context, l *slog.Logger, client *http.Client, url, apiKey string, cc *db.CreateChatCompletionRequest) (*db.CreateChatCompletionResponse, error) {
 	if z.Dereference(cc.Stream) {
 		l.Warn("Non-streaming chat completion call with streaming enabled, disabling streaming")
 		cc.Stream = nil
 	}
 
-	b, err := json.Marshal(cc.ToPublic())
+	b, err := ChatCompletionRequestBody(cc)
 	if err != nil {
 		return nil, err
 	}
@@ -84,10 +107,21 @@ func MakeChatCompletionRequest(ctx context.Context, l *slog.Logger, client *http
 		req.Header.Set("Authorization", "Bearer "+apiKey)
 	}
 
-	resp := new(openai.CreateChatCompletionResponse)
+	var (
+		body []byte
+		resp = new(openai.CreateChatCompletionResponse)
+	)
 
 	// Wait to process this error until after we have the DB object.
-	code, err := cclient.SendRequest(client, req, resp)
+	code, err := cclient.SendRequest(client, req, &body)
+	if err == nil {
+		if err = json.Unmarshal(body, resp); err != nil {
+			code = http.StatusInternalServerError
+		}
+	} else if code >= http.StatusBadRequest {
+		// The error is the body of the error response.
+		body = []byte(err.Error())
+	}
 
 	ccr := new(db.CreateChatCompletionResponse)
 	// err here should be shadowed.
@@ -101,6 +135,10 @@ func MakeChatCompletionRequest(ctx context.Context, l *slog.Logger, client *http
 		ccr.Error = z.Pointer(err.Error())
 	}
 
+	if len(body) > 0 {
+		ccr.RawResponse = z.Pointer(string(body))
+	}
+
 	ccr.StatusCode = code
 	ccr.RequestID = cc.ID
 	ccr.Done = true
@@ -108,6 +146,9 @@ func MakeChatCompletionRequest(ctx context.Context, l *slog.Logger, client *http
 	return ccr, nil
 }
 
+// errStreamCancelled is the cause of the cancellation of a stream returned by StreamChatCompletionRequestWithCancel.
+var errStreamCancelled = errors.New("stream was cancelled")
+
 func streamResponses(ctx context.Context, response *http.Response) <-chan db.ChatCompletionResponseChunk {
 	var (
 		emptyMessagesCount int
@@ -125,16 +166,40 @@ func streamResponses(ctx context.Context, response *http.Response) <-chan db.Cha
 		for {
 			rawLine, readErr := reader.ReadBytes('\n')
 			if readErr != nil {
-				sendChunk(ctx, stream, db.ChatCompletionResponseChunk{
+				if errors.Is(context.Cause(ctx), errStreamCancelled) {
+					// The stream was cancelled by its reader, so the failed read is not an error.
+					return
+				}
+				if ctx.Err() == nil {
+					sendChunk(ctx, stream, db.ChatCompletionResponseChunk{
+						JobResponse: db.JobResponse{
+							StatusCode: http.StatusInternalServerError,
+							Error:      z.Pointer(readErr.Error()),
+						},
+					})
+					return
+				}
+
+				// The request was aborted, for example because it timed out or the client cancelled it. The error is
+				// still sent if there is room for it, so that the truncated stream isn't mistaken for a complete one.
+				select {
+				case stream <- db.ChatCompletionResponseChunk{
 					JobResponse: db.JobResponse{
 						StatusCode: http.StatusInternalServerError,
-						Error:      z.Pointer(readErr.Error()),
+						Error:      z.Pointer(context.Cause(ctx).Error()),
 					},
-				})
+				}:
+				default:
+				}
 				return
 			}
 
 			rawLine = bytes.TrimSpace(rawLine)
+			if bytes.HasPrefix(rawLine, []byte(":")) {
+				// Comments, such as keepalives, are not part of the stream.
+				continue
+			}
+
 			hasDataPrefix := bytes.HasPrefix(rawLine, []byte("data: "))
 			noPrefixLine := bytes.TrimSpace(bytes.TrimPrefix(rawLine, []byte("data: ")))
 
@@ -155,8 +220,24 @@ func streamResponses(ctx context.Context, response *http.Response) <-chan db.Cha
 
 					var ccr db.ChatCompletionResponseChunk
 					if err = json.Unmarshal(errBuf.Bytes(), &ccr); err == nil {
+						if ccr.StatusCode == 0 {
+							ccr.StatusCode = response.StatusCode
+						}
 						sendChunk(ctx, stream, ccr)
 						return
+					} else if json.Valid(errBuf.Bytes()) {
+						// The error is an object, like the errors from OpenAI, so pass it through as the error message.
+						statusCode := response.StatusCode
+						if statusCode < http.StatusBadRequest {
+							statusCode = http.StatusInternalServerError
+						}
+						sendChunk(ctx, stream, db.ChatCompletionResponseChunk{
+							JobResponse: db.JobResponse{
+								StatusCode: statusCode,
+								Error:      z.Pointer(errBuf.String()),
+							},
+						})
+						return
 					}
 					// If we can't unmarshal the error yet, then we haven't received it all. Continue until we get the whole error.
 				}
@@ -0,0 +1,121 @@
+package agents
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/acorn-io/z"
+	"github.com/gptscript-ai/clicky-chats/pkg/db"
+)
+
+func TestChatCompletionResponsesWithUnknownFields(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.Header.Get("Accept"), "text/event-stream") {
+			w.Header().Set("Content-Type", "text/event-stream")
+			_, _ = w.Write([]byte(`data: {"id": "chatcmpl-test", "object": "chat.completion.chunk", "created": 1, "model": "gpt-4", "service_tier": "default", "choices": [{"index": 0, "delta": {"role": "assistant", "content": "Hello", "reasoning": "none"}, "finish_reason": null, "content_filter_results": {}}]}` + "\n\n"))
+			_, _ = w.Write([]byte("data: [DONE]\n\n"))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"id": "chatcmpl-test",
+			"object": "chat.completion",
+			"created": 1,
+			"model": "gpt-4",
+			"service_tier": "default",
+			"choices": [{"index": 0, "finish_reason": "stop", "message": {"role": "assistant", "content": "Hello", "annotations": []}, "content_filter_results": {}}],
+			"usage": {"prompt_tokens": 5, "completion_tokens": 1, "total_tokens": 6, "prompt_tokens_details": {"cached_tokens": 0}}
+		}`))
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+
+	resp, err := MakeChatCompletionRequest(ctx, slog.Default(), srv.Client(), srv.URL, "", &db.CreateChatCompletionRequest{Model: "gpt-4"})
+	if err != nil {
+		t.Fatalf("MakeChatCompletionRequest() error = %v", err)
+	}
+	if resp.GetErrorString() != "" {
+		t.Fatalf("response has error %q", resp.GetErrorString())
+	}
+	if resp.Model != "gpt-4" || len(resp.Choices) != 1 || z.Dereference(resp.Choices[0].Message.Data().Content) != "Hello" {
+		t.Errorf("response = %+v, want the known fields to be populated", resp)
+	}
+	if usage := resp.Usage.Data(); usage == nil || usage.TotalTokens != 6 {
+		t.Errorf("usage = %+v, want 6 total tokens", usage)
+	}
+	// The unknown fields are not lost, they are kept in the raw response.
+	if !strings.Contains(z.Dereference(resp.RawResponse), `"service_tier": "default"`) {
+		t.Errorf("raw response = %q, want it to have the unknown fields", z.Dereference(resp.RawResponse))
+	}
+
+	chunks, err := StreamChatCompletionRequest(ctx, slog.Default(), srv.Client(), srv.URL, "", &db.CreateChatCompletionRequest{Model: "gpt-4"})
+	if err != nil {
+		t.Fatalf("StreamChatCompletionRequest() error = %v", err)
+	}
+
+	var content string
+	for chunk := range chunks {
+		if chunk.GetErrorString() != "" {
+			t.Fatalf("chunk has error %q", chunk.GetErrorString())
+		}
+		for _, choice := range chunk.Choices {
+			content += z.Dereference(choice.Delta.Data().Content)
+		}
+	}
+	if content != "Hello" {
+		t.Errorf("streamed content = %q, want %q", content, "Hello")
+	}
+}
+
+func TestCancelStreamChatCompletionRequest(t *testing.T) {
+	aborted := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = w.Write([]byte(`data: {"id": "chatcmpl-test", "object": "chat.completion.chunk", "created": 1, "model": "gpt-4", "choices": [{"index": 0, "delta": {"role": "assistant", "content": "Hello"}, "finish_reason": null}]}` + "\n\n"))
+		w.(http.Flusher).Flush()
+
+		// The stream stalls until the request is aborted.
+		<-r.Context().Done()
+		close(aborted)
+	}))
+	defer srv.Close()
+
+	chunks, cancel, err := StreamChatCompletionRequestWithCancel(context.Background(), slog.Default(), srv.Client(), srv.URL, "", &db.CreateChatCompletionRequest{Model: "gpt-4"})
+	if err != nil {
+		t.Fatalf("StreamChatCompletionRequestWithCancel() error = %v", err)
+	}
+
+	if chunk := <-chunks; chunk.GetErrorString() != "" || len(chunk.Choices) != 1 {
+		t.Fatalf("first chunk = %+v, want the streamed content", chunk)
+	}
+
+	cancel()
+
+	select {
+	case <-aborted:
+	case <-time.After(5 * time.Second):
+		t.Fatal("request to the model provider was not aborted")
+	}
+
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case chunk, ok := <-chunks:
+			if !ok {
+				return
+			}
+			if chunk.GetErrorString() != "" {
+				t.Errorf("chunk after cancelling has error %q", chunk.GetErrorString())
+			}
+		case <-timeout:
+			t.Fatal("stream was not closed")
+		}
+	}
+}
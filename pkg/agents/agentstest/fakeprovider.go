@@ -0,0 +1,254 @@
+// Package agentstest provides utilities for testing code that uses the agents without a real model provider.
+package agentstest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/acorn-io/z"
+	"github.com/gptscript-ai/clicky-chats/pkg/db"
+	"github.com/gptscript-ai/clicky-chats/pkg/generated/openai"
+)
+
+// Response is a scripted response returned by a FakeProvider.
+type Response struct {
+	// Content is the content of the assistant message.
+	Content string
+	// ToolCalls are the tool calls made by the assistant.
+	ToolCalls []openai.ChatCompletionMessageToolCall
+	// FinishReason defaults to "tool_calls" if there are tool calls, and "stop" otherwise.
+	FinishReason string
+
+	// StatusCode and Error, if set, cause the provider to return an error response instead of a completion.
+	StatusCode int
+	Error      string
+
+	// Latency is how long the provider waits before responding.
+	Latency time.Duration
+}
+
+// Responder returns the scripted response for a chat completion request.
+type Responder func(*openai.CreateChatCompletionRequest) Response
+
+// Sequence returns a Responder that returns the given responses in order, one per request.
+// Once the responses are exhausted, an error response is returned.
+func Sequence(responses ...Response) Responder {
+	var (
+		lock  sync.Mutex
+		calls int
+	)
+	return func(*openai.CreateChatCompletionRequest) Response {
+		lock.Lock()
+		defer lock.Unlock()
+
+		if calls >= len(responses) {
+			return Response{
+				StatusCode: http.StatusInternalServerError,
+				Error:      fmt.Sprintf("no scripted response for request %d", calls+1),
+			}
+		}
+
+		calls++
+		return responses[calls-1]
+	}
+}
+
+// FakeProvider is an OpenAI compatible chat completion server that returns scripted responses.
+// Both regular and streaming chat completion requests are supported.
+type FakeProvider struct {
+	server    *httptest.Server
+	responder Responder
+
+	lock     sync.Mutex
+	requests []*openai.CreateChatCompletionRequest
+}
+
+// NewFakeProvider starts a FakeProvider that uses the responder to respond to requests. The provider should be
+// closed when it is no longer needed.
+func NewFakeProvider(responder Responder) *FakeProvider {
+	f := &FakeProvider{
+		responder: responder,
+	}
+	f.server = httptest.NewServer(http.HandlerFunc(f.handle))
+
+	return f
+}
+
+// URL returns the chat completion URL of the provider.
+func (f *FakeProvider) URL() string {
+	return f.server.URL + "/v1/chat/completions"
+}
+
+// Requests returns the chat completion requests the provider has received, in order.
+func (f *FakeProvider) Requests() []*openai.CreateChatCompletionRequest {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return append([]*openai.CreateChatCompletionRequest(nil), f.requests...)
+}
+
+// Close shuts down the provider.
+func (f *FakeProvider) Close() {
+	f.server.Close()
+}
+
+func (f *FakeProvider) handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.URL.Path != "/v1/chat/completions" {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("unknown endpoint %s %s", r.Method, r.URL.Path))
+		return
+	}
+
+	req := new(openai.CreateChatCompletionRequest)
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("failed to decode request: %v", err))
+		return
+	}
+
+	f.lock.Lock()
+	f.requests = append(f.requests, req)
+	id := fmt.Sprintf("chatcmpl-fake-%d", len(f.requests))
+	f.lock.Unlock()
+
+	resp := f.responder(req)
+	if resp.Latency > 0 {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-time.After(resp.Latency):
+		}
+	}
+
+	if resp.Error != "" || resp.StatusCode >= http.StatusBadRequest {
+		statusCode := resp.StatusCode
+		if statusCode < http.StatusBadRequest {
+			statusCode = http.StatusInternalServerError
+		}
+		writeError(w, statusCode, resp.Error)
+		return
+	}
+
+	model, _ := db.CreateChatCompletionModelFromPublic(req.Model)
+	if z.Dereference(req.Stream) {
+		writeStream(w, id, model, resp)
+		return
+	}
+
+	message := openai.ChatCompletionResponseMessage{
+		Role: openai.ChatCompletionResponseMessageRoleAssistant,
+	}
+	if resp.Content != "" {
+		message.Content = z.Pointer(resp.Content)
+	}
+	if len(resp.ToolCalls) > 0 {
+		message.ToolCalls = z.Pointer[openai.ChatCompletionMessageToolCalls](resp.ToolCalls)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"id":      id,
+		"object":  "chat.completion",
+		"created": time.Now().Unix(),
+		"model":   model,
+		"choices": []map[string]any{
+			{
+				"index":         0,
+				"message":       message,
+				"finish_reason": finishReason(resp),
+			},
+		},
+	})
+}
+
+// writeStream writes the response as a stream of deltas: the content is sent one word at a time, and each tool call is
+// sent as a delta with its name followed by a delta with its arguments.
+func writeStream(w http.ResponseWriter, id, model string, resp Response) {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	created := time.Now().Unix()
+	writeChunk := func(delta openai.ChatCompletionStreamResponseDelta, finishReason *string) {
+		b, _ := json.Marshal(map[string]any{
+			"id":      id,
+			"object":  "chat.completion.chunk",
+			"created": created,
+			"model":   model,
+			"choices": []map[string]any{
+				{
+					"index":         0,
+					"delta":         delta,
+					"finish_reason": finishReason,
+				},
+			},
+		})
+		_, _ = fmt.Fprintf(w, "data: %s\n\n", b)
+		if flusher, ok := w.(http.Flusher); ok {
+			flusher.Flush()
+		}
+	}
+
+	writeChunk(openai.ChatCompletionStreamResponseDelta{
+		Role: z.Pointer(openai.ChatCompletionStreamResponseDeltaRoleAssistant),
+	}, nil)
+
+	for _, word := range strings.SplitAfter(resp.Content, " ") {
+		if word != "" {
+			writeChunk(openai.ChatCompletionStreamResponseDelta{Content: z.Pointer(word)}, nil)
+		}
+	}
+
+	for i, toolCall := range resp.ToolCalls {
+		nameChunk := openai.ChatCompletionMessageToolCallChunk{
+			Id:    z.Pointer(toolCall.Id),
+			Index: i,
+			Type:  z.Pointer(openai.ChatCompletionMessageToolCallChunkTypeFunction),
+		}
+		nameChunk.Function = &struct {
+			Arguments *string `json:"arguments,omitempty"`
+			Name      *string `json:"name,omitempty"`
+		}{
+			Name: z.Pointer(toolCall.Function.Name),
+		}
+		writeChunk(openai.ChatCompletionStreamResponseDelta{ToolCalls: &[]openai.ChatCompletionMessageToolCallChunk{nameChunk}}, nil)
+
+		argumentsChunk := openai.ChatCompletionMessageToolCallChunk{
+			Index: i,
+		}
+		argumentsChunk.Function = &struct {
+			Arguments *string `json:"arguments,omitempty"`
+			Name      *string `json:"name,omitempty"`
+		}{
+			Arguments: z.Pointer(toolCall.Function.Arguments),
+		}
+		writeChunk(openai.ChatCompletionStreamResponseDelta{ToolCalls: &[]openai.ChatCompletionMessageToolCallChunk{argumentsChunk}}, nil)
+	}
+
+	writeChunk(openai.ChatCompletionStreamResponseDelta{}, z.Pointer(finishReason(resp)))
+	_, _ = fmt.Fprint(w, "data: [DONE]\n\n")
+}
+
+func finishReason(resp Response) string {
+	if resp.FinishReason != "" {
+		return resp.FinishReason
+	}
+	if len(resp.ToolCalls) > 0 {
+		return string(openai.CreateChatCompletionResponseChoicesFinishReasonToolCalls)
+	}
+	return string(openai.CreateChatCompletionResponseChoicesFinishReasonStop)
+}
+
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"error": map[string]any{
+			"message": message,
+			"type":    "fake_provider_error",
+		},
+	})
+}
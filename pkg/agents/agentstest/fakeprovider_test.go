@@ -0,0 +1,137 @@
+package agentstest
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/acorn-io/z"
+	"github.com/gptscript-ai/clicky-chats/pkg/agents"
+	"github.com/gptscript-ai/clicky-chats/pkg/db"
+	"github.com/gptscript-ai/clicky-chats/pkg/generated/openai"
+)
+
+func newRequest(t *testing.T, stream bool, messages ...string) *db.CreateChatCompletionRequest {
+	t.Helper()
+
+	publicRequest := new(openai.CreateChatCompletionRequest)
+	if err := json.Unmarshal([]byte(`{
+		"model": "gpt-4",
+		"stream": `+strconv.FormatBool(stream)+`,
+		"messages": [`+strings.Join(messages, ",")+`],
+		"tools": [{"type": "function", "function": {"name": "get_weather", "parameters": {"type": "object"}}}]
+	}`), publicRequest); err != nil {
+		t.Fatalf("failed to unmarshal request: %v", err)
+	}
+
+	cc := new(db.CreateChatCompletionRequest)
+	if err := cc.FromPublic(publicRequest); err != nil {
+		t.Fatalf("FromPublic() error = %v", err)
+	}
+
+	return cc
+}
+
+func TestToolCallingConversation(t *testing.T) {
+	toolCall := openai.ChatCompletionMessageToolCall{
+		Id:   "call_1",
+		Type: openai.ChatCompletionMessageToolCallTypeFunction,
+	}
+	toolCall.Function.Name = "get_weather"
+	toolCall.Function.Arguments = `{"city":"Paris"}`
+
+	provider := NewFakeProvider(Sequence(
+		Response{ToolCalls: []openai.ChatCompletionMessageToolCall{toolCall}},
+		Response{Content: "It is sunny in Paris."},
+	))
+	defer provider.Close()
+
+	ctx, l := context.Background(), slog.Default()
+	userMessage := `{"role": "user", "content": "What is the weather in Paris?"}`
+
+	// The first turn should result in a tool call.
+	ccr, err := agents.MakeChatCompletionRequest(ctx, l, http.DefaultClient, provider.URL(), "", newRequest(t, false, userMessage))
+	if err != nil {
+		t.Fatalf("MakeChatCompletionRequest() error = %v", err)
+	}
+	if ccr.Error != nil {
+		t.Fatalf("unexpected error response: %s", *ccr.Error)
+	}
+
+	response, ok := ccr.ToPublic().(*openai.CreateChatCompletionResponse)
+	if !ok || len(response.Choices) != 1 {
+		t.Fatalf("unexpected response: %#v", ccr.ToPublic())
+	}
+	if got := response.Choices[0].FinishReason; got != openai.CreateChatCompletionResponseChoicesFinishReasonToolCalls {
+		t.Errorf("finish reason = %q, want %q", got, openai.CreateChatCompletionResponseChoicesFinishReasonToolCalls)
+	}
+	toolCalls := z.Dereference(response.Choices[0].Message.ToolCalls)
+	if len(toolCalls) != 1 || toolCalls[0].Function.Name != "get_weather" || toolCalls[0].Function.Arguments != `{"city":"Paris"}` {
+		t.Fatalf("unexpected tool calls: %#v", toolCalls)
+	}
+
+	// The second turn sends the tool result back, streaming the final answer.
+	stream, err := agents.StreamChatCompletionRequest(ctx, l, http.DefaultClient, provider.URL(), "", newRequest(t, true,
+		userMessage,
+		`{"role": "assistant", "tool_calls": [{"id": "call_1", "type": "function", "function": {"name": "get_weather", "arguments": "{\"city\":\"Paris\"}"}}]}`,
+		`{"role": "tool", "tool_call_id": "call_1", "content": "sunny"}`,
+	))
+	if err != nil {
+		t.Fatalf("StreamChatCompletionRequest() error = %v", err)
+	}
+
+	var (
+		content      strings.Builder
+		finishReason string
+	)
+	for chunk := range stream {
+		if chunk.Error != nil {
+			t.Fatalf("unexpected error in stream: %s", *chunk.Error)
+		}
+		for _, choice := range chunk.Choices {
+			content.WriteString(z.Dereference(choice.Delta.Data().Content))
+			if choice.FinishReason != "" {
+				finishReason = choice.FinishReason
+			}
+		}
+	}
+
+	if got := content.String(); got != "It is sunny in Paris." {
+		t.Errorf("streamed content = %q, want %q", got, "It is sunny in Paris.")
+	}
+	if finishReason != "stop" {
+		t.Errorf("finish reason = %q, want %q", finishReason, "stop")
+	}
+
+	if requests := provider.Requests(); len(requests) != 2 || len(requests[1].Messages) != 3 {
+		t.Errorf("provider did not receive the expected requests: %#v", requests)
+	}
+}
+
+func TestInjectedErrorsAndLatency(t *testing.T) {
+	provider := NewFakeProvider(Sequence(
+		Response{StatusCode: http.StatusTooManyRequests, Error: "rate limited", Latency: 50 * time.Millisecond},
+	))
+	defer provider.Close()
+
+	start := time.Now()
+	ccr, err := agents.MakeChatCompletionRequest(context.Background(), slog.Default(), http.DefaultClient, provider.URL(), "", newRequest(t, false, `{"role": "user", "content": "Hello"}`))
+	if err != nil {
+		t.Fatalf("MakeChatCompletionRequest() error = %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("response returned after %s, want at least 50ms", elapsed)
+	}
+	if ccr.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("status code = %d, want %d", ccr.StatusCode, http.StatusTooManyRequests)
+	}
+	if !strings.Contains(z.Dereference(ccr.Error), "rate limited") {
+		t.Errorf("error = %q, want it to contain %q", z.Dereference(ccr.Error), "rate limited")
+	}
+}
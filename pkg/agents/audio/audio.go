@@ -37,12 +37,17 @@ type Config struct {
 	Logger                           *slog.Logger
 	PollingInterval, RetentionPeriod time.Duration
 	AudioBaseURL, APIKey, AgentID    string
-	Trigger                          trigger.Trigger
+	// TranscriptionChunkSize is the size, in bytes, above which audio is split into chunks that are transcribed
+	// concurrently. Chunking is disabled if this is not positive.
+	TranscriptionChunkSize int
+	Client                 *http.Client
+	Trigger                trigger.Trigger
 }
 
 type agent struct {
 	logger                                        *slog.Logger
 	pollingInterval, requestRetention             time.Duration
+	transcriptionChunkSize                        int
 	id, apiKey                                    string
 	speechURL, translationsURL, transcriptionsURL string
 	client                                        *http.Client
@@ -63,18 +68,23 @@ func newAgent(db *db.DB, cfg Config) (*agent, error) {
 		cfg.Trigger = trigger.NewNoop()
 	}
 
+	if cfg.Client == nil {
+		cfg.Client = http.DefaultClient
+	}
+
 	return &agent{
-		logger:            cfg.Logger,
-		pollingInterval:   cfg.PollingInterval,
-		requestRetention:  cfg.RetentionPeriod,
-		speechURL:         cfg.AudioBaseURL + "/speech",
-		translationsURL:   cfg.AudioBaseURL + "/translations",
-		transcriptionsURL: cfg.AudioBaseURL + "/transcriptions",
-		client:            http.DefaultClient,
-		apiKey:            cfg.APIKey,
-		db:                db,
-		id:                cfg.AgentID,
-		trigger:           cfg.Trigger,
+		logger:                 cfg.Logger,
+		pollingInterval:        cfg.PollingInterval,
+		requestRetention:       cfg.RetentionPeriod,
+		transcriptionChunkSize: cfg.TranscriptionChunkSize,
+		speechURL:              cfg.AudioBaseURL + "/speech",
+		translationsURL:        cfg.AudioBaseURL + "/translations",
+		transcriptionsURL:      cfg.AudioBaseURL + "/transcriptions",
+		client:                 cfg.Client,
+		apiKey:                 cfg.APIKey,
+		db:                     db,
+		id:                     cfg.AgentID,
+		trigger:                cfg.Trigger,
 	}, nil
 }
 
@@ -0,0 +1,215 @@
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gptscript-ai/clicky-chats/pkg/generated/openai"
+)
+
+const (
+	wavHeaderSize = 44
+	// silenceWindowsPerSecond determines the length of the windows that are compared when looking for silence.
+	silenceWindowsPerSecond = 50
+	// silenceSearchFraction is the fraction at the end of each chunk that is searched for silence.
+	silenceSearchFraction = 4
+)
+
+var errUnsupportedAudio = errors.New("audio is not 16-bit PCM WAV")
+
+// wav is a 16-bit PCM WAV file.
+type wav struct {
+	channels, sampleRate int
+	data                 []byte
+}
+
+func (w *wav) frameSize() int {
+	return w.channels * 2
+}
+
+func (w *wav) frames() int {
+	return len(w.data) / w.frameSize()
+}
+
+// parseWAV parses a 16-bit PCM WAV file, returning errUnsupportedAudio for any other kind of file.
+func parseWAV(file []byte) (*wav, error) {
+	if len(file) < 12 || string(file[0:4]) != "RIFF" || string(file[8:12]) != "WAVE" {
+		return nil, errUnsupportedAudio
+	}
+
+	var (
+		w      wav
+		hasFmt bool
+	)
+	for offset := 12; offset+8 <= len(file); {
+		id, size := string(file[offset:offset+4]), int(binary.LittleEndian.Uint32(file[offset+4:offset+8]))
+		offset += 8
+		if size < 0 || offset+size > len(file) {
+			// Some encoders write a bogus size for the data chunk, use the rest of the file.
+			size = len(file) - offset
+		}
+
+		switch id {
+		case "fmt ":
+			if size < 16 {
+				return nil, errUnsupportedAudio
+			}
+			format := binary.LittleEndian.Uint16(file[offset : offset+2])
+			bitsPerSample := binary.LittleEndian.Uint16(file[offset+14 : offset+16])
+			if format != 1 || bitsPerSample != 16 {
+				return nil, errUnsupportedAudio
+			}
+			w.channels = int(binary.LittleEndian.Uint16(file[offset+2 : offset+4]))
+			w.sampleRate = int(binary.LittleEndian.Uint32(file[offset+4 : offset+8]))
+			hasFmt = true
+		case "data":
+			if !hasFmt || w.channels == 0 || w.sampleRate == 0 {
+				return nil, errUnsupportedAudio
+			}
+			w.data = file[offset : offset+size-size%w.frameSize()]
+			return &w, nil
+		}
+
+		// Chunks are padded to an even size.
+		offset += size + size%2
+	}
+
+	return nil, errUnsupportedAudio
+}
+
+// audioChunk is a piece of a larger audio file.
+type audioChunk struct {
+	// file is the chunk as a standalone WAV file.
+	file []byte
+	// offset and duration are the start time and the duration of the chunk, in seconds.
+	offset, duration float64
+}
+
+// splitOnSilence splits the audio into WAV files that are no larger than maxChunkSize bytes. Each split is made at the
+// quietest point near the end of the chunk so that words are not cut in half.
+func splitOnSilence(w *wav, maxChunkSize int) ([]audioChunk, error) {
+	maxFrames := (maxChunkSize - wavHeaderSize) / w.frameSize()
+	windowFrames := max(w.sampleRate/silenceWindowsPerSecond, 1)
+	if maxFrames < windowFrames*silenceSearchFraction {
+		return nil, fmt.Errorf("chunk size %d is too small for %d Hz audio", maxChunkSize, w.sampleRate)
+	}
+
+	var chunks []audioChunk
+	for start, total := 0, w.frames(); start < total; {
+		end := total
+		if total-start > maxFrames {
+			end = quietestFrame(w, start+maxFrames-maxFrames/silenceSearchFraction, start+maxFrames, windowFrames)
+		}
+
+		chunks = append(chunks, audioChunk{
+			file:     w.encode(start, end),
+			offset:   float64(start) / float64(w.sampleRate),
+			duration: float64(end-start) / float64(w.sampleRate),
+		})
+		start = end
+	}
+
+	return chunks, nil
+}
+
+// quietestFrame returns the frame in the middle of the quietest window in the frames [from, to).
+func quietestFrame(w *wav, from, to, windowFrames int) int {
+	var (
+		best       = to
+		bestEnergy = -1.0
+	)
+	for s := from; s+windowFrames <= to; s += windowFrames {
+		var energy float64
+		for i := s * w.frameSize(); i < (s+windowFrames)*w.frameSize(); i += 2 {
+			sample := float64(int16(binary.LittleEndian.Uint16(w.data[i : i+2])))
+			energy += sample * sample
+		}
+
+		// Prefer later windows when the energy is the same so that the chunks are as large as possible.
+		if bestEnergy < 0 || energy <= bestEnergy {
+			best, bestEnergy = s+windowFrames/2, energy
+		}
+	}
+
+	return best
+}
+
+// encode returns the frames [start, end) as a standalone WAV file.
+func (w *wav) encode(start, end int) []byte {
+	data := w.data[start*w.frameSize() : end*w.frameSize()]
+
+	buf := bytes.NewBuffer(make([]byte, 0, wavHeaderSize+len(data)))
+	buf.WriteString("RIFF")
+	_ = binary.Write(buf, binary.LittleEndian, uint32(wavHeaderSize-8+len(data)))
+	buf.WriteString("WAVEfmt ")
+	for _, v := range []any{
+		uint32(16),
+		uint16(1),
+		uint16(w.channels),
+		uint32(w.sampleRate),
+		uint32(w.sampleRate * w.frameSize()),
+		uint16(w.frameSize()),
+		uint16(16),
+	} {
+		_ = binary.Write(buf, binary.LittleEndian, v)
+	}
+	buf.WriteString("data")
+	_ = binary.Write(buf, binary.LittleEndian, uint32(len(data)))
+	buf.Write(data)
+
+	return buf.Bytes()
+}
+
+// stitchTranscriptions combines the transcriptions of the chunks, in order, into a single transcription. The timestamps
+// of the segments and words of each chunk are shifted by the chunk's offset.
+func stitchTranscriptions(chunks []audioChunk, transcriptions []*openai.CreateTranscriptionResponseVerboseJson) *openai.CreateTranscriptionResponseVerboseJson {
+	var (
+		result   = new(openai.CreateTranscriptionResponseVerboseJson)
+		texts    = make([]string, 0, len(transcriptions))
+		segments []openai.TranscriptionSegment
+		words    []openai.TranscriptionWord
+		duration float64
+	)
+	for i, t := range transcriptions {
+		offset := float32(chunks[i].offset)
+		duration += chunks[i].duration
+
+		if text := strings.TrimSpace(t.Text); text != "" {
+			texts = append(texts, text)
+		}
+		if result.Language == "" {
+			result.Language = t.Language
+		}
+
+		if t.Segments != nil {
+			for _, segment := range *t.Segments {
+				segment.Id = len(segments)
+				segment.Start += offset
+				segment.End += offset
+				segments = append(segments, segment)
+			}
+		}
+		if t.Words != nil {
+			for _, word := range *t.Words {
+				word.Start += offset
+				word.End += offset
+				words = append(words, word)
+			}
+		}
+	}
+
+	result.Text = strings.Join(texts, " ")
+	result.Duration = strconv.FormatFloat(duration, 'f', -1, 64)
+	if segments != nil {
+		result.Segments = &segments
+	}
+	if words != nil {
+		result.Words = &words
+	}
+
+	return result
+}
@@ -0,0 +1,173 @@
+package audio
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gptscript-ai/clicky-chats/pkg/db"
+	"github.com/gptscript-ai/clicky-chats/pkg/generated/openai"
+)
+
+const testSampleRate = 8000
+
+// testWAV returns a mono WAV file with the given number of one-second tones, each followed by 200ms of silence, except
+// for the last. The amplitude of each tone identifies its position in the file.
+func testWAV(tones int) []byte {
+	var samples []int16
+	for i := 0; i < tones; i++ {
+		for j := 0; j < testSampleRate; j++ {
+			samples = append(samples, int16(1000*(i+1)))
+		}
+		if i < tones-1 {
+			samples = append(samples, make([]int16, testSampleRate/5)...)
+		}
+	}
+
+	data := make([]byte, 2*len(samples))
+	for i, s := range samples {
+		binary.LittleEndian.PutUint16(data[2*i:], uint16(s))
+	}
+
+	return (&wav{channels: 1, sampleRate: testSampleRate, data: data}).encode(0, len(samples))
+}
+
+// newTestTranscriptionServer returns a server that transcribes each tone in the audio to "tone N", with a single segment
+// covering the whole audio.
+func newTestTranscriptionServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			t.Errorf("failed to read form file: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		b, err := io.ReadAll(file)
+		if err != nil {
+			t.Errorf("failed to read file: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		audio, err := parseWAV(b)
+		if err != nil {
+			t.Errorf("failed to parse chunk: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		var text string
+		for i := 0; i < len(audio.data); i += 2 {
+			if sample := int16(binary.LittleEndian.Uint16(audio.data[i:])); sample != 0 {
+				text = fmt.Sprintf("tone %d", sample/1000-1)
+				break
+			}
+		}
+
+		duration := float32(audio.frames()) / float32(audio.sampleRate)
+		_ = json.NewEncoder(w).Encode(openai.CreateTranscriptionResponseVerboseJson{
+			Duration: fmt.Sprintf("%f", duration),
+			Language: "english",
+			Segments: &[]openai.TranscriptionSegment{{Start: 0, End: duration, Text: text}},
+			Text:     text,
+		})
+	}))
+}
+
+func TestTranscribeInChunks(t *testing.T) {
+	srv := newTestTranscriptionServer(t)
+	defer srv.Close()
+
+	file := testWAV(3)
+	// Each chunk can hold 1.3 seconds of audio, so the file must be split into three chunks.
+	chunkSize := wavHeaderSize + 2*testSampleRate*13/10
+
+	a := &agent{
+		client:                 http.DefaultClient,
+		transcriptionsURL:      srv.URL,
+		transcriptionChunkSize: chunkSize,
+	}
+
+	transcription, code, err := a.transcribeRequest(context.Background(), slog.Default(), &db.CreateTranscriptionRequest{
+		FileName: "audio.wav",
+		File:     file,
+		Model:    "whisper-1",
+	})
+	if err != nil {
+		t.Fatalf("transcribeRequest() error = %v", err)
+	}
+	if code != http.StatusOK {
+		t.Errorf("status code = %d, want %d", code, http.StatusOK)
+	}
+
+	if want := "tone 0 tone 1 tone 2"; transcription.Text != want {
+		t.Errorf("text = %q, want %q", transcription.Text, want)
+	}
+
+	if transcription.Segments == nil || len(*transcription.Segments) != 3 {
+		t.Fatalf("expected 3 segments, got %#v", transcription.Segments)
+	}
+
+	var end float32
+	for i, segment := range *transcription.Segments {
+		if segment.Id != i {
+			t.Errorf("segment %d has id %d", i, segment.Id)
+		}
+		if segment.Text != fmt.Sprintf("tone %d", i) {
+			t.Errorf("segment %d has text %q", i, segment.Text)
+		}
+		if math.Abs(float64(segment.Start-end)) > 1e-3 {
+			t.Errorf("segment %d starts at %f, want %f", i, segment.Start, end)
+		}
+		end = segment.End
+	}
+
+	if want := float32(len(file)-wavHeaderSize) / 2 / testSampleRate; math.Abs(float64(end-want)) > 1e-3 {
+		t.Errorf("last segment ends at %f, want %f", end, want)
+	}
+}
+
+func TestSplitOnSilence(t *testing.T) {
+	w, err := parseWAV(testWAV(3))
+	if err != nil {
+		t.Fatalf("parseWAV() error = %v", err)
+	}
+
+	chunkSize := wavHeaderSize + 2*testSampleRate*13/10
+	chunks, err := splitOnSilence(w, chunkSize)
+	if err != nil {
+		t.Fatalf("splitOnSilence() error = %v", err)
+	}
+
+	if len(chunks) != 3 {
+		t.Fatalf("expected 3 chunks, got %d", len(chunks))
+	}
+
+	var offset float64
+	for i, chunk := range chunks {
+		if len(chunk.file) > chunkSize {
+			t.Errorf("chunk %d has size %d, want at most %d", i, len(chunk.file), chunkSize)
+		}
+		if math.Abs(chunk.offset-offset) > 1e-9 {
+			t.Errorf("chunk %d has offset %f, want %f", i, chunk.offset, offset)
+		}
+		offset += chunk.duration
+
+		if i == 0 {
+			continue
+		}
+
+		// Each split should be made in the silence between the tones.
+		if silenceStart := float64(i) * 1.2; chunk.offset <= silenceStart-0.2 || chunk.offset >= silenceStart {
+			t.Errorf("chunk %d starts at %f, which is not in the silence between %f and %f", i, chunk.offset, silenceStart-0.2, silenceStart)
+		}
+	}
+}
@@ -5,10 +5,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"log/slog"
 	"mime/multipart"
 	"net/http"
+	"sync"
 
 	"github.com/acorn-io/z"
 	cclient "github.com/gptscript-ai/clicky-chats/pkg/client"
@@ -30,68 +30,150 @@ func (a *agent) runTranscriptions(ctx context.Context, l *slog.Logger) error {
 	l = slog.With("type", "transcription", "id", transcriptionRequest.ID)
 	l.Debug("processing request")
 
-	var requestBody bytes.Buffer
-	writer := multipart.NewWriter(&requestBody)
+	oir, code, err := a.transcribeRequest(ctx, l, transcriptionRequest)
+
+	// The segments and words, with their timestamps, are only returned for the verbose_json response format.
+	var publicResponse any = &openai.CreateTranscriptionResponseJson{Text: oir.Text}
+	if z.Dereference(transcriptionRequest.ResponseFormat) == string(openai.CreateTranscriptionRequestResponseFormatVerboseJson) {
+		publicResponse = oir
+	}
 
-	publicRequest := transcriptionRequest.ToPublic().(*openai.CreateTranscriptionRequest)
-	part, err := writer.CreateFormFile("file", publicRequest.File.Filename())
+	ir := new(db.CreateTranscriptionResponse)
+	// err must be shadowed here.
+	if err := ir.FromPublic(publicResponse); err != nil {
+		l.Error("failed to convert transcription response", "err", err)
+	}
+
+	// Process the request error here.
 	if err != nil {
-		return fmt.Errorf("failed to create form file: %w", err)
+		l.Error("failed to send transcription request", "err", err)
+		ir.Error = z.Pointer(err.Error())
+	}
+
+	ir.StatusCode = code
+	ir.RequestID = transcriptionRequest.ID
+	ir.Done = true
+
+	// Store the completed response and mark the request as done.
+	if err = gdb.Transaction(func(tx *gorm.DB) error {
+		if err = db.Create(tx, ir); err != nil {
+			return err
+		}
+		return tx.Model(transcriptionRequest).Where("id = ?", transcriptionRequest.ID).Update("done", true).Error
+	}); err != nil {
+		l.Error("failed to store transcription response", "err", err)
+	}
+
+	a.trigger.Ready(transcriptionRequest.ID)
+
+	return nil
+}
+
+// transcribeRequest transcribes the audio of the request. If the audio is larger than the configured chunk size, then it
+// is split on silence and the chunks are transcribed concurrently.
+func (a *agent) transcribeRequest(ctx context.Context, l *slog.Logger, transcriptionRequest *db.CreateTranscriptionRequest) (*openai.CreateTranscriptionResponseVerboseJson, int, error) {
+	if a.transcriptionChunkSize <= 0 || len(transcriptionRequest.File) <= a.transcriptionChunkSize {
+		return a.transcribe(ctx, transcriptionRequest, transcriptionRequest.FileName, transcriptionRequest.File)
+	}
+
+	w, err := parseWAV(transcriptionRequest.File)
+	if err != nil {
+		l.Warn("not chunking audio that is larger than the chunk size", "err", err)
+		return a.transcribe(ctx, transcriptionRequest, transcriptionRequest.FileName, transcriptionRequest.File)
+	}
+
+	chunks, err := splitOnSilence(w, a.transcriptionChunkSize)
+	if err != nil {
+		return new(openai.CreateTranscriptionResponseVerboseJson), http.StatusInternalServerError, err
+	}
+
+	l.Debug("transcribing audio in chunks", "chunks", len(chunks))
+
+	var (
+		wg             sync.WaitGroup
+		transcriptions = make([]*openai.CreateTranscriptionResponseVerboseJson, len(chunks))
+		codes          = make([]int, len(chunks))
+		errs           = make([]error, len(chunks))
+	)
+	for i, chunk := range chunks {
+		wg.Add(1)
+		go func(i int, chunk audioChunk) {
+			defer wg.Done()
+			transcriptions[i], codes[i], errs[i] = a.transcribe(ctx, transcriptionRequest, fmt.Sprintf("chunk-%d.wav", i), chunk.file)
+		}(i, chunk)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return transcriptions[i], codes[i], fmt.Errorf("failed to transcribe chunk %d: %w", i, err)
+		}
 	}
-	r, err := publicRequest.File.Reader()
+
+	return stitchTranscriptions(chunks, transcriptions), codes[0], nil
+}
+
+// transcribe sends a transcription request for the file to the model provider. The returned response is never nil.
+func (a *agent) transcribe(ctx context.Context, transcriptionRequest *db.CreateTranscriptionRequest, fileName string, file []byte) (*openai.CreateTranscriptionResponseVerboseJson, int, error) {
+	oir := new(openai.CreateTranscriptionResponseVerboseJson)
+
+	var requestBody bytes.Buffer
+	writer := multipart.NewWriter(&requestBody)
+
+	part, err := writer.CreateFormFile("file", fileName)
 	if err != nil {
-		return fmt.Errorf("failed to get transcription reader: %w", err)
+		return oir, http.StatusInternalServerError, fmt.Errorf("failed to create form file: %w", err)
 	}
-	if _, err := io.Copy(part, r); err != nil {
-		return fmt.Errorf("failed to copy file to form file: %w", err)
+	if _, err := part.Write(file); err != nil {
+		return oir, http.StatusInternalServerError, fmt.Errorf("failed to copy file to form file: %w", err)
 	}
 
 	if language := transcriptionRequest.Language; language != nil {
 		if err := writer.WriteField("language", *language); err != nil {
-			return fmt.Errorf("failed to write language field: %w", err)
+			return oir, http.StatusInternalServerError, fmt.Errorf("failed to write language field: %w", err)
 		}
 	}
 
 	if err := writer.WriteField("model", transcriptionRequest.Model); err != nil {
-		return fmt.Errorf("failed to write model field: %w", err)
+		return oir, http.StatusInternalServerError, fmt.Errorf("failed to write model field: %w", err)
 	}
 
 	if prompt := transcriptionRequest.Prompt; prompt != nil {
 		if err := writer.WriteField("prompt", *prompt); err != nil {
-			return fmt.Errorf("failed to write prompt field: %w", err)
+			return oir, http.StatusInternalServerError, fmt.Errorf("failed to write prompt field: %w", err)
 		}
 	}
 
 	if format := transcriptionRequest.ResponseFormat; format != nil {
 		if err := writer.WriteField("response_format", *format); err != nil {
-			return fmt.Errorf("failed to write response format field: %w", err)
+			return oir, http.StatusInternalServerError, fmt.Errorf("failed to write response format field: %w", err)
 		}
 	}
 
 	if temperature := transcriptionRequest.Temperature; temperature != nil {
 		if err := writer.WriteField("temperature", fmt.Sprintf("%f", *temperature)); err != nil {
-			return fmt.Errorf("failed to write response format field: %w", err)
+			return oir, http.StatusInternalServerError, fmt.Errorf("failed to write response format field: %w", err)
 		}
 	}
 
 	if granularities := transcriptionRequest.TimestampGranularities; granularities != nil {
 		data, err := json.Marshal(granularities)
 		if err != nil {
-			return fmt.Errorf("failed to marshal timestamp granularities: %w", err)
+			return oir, http.StatusInternalServerError, fmt.Errorf("failed to marshal timestamp granularities: %w", err)
 		}
 
 		if err := writer.WriteField("timestamp_granularities", string(data)); err != nil {
-			return fmt.Errorf("failed to write timestamp granularities field: %w", err)
+			return oir, http.StatusInternalServerError, fmt.Errorf("failed to write timestamp granularities field: %w", err)
 		}
 	}
 
 	if err := writer.Close(); err != nil {
-		return fmt.Errorf("failed to close body writer: %w", err)
+		return oir, http.StatusInternalServerError, fmt.Errorf("failed to close body writer: %w", err)
 	}
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.transcriptionsURL, &requestBody)
 	if err != nil {
-		return fmt.Errorf("failed to create transcription request: %w", err)
+		return oir, http.StatusInternalServerError, fmt.Errorf("failed to create transcription request: %w", err)
 	}
 
 	req.Header.Set("Content-Type", writer.FormDataContentType())
@@ -100,35 +182,6 @@ func (a *agent) runTranscriptions(ctx context.Context, l *slog.Logger) error {
 		req.Header.Set("Authorization", "Bearer "+a.apiKey)
 	}
 
-	oir, ir := new(openai.CreateTranscriptionResponseJson), new(db.CreateTranscriptionResponse)
 	code, err := cclient.SendRequest(a.client, req, oir)
-
-	// err must be shadowed here.
-	if err := ir.FromPublic(oir); err != nil {
-		l.Error("failed to convert transcription response", "err", err)
-	}
-
-	// Process the request error here.
-	if err != nil {
-		l.Error("failed to send transcription request", "err", err)
-		ir.Error = z.Pointer(err.Error())
-	}
-
-	ir.StatusCode = code
-	ir.RequestID = transcriptionRequest.ID
-	ir.Done = true
-
-	// Store the completed response and mark the request as done.
-	if err = gdb.Transaction(func(tx *gorm.DB) error {
-		if err = db.Create(tx, ir); err != nil {
-			return err
-		}
-		return tx.Model(transcriptionRequest).Where("id = ?", transcriptionRequest.ID).Update("done", true).Error
-	}); err != nil {
-		l.Error("failed to store transcription response", "err", err)
-	}
-
-	a.trigger.Ready(transcriptionRequest.ID)
-
-	return nil
+	return oir, code, err
 }
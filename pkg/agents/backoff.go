@@ -0,0 +1,32 @@
+package agents
+
+import "time"
+
+// PollingBackoff determines how long an agent waits before it polls for new requests again. The wait starts at the
+// polling interval and doubles after each poll that finds no requests, up to the max interval. It is reset to the
+// polling interval when a poll finds a request.
+type PollingBackoff struct {
+	interval, maxInterval, current time.Duration
+}
+
+// NewPollingBackoff returns a backoff that starts at the interval and grows up to maxInterval. If maxInterval is not
+// more than the interval, then the agent always waits for the interval.
+func NewPollingBackoff(interval, maxInterval time.Duration) *PollingBackoff {
+	return &PollingBackoff{
+		interval:    interval,
+		maxInterval: max(interval, maxInterval),
+		current:     interval,
+	}
+}
+
+// Next returns how long to wait before the next poll, given whether the last poll found a request.
+func (b *PollingBackoff) Next(found bool) time.Duration {
+	if found {
+		b.current = b.interval
+		return b.current
+	}
+
+	wait := b.current
+	b.current = min(2*b.current, b.maxInterval)
+	return wait
+}
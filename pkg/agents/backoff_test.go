@@ -0,0 +1,47 @@
+package agents
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestPollingBackoff(t *testing.T) {
+	tests := []struct {
+		name        string
+		maxInterval time.Duration
+		found       []bool
+		want        []time.Duration
+	}{
+		{
+			name:  "Disabled",
+			found: []bool{false, false, false},
+			want:  []time.Duration{time.Second, time.Second, time.Second},
+		},
+		{
+			name:        "Grows up to the max interval",
+			maxInterval: 5 * time.Second,
+			found:       []bool{false, false, false, false, false},
+			want:        []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second},
+		},
+		{
+			name:        "Reset when a request is found",
+			maxInterval: 5 * time.Second,
+			found:       []bool{false, false, true, false},
+			want:        []time.Duration{time.Second, 2 * time.Second, time.Second, time.Second},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewPollingBackoff(time.Second, tt.maxInterval)
+
+			got := make([]time.Duration, 0, len(tt.found))
+			for _, found := range tt.found {
+				got = append(got, b.Next(found))
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("waits = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package agents
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned when the circuit breaker is open and requests are not being sent to the model provider.
+var ErrCircuitOpen = errors.New("the model provider is failing, try again later")
+
+// CircuitBreaker stops requests from being sent to the model provider after too many consecutive failures so that a
+// failing provider doesn't receive even more load. Once the breaker has been open for the recovery period, a single probe
+// request is let through: the breaker closes if the probe succeeds, and opens again if it fails.
+type CircuitBreaker struct {
+	failureThreshold int
+	recoveryPeriod   time.Duration
+	now              func() time.Time
+
+	lock     sync.Mutex
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+// NewCircuitBreaker returns a circuit breaker that opens after failureThreshold consecutive failures. If the threshold
+// is not positive, then the breaker never opens.
+func NewCircuitBreaker(failureThreshold int, recoveryPeriod time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{
+		failureThreshold: failureThreshold,
+		recoveryPeriod:   recoveryPeriod,
+		now:              time.Now,
+	}
+}
+
+// Allow returns ErrCircuitOpen if a request should not be sent to the model provider. Otherwise, the request can be
+// sent and the result must be passed to Record or Release.
+func (b *CircuitBreaker) Allow() error {
+	if b.failureThreshold <= 0 {
+		return nil
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.failures < b.failureThreshold {
+		return nil
+	}
+
+	if b.probing || b.now().Sub(b.openedAt) < b.recoveryPeriod {
+		return ErrCircuitOpen
+	}
+
+	// The recovery period has passed, let this request through to check if the provider has recovered.
+	b.probing = true
+	return nil
+}
+
+// Record records the result of a request that was allowed by the breaker.
+func (b *CircuitBreaker) Record(failed bool) {
+	if b.failureThreshold <= 0 {
+		return
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.probing = false
+	if !failed {
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.failures >= b.failureThreshold {
+		b.openedAt = b.now()
+	}
+}
+
+// Release must be called instead of Record if the result of an allowed request is unknown, for example because it was
+// cancelled.
+func (b *CircuitBreaker) Release() {
+	if b.failureThreshold <= 0 {
+		return
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.probing = false
+}
+
+// IsProviderFailure returns true if the status code of a response from the model provider indicates that the provider
+// is failing, as opposed to the request being invalid.
+func IsProviderFailure(statusCode int) bool {
+	return statusCode == 0 || statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+}
@@ -0,0 +1,68 @@
+package agents
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Now()
+	b := NewCircuitBreaker(3, time.Minute)
+	b.now = func() time.Time { return now }
+
+	// A success resets the consecutive failures.
+	for _, failed := range []bool{true, true, false, true, true} {
+		if err := b.Allow(); err != nil {
+			t.Fatalf("Allow() unexpected error = %v", err)
+		}
+		b.Record(failed)
+	}
+
+	// The third consecutive failure trips the breaker.
+	if err := b.Allow(); err != nil {
+		t.Fatalf("Allow() unexpected error = %v", err)
+	}
+	b.Record(true)
+
+	for i := 0; i < 3; i++ {
+		if err := b.Allow(); !errors.Is(err, ErrCircuitOpen) {
+			t.Fatalf("Allow() error = %v, want %v", err, ErrCircuitOpen)
+		}
+	}
+
+	// After the recovery period, only a single probe is let through.
+	now = now.Add(time.Minute)
+	if err := b.Allow(); err != nil {
+		t.Fatalf("Allow() probe unexpected error = %v", err)
+	}
+	if err := b.Allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("Allow() during probe error = %v, want %v", err, ErrCircuitOpen)
+	}
+
+	// A failed probe opens the breaker again.
+	b.Record(true)
+	if err := b.Allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("Allow() after failed probe error = %v, want %v", err, ErrCircuitOpen)
+	}
+
+	// A successful probe closes the breaker.
+	now = now.Add(time.Minute)
+	if err := b.Allow(); err != nil {
+		t.Fatalf("Allow() probe unexpected error = %v", err)
+	}
+	b.Record(false)
+	if err := b.Allow(); err != nil {
+		t.Fatalf("Allow() after recovery unexpected error = %v", err)
+	}
+}
+
+func TestCircuitBreakerDisabled(t *testing.T) {
+	b := NewCircuitBreaker(0, time.Minute)
+	for i := 0; i < 10; i++ {
+		if err := b.Allow(); err != nil {
+			t.Fatalf("Allow() unexpected error = %v", err)
+		}
+		b.Record(true)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
@@ -21,22 +22,101 @@ import (
 const (
 	minPollingInterval  = time.Second
 	minRequestRetention = 5 * time.Minute
+
+	// statusClientClosedRequest is the non-standard status code used for requests that the client cancelled.
+	statusClientClosedRequest = 499
 )
 
+var errRequestCancelled = errors.New("chat completion request was cancelled by the client")
+
 var (
-	supportedModels = map[string]struct{}{
+	// defaultSupportedModels are the models that are listed if no models are configured.
+	defaultSupportedModels = map[string]struct{}{
 		"gpt-3.5":             {},
 		"gpt-3.5-turbo":       {},
 		"gpt-4":               {},
 		"gpt-4-turbo-preview": {},
 	}
+
+	// defaultModelDeprecations maps models that have been retired by OpenAI to their recommended replacement.
+	defaultModelDeprecations = map[string]string{
+		"gpt-3.5-turbo-0301": "gpt-3.5-turbo",
+		"gpt-3.5-turbo-0613": "gpt-3.5-turbo",
+		"gpt-4-0314":         "gpt-4",
+		"gpt-4-32k-0314":     "gpt-4-32k",
+	}
 )
 
+// UsageReporter is called with the usage of a chat completion once its response has been stored.
+type UsageReporter func(ctx context.Context, chatCompletionID, model string, usage openai.CompletionUsage)
+
 type Config struct {
 	Logger                                        *slog.Logger
 	PollingInterval, RetentionPeriod              time.Duration
 	ModelsURL, ChatCompletionURL, APIKey, AgentID string
-	Trigger                                       trigger.Trigger
+	MaxConcurrentRequests, MaxQueuedRequests      int
+	// SupportedModels are the models from the model provider that are stored and listed. If empty, a default set of
+	// models is used.
+	SupportedModels []string
+	// ModelDeprecations maps retired model names to their replacement. These are merged with, and take precedence
+	// over, the default deprecations.
+	ModelDeprecations map[string]string
+	// RequestTimeout bounds each request to the model provider, unless the model has a timeout in ModelRequestTimeouts.
+	// Requests are not bounded if the timeout is not positive.
+	RequestTimeout       time.Duration
+	ModelRequestTimeouts map[string]time.Duration
+	// ModelFallbacks maps models to the models that are tried, in order, when the model provider doesn't have the
+	// capacity to serve a request for the model.
+	ModelFallbacks map[string][]string
+	// FailureThreshold is the number of consecutive failed requests to the model provider after which requests are
+	// rejected without being sent, until a probe request succeeds after the RecoveryPeriod. The circuit breaker is
+	// disabled if the threshold is not positive.
+	FailureThreshold int
+	RecoveryPeriod   time.Duration
+	// MaxRetries is the number of times a request is sent again when the model provider responds with one of the
+	// RetryStatusCodes, waiting RetryBackoff before the first retry and twice as long before each one after it. If no
+	// status codes are set, then agents.DefaultRetryStatusCodes are used. Requests are not retried if MaxRetries is not
+	// positive.
+	MaxRetries       int
+	RetryBackoff     time.Duration
+	RetryStatusCodes []int
+	// StoreRawResponses enables storing the body of non-streaming responses from the model provider with the parsed
+	// response. The values of the JSON keys in RawResponseRedactions are redacted. The bodies are removed after the
+	// RawResponseRetention, or the RetentionPeriod if it is not positive.
+	StoreRawResponses     bool
+	RawResponseRedactions []string
+	RawResponseRetention  time.Duration
+	// StorePrompts enables storing the messages that are sent to the model provider with the request, so that the
+	// prompt the model saw can be inspected after the request has been normalized.
+	StorePrompts bool
+	// ReportUsage, if set, is called with the usage of each successful non-streaming chat completion, so that it can
+	// be sent to telemetry. It is called by the worker that processed the request, so it should return quickly.
+	// Streamed chunks don't include usage, so streaming chat completions are not reported.
+	ReportUsage UsageReporter
+	// StorageTTL is how long chat completions are stored once they are done. Expired chat completions are deleted in
+	// batches of CleanupBatchSize, unless they were created with store set. Chat completions are stored indefinitely if
+	// the TTL is not positive.
+	StorageTTL       time.Duration
+	CleanupBatchSize int
+	// JobLease is how long a claimed request can go without being renewed before another agent can claim it. Requests
+	// are claimed without a lease if it is not positive.
+	JobLease time.Duration
+	// DefaultParameters are used for the parameters that requests omit. The LockedParameters are always set to their
+	// default, ignoring the values in requests.
+	DefaultParameters Parameters
+	LockedParameters  []string
+	// RoleRemappings maps canonical message roles to the roles the model provider requires instead, for example tool to
+	// function for providers that only support the older function calling API. Messages are only remapped when they are
+	// sent to the model provider.
+	RoleRemappings map[string]string
+	// MaxHistoryMessages is the number of most recent messages, not counting system messages, that are sent to the
+	// model provider. All system messages are always sent. The history is not windowed if it is not positive.
+	MaxHistoryMessages int
+	// DedupeSystemMessages collapses identical consecutive system messages into one before requests are sent to
+	// the model provider.
+	DedupeSystemMessages bool
+	Client               *http.Client
+	Trigger              trigger.Trigger
 }
 
 func Start(ctx context.Context, wg *sync.WaitGroup, gdb *db.DB, cfg Config) error {
@@ -64,9 +144,40 @@ type agent struct {
 	client                           *http.Client
 	db                               *db.DB
 	trigger                          trigger.Trigger
+	pool                             *agents.WorkerPool
+	modelDeprecations                map[string]string
+	supportedModels                  map[string]struct{}
+	requestTimeout                   time.Duration
+	modelRequestTimeouts             map[string]time.Duration
+	modelFallbacks                   map[string][]string
+	breaker                          *agents.CircuitBreaker
+	retryPolicy                      *agents.RetryPolicy
+	queue                            *db.Queue
+	defaultParameters                Parameters
+	lockedParameters                 map[string]struct{}
+	roleRemappings                   map[string]string
+	deduplicateSystem                bool
+	maxHistoryMessages               int
+	storeRawResponses                bool
+	rawResponseRedactions            map[string]struct{}
+	rawResponseRetention             time.Duration
+	storePrompts                     bool
+	reportUsage                      UsageReporter
+	storageTTL                       time.Duration
+	cleanupBatchSize                 int
+
+	// inFlight holds the IDs of the requests that have been claimed by this agent and are waiting for, or being
+	// processed by, a worker. These are excluded when looking for new requests to claim.
+	inFlight     map[string]struct{}
+	inFlightLock sync.Mutex
+
+	// cancellationCheck is closed, and replaced, when the agent is triggered so that the requests being processed are
+	// checked for cancellation without waiting for the next poll.
+	cancellationCheck     chan struct{}
+	cancellationCheckLock sync.Mutex
 }
 
-func newAgent(db *db.DB, cfg Config) (*agent, error) {
+func newAgent(gdb *db.DB, cfg Config) (*agent, error) {
 	if cfg.PollingInterval < minPollingInterval {
 		return nil, fmt.Errorf("[chatcompletion] polling interval must be at least %s", minPollingInterval)
 	}
@@ -79,19 +190,119 @@ func newAgent(db *db.DB, cfg Config) (*agent, error) {
 		cfg.Trigger = trigger.NewNoop()
 	}
 
+	if cfg.Client == nil {
+		cfg.Client = http.DefaultClient
+	}
+
+	supportedModels := defaultSupportedModels
+	if len(cfg.SupportedModels) > 0 {
+		supportedModels = make(map[string]struct{}, len(cfg.SupportedModels))
+		for _, model := range cfg.SupportedModels {
+			supportedModels[model] = struct{}{}
+		}
+	}
+
+	lockedParameters, err := validateLockedParameters(cfg.LockedParameters)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = validateRoleRemappings(cfg.RoleRemappings); err != nil {
+		return nil, err
+	}
+
+	rawResponseRedactions := make(map[string]struct{}, len(cfg.RawResponseRedactions))
+	for _, key := range cfg.RawResponseRedactions {
+		rawResponseRedactions[key] = struct{}{}
+	}
+	if cfg.RawResponseRetention <= 0 {
+		cfg.RawResponseRetention = cfg.RetentionPeriod
+	}
+	if cfg.CleanupBatchSize < 1 {
+		cfg.CleanupBatchSize = defaultCleanupBatchSize
+	}
+
 	return &agent{
-		logger:          cfg.Logger,
-		pollingInterval: cfg.PollingInterval,
-		retentionPeriod: cfg.RetentionPeriod,
-		client:          http.DefaultClient,
-		apiKey:          cfg.APIKey,
-		db:              db,
-		id:              cfg.AgentID,
-		url:             cfg.ChatCompletionURL,
-		trigger:         cfg.Trigger,
+		logger:                cfg.Logger,
+		pollingInterval:       cfg.PollingInterval,
+		retentionPeriod:       cfg.RetentionPeriod,
+		client:                cfg.Client,
+		apiKey:                cfg.APIKey,
+		db:                    gdb,
+		id:                    cfg.AgentID,
+		url:                   cfg.ChatCompletionURL,
+		trigger:               cfg.Trigger,
+		pool:                  agents.NewWorkerPool(cfg.MaxConcurrentRequests, cfg.MaxQueuedRequests),
+		inFlight:              make(map[string]struct{}),
+		cancellationCheck:     make(chan struct{}),
+		modelDeprecations:     mergeModelDeprecations(cfg.ModelDeprecations),
+		supportedModels:       supportedModels,
+		requestTimeout:        cfg.RequestTimeout,
+		modelRequestTimeouts:  cfg.ModelRequestTimeouts,
+		modelFallbacks:        cfg.ModelFallbacks,
+		breaker:               agents.NewCircuitBreaker(cfg.FailureThreshold, cfg.RecoveryPeriod),
+		retryPolicy:           agents.NewRetryPolicy(cfg.MaxRetries, cfg.RetryBackoff, cfg.RetryStatusCodes),
+		defaultParameters:     cfg.DefaultParameters,
+		lockedParameters:      lockedParameters,
+		roleRemappings:        cfg.RoleRemappings,
+		deduplicateSystem:     cfg.DedupeSystemMessages,
+		maxHistoryMessages:    cfg.MaxHistoryMessages,
+		queue:                 db.NewQueue(cfg.AgentID, cfg.JobLease),
+		storeRawResponses:     cfg.StoreRawResponses,
+		rawResponseRedactions: rawResponseRedactions,
+		rawResponseRetention:  cfg.RawResponseRetention,
+		storePrompts:          cfg.StorePrompts,
+		reportUsage:           cfg.ReportUsage,
+		storageTTL:            cfg.StorageTTL,
+		cleanupBatchSize:      cfg.CleanupBatchSize,
 	}, nil
 }
 
+// mergeModelDeprecations returns the default model deprecations merged with the given ones.
+func mergeModelDeprecations(deprecations map[string]string) map[string]string {
+	modelDeprecations := make(map[string]string, len(defaultModelDeprecations)+len(deprecations))
+	for deprecated, replacement := range defaultModelDeprecations {
+		modelDeprecations[deprecated] = replacement
+	}
+	for deprecated, replacement := range deprecations {
+		modelDeprecations[deprecated] = replacement
+	}
+
+	return modelDeprecations
+}
+
+// RequestBody returns the JSON body that an agent started with the config would send to the model provider for the chat
+// completion request. The request is normalized the same way it is before it is dispatched, but cc is not modified.
+func RequestBody(cfg Config, cc *db.CreateChatCompletionRequest) ([]byte, error) {
+	if cfg.Logger == nil {
+		cfg.Logger = slog.Default().With("agent", "chat completion")
+	}
+
+	lockedParameters, err := validateLockedParameters(cfg.LockedParameters)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = validateRoleRemappings(cfg.RoleRemappings); err != nil {
+		return nil, err
+	}
+
+	a := &agent{
+		logger:             cfg.Logger,
+		modelDeprecations:  mergeModelDeprecations(cfg.ModelDeprecations),
+		defaultParameters:  cfg.DefaultParameters,
+		lockedParameters:   lockedParameters,
+		roleRemappings:     cfg.RoleRemappings,
+		deduplicateSystem:  cfg.DedupeSystemMessages,
+		maxHistoryMessages: cfg.MaxHistoryMessages,
+	}
+
+	normalized := *cc
+	a.normalize(a.logger.With("id", cc.ID), &normalized)
+
+	return agents.ChatCompletionRequestBody(&normalized)
+}
+
 func (a *agent) listAndStoreModels(ctx context.Context, modelsURL string) error {
 	// List models
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, modelsURL, nil)
@@ -99,7 +310,9 @@ func (a *agent) listAndStoreModels(ctx context.Context, modelsURL string) error
 		return fmt.Errorf("failed to create request: %w", err)
 	}
 
-	req.Header.Add("Authorization", "Bearer "+a.apiKey)
+	if a.apiKey != "" {
+		req.Header.Add("Authorization", "Bearer "+a.apiKey)
+	}
 	req.Header.Add("Content-Type", "application/json")
 	req.Header.Add("Accept", "application/json")
 
@@ -135,7 +348,7 @@ func (a *agent) listAndStoreModels(ctx context.Context, modelsURL string) error
 		}
 
 		for _, publicModel := range m.Data {
-			if _, ok := supportedModels[publicModel.Id]; !ok {
+			if _, ok := a.supportedModels[publicModel.Id]; !ok {
 				continue
 			}
 			if _, ok := dbModelIDs[publicModel.Id]; ok {
@@ -171,10 +384,12 @@ func (a *agent) Start(ctx context.Context, wg *sync.WaitGroup) {
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
+		// Wait for any requests being processed by the workers to finish.
+		defer a.pool.Wait()
 		timer := time.NewTimer(a.pollingInterval)
 		for {
 			if err := a.run(ctx); err != nil {
-				if !errors.Is(err, gorm.ErrRecordNotFound) {
+				if !errors.Is(err, gorm.ErrRecordNotFound) && !errors.Is(err, agents.ErrWorkerPoolFull) {
 					a.logger.Error("failed run iteration", "err", err)
 				}
 
@@ -190,6 +405,8 @@ func (a *agent) Start(ctx context.Context, wg *sync.WaitGroup) {
 					return
 				case <-timer.C:
 				case <-a.trigger.Triggered():
+					// The server also triggers the agent when a client cancels a request.
+					a.checkCancellations()
 				}
 			}
 
@@ -238,6 +455,18 @@ func (a *agent) Start(ctx context.Context, wg *sync.WaitGroup) {
 				a.logger.Error("Failed to cleanup chat completions", "err", err)
 			}
 
+			if a.storeRawResponses {
+				if err := a.removeRawResponses(a.db.WithContext(ctx)); err != nil {
+					a.logger.Error("Failed to remove expired raw chat completion responses", "err", err)
+				}
+			}
+
+			if a.storageTTL > 0 {
+				if err := a.removeExpiredChatCompletions(a.db.WithContext(ctx)); err != nil {
+					a.logger.Error("Failed to remove expired chat completions", "err", err)
+				}
+			}
+
 			select {
 			case <-ctx.Done():
 				// Ensure the timer channel is drained
@@ -257,20 +486,24 @@ func (a *agent) Start(ctx context.Context, wg *sync.WaitGroup) {
 }
 
 func (a *agent) run(ctx context.Context) error {
+	// Requests are only claimed when there is room for them in the worker pool. Otherwise, they are left in the database
+	// for other agents, or for this agent once a worker is available.
+	if a.pool.Full() {
+		a.logger.Debug("Not claiming a chat completion request, all workers are busy")
+		return agents.ErrWorkerPoolFull
+	}
+
 	a.logger.Debug("Checking for a chat completion request")
 	// Look for a new chat completion request and claim it.
-	cc := new(db.CreateChatCompletionRequest)
-	if err := a.db.WithContext(ctx).Model(cc).Transaction(func(tx *gorm.DB) error {
-		if err := tx.Where("claimed_by IS NULL").Or("claimed_by = ? AND done = false", a.id).Order("created_at desc").First(cc).Error; err != nil {
-			return err
-		}
-
-		if err := tx.Where("id = ?", cc.ID).Updates(map[string]interface{}{"claimed_by": a.id}).Error; err != nil {
-			return err
-		}
+	a.inFlightLock.Lock()
+	inFlight := make([]string, 0, len(a.inFlight))
+	for id := range a.inFlight {
+		inFlight = append(inFlight, id)
+	}
+	a.inFlightLock.Unlock()
 
-		return nil
-	}); err != nil {
+	cc := new(db.CreateChatCompletionRequest)
+	if err := a.queue.Claim(a.db.WithContext(ctx), cc, inFlight...); err != nil {
 		if !errors.Is(err, gorm.ErrRecordNotFound) {
 			a.logger.Error("Failed to get chat completion", "err", err)
 		}
@@ -281,55 +514,418 @@ func (a *agent) run(ctx context.Context) error {
 	chatCompletionID := cc.ID
 	l := a.logger.With("id", chatCompletionID)
 
+	a.inFlightLock.Lock()
+	a.inFlight[chatCompletionID] = struct{}{}
+	a.inFlightLock.Unlock()
+
+	err := a.pool.Submit(ctx, cc.Priority, func(ctx context.Context) {
+		defer func() {
+			a.inFlightLock.Lock()
+			delete(a.inFlight, chatCompletionID)
+			a.inFlightLock.Unlock()
+		}()
+
+		// Keep the claim on the request until it has been processed.
+		keepAliveCtx, stop := context.WithCancel(ctx)
+		defer stop()
+		go func() {
+			if err := a.queue.KeepAlive(keepAliveCtx, a.db.WithContext(keepAliveCtx), cc); err != nil {
+				l.Error("Failed to keep chat completion request claimed", "err", err)
+			}
+		}()
+
+		if err := a.process(ctx, l, cc); err != nil {
+			l.Error("Failed to process chat completion", "err", err)
+		}
+	})
+	if err == nil {
+		return nil
+	}
+
+	a.inFlightLock.Lock()
+	delete(a.inFlight, chatCompletionID)
+	a.inFlightLock.Unlock()
+
+	if !errors.Is(err, agents.ErrWorkerPoolFull) {
+		return err
+	}
+
+	l.Debug("Releasing chat completion request, all workers are busy")
+	if releaseErr := a.queue.Release(a.db.WithContext(ctx), cc); releaseErr != nil {
+		l.Error("Failed to release chat completion request", "err", releaseErr)
+	}
+	return err
+}
+
+func (a *agent) process(ctx context.Context, l *slog.Logger, cc *db.CreateChatCompletionRequest) error {
+	chatCompletionID := cc.ID
+
 	url := cc.ModelAPI
 	if url == "" {
 		url = a.url
 	}
 
+	if cc.Cancelled {
+		l.Debug("Chat completion request was cancelled before it was processed")
+		return a.reject(ctx, cc, statusClientClosedRequest, errRequestCancelled)
+	}
+
+	if err := a.breaker.Allow(); err != nil {
+		l.Warn("Rejecting chat completion request", "err", err)
+		return a.reject(ctx, cc, http.StatusServiceUnavailable, err)
+	}
+
+	if err := a.db.WithContext(ctx).Model(cc).Where("id = ?", chatCompletionID).Update("status", string(openai.InProgress)).Error; err != nil {
+		l.Error("Failed to mark chat completion request in progress", "err", err)
+		return err
+	}
+
+	a.normalize(l, cc)
+	a.storePrompt(ctx, l, cc)
+
+	// The request to the model provider is aborted if the client disconnects.
+	cancelCtx, cancelRequest := context.WithCancelCause(ctx)
+	defer cancelRequest(nil)
+	go a.watchForCancellation(cancelCtx, l, chatCompletionID, cancelRequest)
+
 	l.Debug("Found chat completion", "cc", cc)
 	if z.Dereference(cc.Stream) {
-		l.Debug("Streaming chat completion...")
-		stream, err := agents.StreamChatCompletionRequest(ctx, l, a.client, url, a.apiKey, cc)
+		return a.processStream(ctx, cancelCtx, l, url, cc)
+	}
+
+	var ccr *db.CreateChatCompletionResponse
+	models := a.modelChain(cc.Model)
+	for i, model := range models {
+		cc.Model = model
+
+		for retries := 0; ; retries++ {
+			// Only the request to the model provider is bounded by the timeout, the response must still be stored if it
+			// expires.
+			reqCtx, cancel := a.requestContext(cancelCtx, model)
+			var err error
+			ccr, err = agents.MakeChatCompletionRequest(reqCtx, l, a.client, url, a.apiKey, cc)
+			if err != nil {
+				cancel()
+				// The request was never sent to the model provider.
+				a.breaker.Release()
+				l.Error("Failed to make chat completion request", "err", err)
+				return err
+			}
+			a.recordResult(reqCtx, ccr.StatusCode)
+			cancel()
+
+			// A request to a model that the provider is out of capacity for isn't retried if there is a model to fall
+			// back to.
+			if i < len(models)-1 && isCapacityError(ccr.StatusCode, z.Dereference(ccr.Error)) || !a.retryPolicy.ShouldRetry(retries, ccr.StatusCode) {
+				break
+			}
+
+			l.Warn("Retrying chat completion request", "model", model, "status_code", ccr.StatusCode, "retry", retries+1)
+			if err = a.retryPolicy.Wait(cancelCtx, retries); err != nil {
+				// The last response is stored if the request is cancelled while waiting to retry.
+				break
+			}
+			if err = a.breaker.Allow(); err != nil {
+				l.Warn("Rejecting chat completion request", "err", err)
+				return a.reject(ctx, cc, http.StatusServiceUnavailable, err)
+			}
+		}
+
+		if i == len(models)-1 || !isCapacityError(ccr.StatusCode, z.Dereference(ccr.Error)) {
+			break
+		}
+
+		l.Warn("Model provider is out of capacity, falling back to another model", "model", model, "fallback", models[i+1], "status_code", ccr.StatusCode)
+		if err := a.breaker.Allow(); err != nil {
+			l.Warn("Rejecting chat completion request", "err", err)
+			return a.reject(ctx, cc, http.StatusServiceUnavailable, err)
+		}
+	}
+	if ccr.Model == "" {
+		ccr.Model = cc.Model
+	}
+	ccr.RawResponse = a.rawResponse(l, ccr.RawResponse)
+
+	l.Debug("Made chat completion request", "status_code", ccr.StatusCode, "err", ccr.Error)
+
+	if err := a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := db.Create(tx, ccr); err != nil {
+			return err
+		}
+		return tx.Model(cc).Where("id = ?", chatCompletionID).
+			Updates(map[string]any{"done": true, "status": db.ChatCompletionDoneStatus(ccr.Error != nil)}).Error
+	}); err != nil {
+		l.Error("Failed to create chat completion response", "err", err)
+		return err
+	}
+
+	a.trigger.Ready(chatCompletionID)
+	a.reportCompletionUsage(ctx, chatCompletionID, ccr)
+	return nil
+}
+
+// reportCompletionUsage reports the usage of the chat completion response, if usage is reported and the model provider
+// responded with the usage.
+func (a *agent) reportCompletionUsage(ctx context.Context, chatCompletionID string, ccr *db.CreateChatCompletionResponse) {
+	if a.reportUsage == nil || ccr.Error != nil {
+		return
+	}
+
+	if usage := ccr.Usage.Data(); usage != nil {
+		a.reportUsage(ctx, chatCompletionID, ccr.Model, *usage)
+	}
+}
+
+// processStream makes a streaming chat completion request to the model provider, retrying it if the provider responds
+// with a retryable error and falling back to other models if the provider is out of capacity, and stores the chunks of
+// the stream.
+func (a *agent) processStream(ctx, cancelCtx context.Context, l *slog.Logger, url string, cc *db.CreateChatCompletionRequest) error {
+	l.Debug("Streaming chat completion...")
+
+	// The time to first token includes the time spent on requests to the models that are out of capacity.
+	dispatched := time.Now()
+	models := a.modelChain(cc.Model)
+	for i, retries := 0, 0; i < len(models); {
+		model := models[i]
+		cc.Model = model
+
+		reqCtx, cancel := a.requestContext(cancelCtx, model)
+		stream, err := agents.StreamChatCompletionRequest(reqCtx, l, a.client, url, a.apiKey, cc)
 		if err != nil {
+			// The model provider didn't respond, which is recorded as a failure unless the client cancelled the request.
+			a.recordResult(reqCtx, 0)
+			cancelled := errors.Is(context.Cause(reqCtx), errRequestCancelled)
+			cancel()
+			if cancelled {
+				return a.reject(ctx, cc, statusClientClosedRequest, errRequestCancelled)
+			}
 			l.Error("Failed to stream chat completion request", "err", err)
 			return err
 		}
 
-		if err = streamResponses(l, a.db.WithContext(ctx), chatCompletionID, stream); err != nil {
+		// A model provider that is out of capacity, or failing, responds with an error instead of starting the stream.
+		first, ok := <-stream
+		fallBack := ok && first.Error != nil && i < len(models)-1 && isCapacityError(first.StatusCode, *first.Error)
+		retry := ok && first.Error != nil && !fallBack && a.retryPolicy.ShouldRetry(retries, first.StatusCode)
+		if retry {
+			l.Warn("Retrying chat completion request", "model", model, "status_code", first.StatusCode, "retry", retries+1)
+			// The error is streamed if the request is cancelled while waiting to retry.
+			retry = a.retryPolicy.Wait(cancelCtx, retries) == nil
+		}
+		if fallBack || retry {
+			a.recordResult(reqCtx, first.StatusCode)
+			//nolint:revive
+			for range stream {
+			}
+			cancel()
+
+			if fallBack {
+				l.Warn("Model provider is out of capacity, falling back to another model", "model", model, "fallback", models[i+1], "status_code", first.StatusCode)
+				i, retries = i+1, 0
+			} else {
+				retries++
+			}
+			if err = a.breaker.Allow(); err != nil {
+				l.Warn("Rejecting chat completion request", "err", err)
+				return a.reject(ctx, cc, http.StatusServiceUnavailable, err)
+			}
+			continue
+		}
+
+		statusCode, err := streamResponses(l, a.db.WithContext(ctx), cc.ID, dispatched, prependChunk(first, ok, stream))
+		if err != nil {
 			l.Error("Failed to stream chat completion responses", "err", err)
 		}
+		a.recordResult(reqCtx, statusCode)
+		cancel()
 
 		return nil
 	}
 
-	ccr, err := agents.MakeChatCompletionRequest(ctx, l, a.client, url, a.apiKey, cc)
-	if err != nil {
-		l.Error("Failed to make chat completion request", "err", err)
-		return err
+	return nil
+}
+
+// prependChunk returns a stream with the chunk, if ok, followed by the chunks of the stream.
+func prependChunk(chunk db.ChatCompletionResponseChunk, ok bool, stream <-chan db.ChatCompletionResponseChunk) <-chan db.ChatCompletionResponseChunk {
+	result := make(chan db.ChatCompletionResponseChunk, 1)
+	go func() {
+		defer close(result)
+		if !ok {
+			return
+		}
+
+		result <- chunk
+		for chunk := range stream {
+			result <- chunk
+		}
+	}()
+
+	return result
+}
+
+// modelChain returns the model followed by its fallbacks.
+func (a *agent) modelChain(model string) []string {
+	return append([]string{model}, a.modelFallbacks[model]...)
+}
+
+// isCapacityError returns true if the response from the model provider indicates that it doesn't have the capacity to
+// serve the request for the model, but may be able to serve it for another model.
+func isCapacityError(statusCode int, errMessage string) bool {
+	return statusCode == http.StatusServiceUnavailable ||
+		statusCode == http.StatusTooManyRequests && strings.Contains(errMessage, "insufficient_quota")
+}
+
+// requestContext returns a context for a request to the model provider that is bounded by the timeout for the model.
+func (a *agent) requestContext(ctx context.Context, model string) (context.Context, context.CancelFunc) {
+	timeout, ok := a.modelRequestTimeouts[model]
+	if !ok {
+		timeout = a.requestTimeout
+	}
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
 	}
 
-	l.Debug("Made chat completion request", "status_code", ccr.StatusCode, "err", ccr.Error)
+	return context.WithTimeout(ctx, timeout)
+}
+
+// recordResult records the result of a request to the model provider with the circuit breaker. Requests that were
+// cancelled by the client are not counted.
+func (a *agent) recordResult(reqCtx context.Context, statusCode int) {
+	if errors.Is(context.Cause(reqCtx), errRequestCancelled) {
+		a.breaker.Release()
+		return
+	}
+
+	a.breaker.Record(agents.IsProviderFailure(statusCode))
+}
+
+// watchForCancellation polls the chat completion request until the context is done, and cancels the context with
+// errRequestCancelled if the client has cancelled the request. The request is also checked when the agent is triggered.
+func (a *agent) watchForCancellation(ctx context.Context, l *slog.Logger, chatCompletionID string, cancel context.CancelCauseFunc) {
+	timer := time.NewTimer(a.pollingInterval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		case <-a.cancellationChecked():
+			if !timer.Stop() {
+				<-timer.C
+			}
+		}
+
+		var cancelled []bool
+		if err := a.db.WithContext(ctx).Model(new(db.CreateChatCompletionRequest)).Where("id = ?", chatCompletionID).Pluck("cancelled", &cancelled).Error; err != nil {
+			if ctx.Err() == nil {
+				l.Error("Failed to check if chat completion request was cancelled", "err", err)
+			}
+		} else if len(cancelled) > 0 && cancelled[0] {
+			l.Debug("Chat completion request was cancelled, aborting request to model provider")
+			cancel(errRequestCancelled)
+			return
+		}
+
+		timer.Reset(a.pollingInterval)
+	}
+}
+
+// cancellationChecked returns a channel that is closed the next time the requests being processed should be checked
+// for cancellation.
+func (a *agent) cancellationChecked() <-chan struct{} {
+	a.cancellationCheckLock.Lock()
+	defer a.cancellationCheckLock.Unlock()
+	return a.cancellationCheck
+}
+
+// checkCancellations wakes the watchers of the requests being processed to check if they have been cancelled.
+func (a *agent) checkCancellations() {
+	a.cancellationCheckLock.Lock()
+	defer a.cancellationCheckLock.Unlock()
+	close(a.cancellationCheck)
+	a.cancellationCheck = make(chan struct{})
+}
+
+// normalize prepares the chat completion request to be sent to the model provider.
+func (a *agent) normalize(l *slog.Logger, cc *db.CreateChatCompletionRequest) {
+	a.replaceDeprecatedModel(l, cc)
+	a.applyDefaultParameters(l, cc)
+	// Duplicates are removed, and the history is windowed, before the roles are remapped, since system messages may be
+	// sent with another role.
+	a.deduplicateSystemMessages(l, cc)
+	a.windowHistory(l, cc)
+	a.remapRoles(l, cc)
+}
+
+// storePrompt stores the messages of the normalized chat completion request, which are the messages the model provider
+// receives, if prompts are stored. The request is still sent if its prompt can't be stored.
+func (a *agent) storePrompt(ctx context.Context, l *slog.Logger, cc *db.CreateChatCompletionRequest) {
+	if !a.storePrompts {
+		return
+	}
+
+	cc.Prompt = cc.Messages
+	if err := a.db.WithContext(ctx).Model(cc).Where("id = ?", cc.ID).Update("prompt", cc.Prompt).Error; err != nil {
+		l.Warn("Failed to store chat completion prompt", "err", err)
+	}
+}
+
+// replaceDeprecatedModel rewrites the model of the chat completion request to its replacement if the model has been retired.
+func (a *agent) replaceDeprecatedModel(l *slog.Logger, cc *db.CreateChatCompletionRequest) {
+	replacement, ok := a.modelDeprecations[cc.Model]
+	if !ok || replacement == "" {
+		return
+	}
+
+	l.Warn("Model is deprecated, using replacement", "model", cc.Model, "replacement", replacement)
+	cc.Model = replacement
+}
+
+// reject will store an error response for the chat completion request without sending it to the model provider.
+func (a *agent) reject(ctx context.Context, cc *db.CreateChatCompletionRequest, statusCode int, err error) error {
+	jobResponse := db.JobResponse{
+		RequestID:  cc.ID,
+		Error:      z.Pointer(err.Error()),
+		StatusCode: statusCode,
+		Done:       true,
+	}
 
 	if err = a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		if err = db.Create(tx, ccr); err != nil {
+		if z.Dereference(cc.Stream) {
+			if err := db.Create(tx, &db.ChatCompletionResponseChunk{JobResponse: jobResponse}); err != nil {
+				return err
+			}
+		} else if err := db.Create(tx, &db.CreateChatCompletionResponse{JobResponse: jobResponse}); err != nil {
 			return err
 		}
-		return tx.Model(cc).Where("id = ?", chatCompletionID).Update("done", true).Error
+
+		return tx.Model(cc).Where("id = ?", cc.ID).Updates(map[string]any{"done": true, "status": db.ChatCompletionDoneStatus(true)}).Error
 	}); err != nil {
-		l.Error("Failed to create chat completion response", "err", err)
 		return err
 	}
 
-	a.trigger.Ready(chatCompletionID)
+	a.trigger.Ready(cc.ID)
 	return nil
 }
 
-func streamResponses(l *slog.Logger, gdb *gorm.DB, chatCompletionID string, stream <-chan db.ChatCompletionResponseChunk) error {
+// streamResponses stores the chunks of the stream as they are received. The returned status code is that of the first error
+// in the stream, or http.StatusOK if there are no errors.
+func streamResponses(l *slog.Logger, gdb *gorm.DB, chatCompletionID string, dispatched time.Time, stream <-chan db.ChatCompletionResponseChunk) (int, error) {
 	var (
-		index int
-		errs  []error
+		index            int
+		errs             []error
+		statusCode       = http.StatusOK
+		timeToFirstToken *int
 	)
 	for chunk := range stream {
+		if chunk.Error != nil && statusCode == http.StatusOK {
+			statusCode = chunk.StatusCode
+		}
+		if timeToFirstToken == nil && hasContent(chunk) {
+			timeToFirstToken = z.Pointer(int(time.Since(dispatched).Milliseconds()))
+			l.Debug("Received first token", "time_to_first_token_ms", *timeToFirstToken)
+		}
 		chunk.RequestID = chatCompletionID
 		chunk.ResponseIdx = index
 		index++
@@ -351,11 +947,24 @@ func streamResponses(l *slog.Logger, gdb *gorm.DB, chatCompletionID string, stre
 			return err
 		}
 
-		return tx.Model(new(db.CreateChatCompletionRequest)).Where("id = ?", chatCompletionID).Update("done", true).Error
+		return tx.Model(new(db.CreateChatCompletionRequest)).Where("id = ?", chatCompletionID).
+			Updates(map[string]any{"done": true, "status": db.ChatCompletionDoneStatus(statusCode != http.StatusOK), "time_to_first_token": timeToFirstToken}).Error
 	}); err != nil {
 		l.Error("Failed to create final chat completion response chunk", "err", err)
 		errs = append(errs, err)
 	}
 
-	return errors.Join(errs...)
+	return statusCode, errors.Join(errs...)
+}
+
+// hasContent returns true if the chunk has content or tool calls generated by the model.
+func hasContent(chunk db.ChatCompletionResponseChunk) bool {
+	for _, choice := range chunk.Choices {
+		delta := choice.Delta.Data()
+		if z.Dereference(delta.Content) != "" || len(z.Dereference(delta.ToolCalls)) > 0 || delta.FunctionCall != nil {
+			return true
+		}
+	}
+
+	return false
 }
@@ -0,0 +1,1363 @@
+package chatcompletion
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/acorn-io/z"
+	"github.com/gptscript-ai/clicky-chats/pkg/agents"
+	"github.com/gptscript-ai/clicky-chats/pkg/agents/agentstest"
+	"github.com/gptscript-ai/clicky-chats/pkg/db"
+	"github.com/gptscript-ai/clicky-chats/pkg/generated/openai"
+	"github.com/gptscript-ai/clicky-chats/pkg/trigger"
+)
+
+// newTestAgent returns an agent with the config that stores its data in a new database. The logger, polling interval,
+// and retention period default to the minimums if they aren't set.
+func newTestAgent(t *testing.T, cfg Config) (*agent, *db.DB) {
+	t.Helper()
+
+	gdb, err := db.New("sqlite://"+filepath.Join(t.TempDir(), "clicky-chats.db"), true)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	if err = gdb.AutoMigrate(); err != nil {
+		t.Fatalf("failed to migrate database: %v", err)
+	}
+
+	if cfg.Logger == nil {
+		cfg.Logger = slog.Default()
+	}
+	if cfg.PollingInterval == 0 {
+		cfg.PollingInterval = minPollingInterval
+	}
+	if cfg.RetentionPeriod == 0 {
+		cfg.RetentionPeriod = minRequestRetention
+	}
+
+	a, err := newAgent(gdb, cfg)
+	if err != nil {
+		t.Fatalf("newAgent() error = %v", err)
+	}
+
+	return a, gdb
+}
+
+func TestReplaceDeprecatedModel(t *testing.T) {
+	a, err := newAgent(nil, Config{
+		Logger:            slog.Default(),
+		PollingInterval:   minPollingInterval,
+		RetentionPeriod:   minRequestRetention,
+		ModelDeprecations: map[string]string{"gpt-3.5-turbo-0613": "gpt-3.5-turbo-0125"},
+	})
+	if err != nil {
+		t.Fatalf("newAgent() error = %v", err)
+	}
+
+	type testCase struct {
+		name, model, want string
+	}
+	tests := []testCase{
+		{
+			name:  "Default deprecation",
+			model: "gpt-4-0314",
+			want:  "gpt-4",
+		},
+		{
+			name:  "Configured deprecation overrides default",
+			model: "gpt-3.5-turbo-0613",
+			want:  "gpt-3.5-turbo-0125",
+		},
+		{
+			name:  "Model not deprecated",
+			model: "gpt-4-turbo-preview",
+			want:  "gpt-4-turbo-preview",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cc := &db.CreateChatCompletionRequest{Model: tt.model}
+			a.replaceDeprecatedModel(slog.Default(), cc)
+			if cc.Model != tt.want {
+				t.Errorf("replaceDeprecatedModel() model = %q, want %q", cc.Model, tt.want)
+			}
+		})
+	}
+}
+
+func TestModelRequestTimeout(t *testing.T) {
+	provider := agentstest.NewFakeProvider(func(*openai.CreateChatCompletionRequest) agentstest.Response {
+		return agentstest.Response{Content: "Done thinking.", Latency: 200 * time.Millisecond}
+	})
+	defer provider.Close()
+
+	a, err := newAgent(nil, Config{
+		Logger:               slog.Default(),
+		PollingInterval:      minPollingInterval,
+		RetentionPeriod:      minRequestRetention,
+		RequestTimeout:       50 * time.Millisecond,
+		ModelRequestTimeouts: map[string]time.Duration{"o1-preview": 5 * time.Second},
+	})
+	if err != nil {
+		t.Fatalf("newAgent() error = %v", err)
+	}
+
+	type testCase struct {
+		name, model string
+		wantErr     bool
+	}
+	tests := []testCase{
+		{
+			name:    "Default timeout aborts slow request",
+			model:   "gpt-3.5-turbo",
+			wantErr: true,
+		},
+		{
+			name:  "Model timeout overrides default",
+			model: "o1-preview",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reqCtx, cancel := a.requestContext(context.Background(), tt.model)
+			defer cancel()
+
+			ccr, err := agents.MakeChatCompletionRequest(reqCtx, slog.Default(), a.client, provider.URL(), "", &db.CreateChatCompletionRequest{Model: tt.model})
+			if err != nil {
+				t.Fatalf("MakeChatCompletionRequest() error = %v", err)
+			}
+
+			if (ccr.Error != nil) != tt.wantErr {
+				t.Errorf("MakeChatCompletionRequest() response error = %v, wantErr %v", z.Dereference(ccr.Error), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRequestBody(t *testing.T) {
+	publicRequest := new(openai.CreateChatCompletionRequest)
+	if err := json.Unmarshal([]byte(`{
+		"model": "gpt-4-0314",
+		"messages": [{"role": "system", "content": "You are a helpful assistant."}, {"role": "user", "content": "Hello"}],
+		"stream": true,
+		"user": "user-1234",
+		"response_format": {
+			"type": "json_schema",
+			"json_schema": {
+				"name": "greeting",
+				"description": "A greeting for the user.",
+				"schema": {"type": "object", "properties": {"greeting": {"type": "string"}}, "required": ["greeting"], "additionalProperties": false},
+				"strict": true
+			}
+		}
+	}`), publicRequest); err != nil {
+		t.Fatalf("failed to unmarshal request: %v", err)
+	}
+
+	cc := new(db.CreateChatCompletionRequest)
+	if err := cc.FromPublic(publicRequest); err != nil {
+		t.Fatalf("FromPublic() error = %v", err)
+	}
+
+	body, err := RequestBody(Config{ModelDeprecations: map[string]string{"gpt-4-0314": "gpt-4-turbo-preview"}}, cc)
+	if err != nil {
+		t.Fatalf("RequestBody() error = %v", err)
+	}
+
+	var got map[string]any
+	if err = json.Unmarshal(body, &got); err != nil {
+		t.Fatalf("failed to unmarshal request body: %v", err)
+	}
+
+	if got["model"] != "gpt-4-turbo-preview" {
+		t.Errorf("model = %v, want %q", got["model"], "gpt-4-turbo-preview")
+	}
+	if got["stream"] != true {
+		t.Errorf("stream = %v, want true", got["stream"])
+	}
+	if got["user"] != "user-1234" {
+		t.Errorf("user = %v, want %q", got["user"], "user-1234")
+	}
+
+	responseFormat, _ := got["response_format"].(map[string]any)
+	if responseFormat["type"] != "json_schema" {
+		t.Errorf("response_format.type = %v, want %q", responseFormat["type"], "json_schema")
+	}
+	jsonSchema, _ := responseFormat["json_schema"].(map[string]any)
+	if jsonSchema["name"] != "greeting" || jsonSchema["description"] != "A greeting for the user." || jsonSchema["strict"] != true {
+		t.Errorf("response_format.json_schema = %v, want the name, description, and strict flag to be kept", jsonSchema)
+	}
+	if schema, _ := jsonSchema["schema"].(map[string]any); schema["additionalProperties"] != false {
+		t.Errorf("response_format.json_schema.schema = %v, want the schema to be kept", jsonSchema["schema"])
+	}
+
+	messages, _ := got["messages"].([]any)
+	if len(messages) != 2 {
+		t.Fatalf("expected 2 messages, got %v", got["messages"])
+	}
+	if system, _ := messages[0].(map[string]any); system["role"] != "system" || system["content"] != "You are a helpful assistant." {
+		t.Errorf("first message = %v, want the system message", messages[0])
+	}
+
+	// The request itself should not be modified.
+	if cc.Model != "gpt-4-0314" {
+		t.Errorf("request model = %q, want it to be unchanged", cc.Model)
+	}
+}
+
+func TestListAndStoreSupportedModels(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"data": [
+			{"id": "gpt-4", "object": "model", "created": 1687882411, "owned_by": "openai"},
+			{"id": "gpt-4o", "object": "model", "created": 1715367049, "owned_by": "system"},
+			{"id": "dall-e-3", "object": "model", "created": 1698785189, "owned_by": "system"}
+		]}`))
+	}))
+	defer srv.Close()
+
+	a, gdb := newTestAgent(t, Config{
+		SupportedModels: []string{"gpt-4o", "dall-e-3", "gpt-5"},
+	})
+
+	if err := a.listAndStoreModels(context.Background(), srv.URL); err != nil {
+		t.Fatalf("listAndStoreModels() error = %v", err)
+	}
+
+	var models []db.Model
+	if err := gdb.WithContext(context.Background()).Order("id").Find(&models).Error; err != nil {
+		t.Fatalf("failed to list models: %v", err)
+	}
+
+	// Only the configured models that are available from the provider should be listed.
+	var ids []string
+	for _, model := range models {
+		ids = append(ids, model.ID)
+	}
+	if want := []string{"dall-e-3", "gpt-4o"}; !slices.Equal(ids, want) {
+		t.Errorf("listed models = %v, want %v", ids, want)
+	}
+}
+
+func TestAbortOnClientDisconnect(t *testing.T) {
+	started, aborted := make(chan struct{}), make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`data: {"id": "chatcmpl-1", "object": "chat.completion.chunk", "created": 1687882411, "model": "gpt-4", "choices": [{"index": 0, "delta": {"content": "Hello"}}]}` + "\n\n"))
+		w.(http.Flusher).Flush()
+		close(started)
+
+		// Never finish the stream, the request should be aborted when the client disconnects.
+		<-r.Context().Done()
+		close(aborted)
+	}))
+	defer srv.Close()
+
+	a, gdb := newTestAgent(t, Config{
+		ChatCompletionURL: srv.URL,
+	})
+	a.pollingInterval = 10 * time.Millisecond
+
+	ctx := context.Background()
+	cc := &db.CreateChatCompletionRequest{Model: "gpt-4", Stream: z.Pointer(true)}
+	if err := db.Create(gdb.WithContext(ctx), cc); err != nil {
+		t.Fatalf("failed to create chat completion request: %v", err)
+	}
+
+	processed := make(chan error, 1)
+	go func() {
+		processed <- a.process(ctx, slog.Default(), cc)
+	}()
+
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for request to model provider")
+	}
+
+	// This is what the server does when the client disconnects.
+	if cancelled, err := db.CancelChatCompletion(gdb.WithContext(ctx), cc.ID); err != nil || !cancelled {
+		t.Fatalf("CancelChatCompletion() = %v, %v, want the request to be cancelled", cancelled, err)
+	}
+
+	select {
+	case <-aborted:
+	case <-time.After(5 * time.Second):
+		t.Fatal("request to model provider was not aborted")
+	}
+
+	select {
+	case err := <-processed:
+		if err != nil {
+			t.Errorf("process() error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for chat completion to be processed")
+	}
+
+	stored := new(db.CreateChatCompletionRequest)
+	if err := db.Get(gdb.WithContext(ctx), stored, cc.ID); err != nil {
+		t.Fatalf("failed to get chat completion request: %v", err)
+	}
+	if !stored.Done {
+		t.Errorf("chat completion request should be done after it is aborted")
+	}
+	if stored.Status != string(openai.Cancelled) {
+		t.Errorf("status = %q, want %q", stored.Status, openai.Cancelled)
+	}
+
+	// The truncated stream is stored with an error, so that it isn't mistaken for a complete one.
+	var chunks []db.ChatCompletionResponseChunk
+	if err := gdb.WithContext(ctx).Where("request_id = ?", cc.ID).Order("response_idx asc").Find(&chunks).Error; err != nil {
+		t.Fatalf("failed to get chat completion response chunks: %v", err)
+	}
+	if !slices.ContainsFunc(chunks, func(chunk db.ChatCompletionResponseChunk) bool { return chunk.GetErrorString() != "" }) {
+		t.Errorf("no error chunk was stored for the aborted stream: %+v", chunks)
+	}
+}
+
+func TestAbortOnCancellationTrigger(t *testing.T) {
+	started, aborted := make(chan struct{}), make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+		close(started)
+
+		<-r.Context().Done()
+		close(aborted)
+	}))
+	defer srv.Close()
+
+	trig := trigger.New()
+	a, gdb := newTestAgent(t, Config{
+		ChatCompletionURL: srv.URL,
+		Trigger:           trig,
+	})
+	// The cancellation is only noticed in time if the trigger wakes the watcher.
+	a.pollingInterval = time.Hour
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cc := &db.CreateChatCompletionRequest{Model: "gpt-4", Stream: z.Pointer(true)}
+	if err := db.Create(gdb.WithContext(ctx), cc); err != nil {
+		t.Fatalf("failed to create chat completion request: %v", err)
+	}
+
+	wg := new(sync.WaitGroup)
+	a.Start(ctx, wg)
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for request to model provider")
+	}
+
+	if cancelled, err := db.CancelChatCompletion(gdb.WithContext(ctx), cc.ID); err != nil || !cancelled {
+		t.Fatalf("CancelChatCompletion() = %v, %v, want the request to be cancelled", cancelled, err)
+	}
+
+	// The kick is dropped if the agent isn't waiting for it, so it is repeated until the request is aborted.
+	deadline := time.After(5 * time.Second)
+	for {
+		trig.Kick(cc.ID)
+		select {
+		case <-aborted:
+			return
+		case <-deadline:
+			t.Fatal("request to model provider was not aborted")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+func TestStoreRawResponses(t *testing.T) {
+	provider := agentstest.NewFakeProvider(func(*openai.CreateChatCompletionRequest) agentstest.Response {
+		return agentstest.Response{Content: "My secret answer."}
+	})
+	defer provider.Close()
+
+	type testCase struct {
+		name       string
+		enabled    bool
+		redactions []string
+		// wantRawContent is the content expected in the raw response, or empty if no raw response should be stored.
+		wantRawContent string
+	}
+	tests := []testCase{
+		{
+			name: "Raw response is not stored when disabled",
+		},
+		{
+			name:           "Raw response is stored when enabled",
+			enabled:        true,
+			wantRawContent: "My secret answer.",
+		},
+		{
+			name:           "Raw response is redacted",
+			enabled:        true,
+			redactions:     []string{"content"},
+			wantRawContent: "[REDACTED]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, gdb := newTestAgent(t, Config{
+				ChatCompletionURL:     provider.URL(),
+				StoreRawResponses:     tt.enabled,
+				RawResponseRedactions: tt.redactions,
+			})
+
+			ctx := context.Background()
+			cc := &db.CreateChatCompletionRequest{Model: "gpt-4"}
+			if err := db.Create(gdb.WithContext(ctx), cc); err != nil {
+				t.Fatalf("failed to create chat completion request: %v", err)
+			}
+
+			if err := a.process(ctx, slog.Default(), cc); err != nil {
+				t.Fatalf("process() error = %v", err)
+			}
+
+			ccr := new(db.CreateChatCompletionResponse)
+			if err := gdb.WithContext(ctx).Where("request_id = ?", cc.ID).First(ccr).Error; err != nil {
+				t.Fatalf("failed to get chat completion response: %v", err)
+			}
+
+			// The parsed response is never redacted.
+			if choices := ccr.Choices; len(choices) != 1 || z.Dereference(choices[0].Message.Data().Content) != "My secret answer." {
+				t.Errorf("unexpected choices: %#v", choices)
+			}
+
+			if tt.wantRawContent == "" {
+				if ccr.RawResponse != nil {
+					t.Errorf("raw response = %q, want none", *ccr.RawResponse)
+				}
+				return
+			}
+
+			if ccr.RawResponse == nil {
+				t.Fatal("raw response was not stored")
+			}
+
+			var raw openai.CreateChatCompletionResponse
+			if err := json.Unmarshal([]byte(*ccr.RawResponse), &raw); err != nil {
+				t.Fatalf("failed to unmarshal raw response: %v", err)
+			}
+			if len(raw.Choices) != 1 || z.Dereference(raw.Choices[0].Message.Content) != tt.wantRawContent {
+				t.Errorf("unexpected raw response: %s", *ccr.RawResponse)
+			}
+		})
+	}
+}
+
+func TestStoreFinishReason(t *testing.T) {
+	provider := agentstest.NewFakeProvider(func(*openai.CreateChatCompletionRequest) agentstest.Response {
+		return agentstest.Response{Content: "The answer is cut", FinishReason: string(openai.CreateChatCompletionResponseChoicesFinishReasonLength)}
+	})
+	defer provider.Close()
+
+	a, gdb := newTestAgent(t, Config{
+		ChatCompletionURL: provider.URL(),
+	})
+
+	ctx := context.Background()
+	cc := &db.CreateChatCompletionRequest{Model: "gpt-4", MaxTokens: z.Pointer(4)}
+	if err := db.Create(gdb.WithContext(ctx), cc); err != nil {
+		t.Fatalf("failed to create chat completion request: %v", err)
+	}
+
+	if err := a.process(ctx, slog.Default(), cc); err != nil {
+		t.Fatalf("process() error = %v", err)
+	}
+
+	ccr := new(db.CreateChatCompletionResponse)
+	if err := gdb.WithContext(ctx).Where("request_id = ?", cc.ID).First(ccr).Error; err != nil {
+		t.Fatalf("failed to get chat completion response: %v", err)
+	}
+	if len(ccr.Choices) != 1 || ccr.Choices[0].FinishReason != string(openai.CreateChatCompletionResponseChoicesFinishReasonLength) {
+		t.Fatalf("stored choices = %#v, want one choice with finish reason length", ccr.Choices)
+	}
+
+	// The finish reason is returned when the chat completion is retrieved.
+	resp, ok := ccr.ToPublic().(*openai.CreateChatCompletionResponse)
+	if !ok {
+		t.Fatalf("ToPublic() returned %T", ccr.ToPublic())
+	}
+	if finishReason := resp.Choices[0].FinishReason; finishReason != openai.CreateChatCompletionResponseChoicesFinishReasonLength {
+		t.Errorf("finish reason = %s, want %s", finishReason, openai.CreateChatCompletionResponseChoicesFinishReasonLength)
+	}
+}
+
+func TestApplyDefaultParameters(t *testing.T) {
+	defaults, err := ParseParameters(map[string]string{"temperature": "0.2", "max_tokens": "512"})
+	if err != nil {
+		t.Fatalf("ParseParameters() error = %v", err)
+	}
+
+	type testCase struct {
+		name             string
+		locked           []string
+		request          *db.CreateChatCompletionRequest
+		wantTemperature  *float32
+		wantMaxTokens    *int
+		wantTopP         *float32
+		wantNewAgentFail bool
+	}
+	tests := []testCase{
+		{
+			name:            "Omitted parameters get the defaults",
+			request:         &db.CreateChatCompletionRequest{Model: "gpt-4", TopP: z.Pointer[float32](0.5)},
+			wantTemperature: z.Pointer[float32](0.2),
+			wantMaxTokens:   z.Pointer(512),
+			wantTopP:        z.Pointer[float32](0.5),
+		},
+		{
+			name:            "Request overrides the defaults",
+			request:         &db.CreateChatCompletionRequest{Model: "gpt-4", Temperature: z.Pointer[float32](1), MaxTokens: z.Pointer(10)},
+			wantTemperature: z.Pointer[float32](1),
+			wantMaxTokens:   z.Pointer(10),
+		},
+		{
+			name:            "Locked parameters ignore the request",
+			locked:          []string{"temperature", "top_p"},
+			request:         &db.CreateChatCompletionRequest{Model: "gpt-4", Temperature: z.Pointer[float32](1), TopP: z.Pointer[float32](0.5), MaxTokens: z.Pointer(10)},
+			wantTemperature: z.Pointer[float32](0.2),
+			wantMaxTokens:   z.Pointer(10),
+		},
+		{
+			name:             "Unknown locked parameter",
+			locked:           []string{"seed"},
+			wantNewAgentFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := newAgent(nil, Config{
+				Logger:            slog.Default(),
+				PollingInterval:   minPollingInterval,
+				RetentionPeriod:   minRequestRetention,
+				DefaultParameters: defaults,
+				LockedParameters:  tt.locked,
+			})
+			if (err != nil) != tt.wantNewAgentFail {
+				t.Fatalf("newAgent() error = %v, wantErr %v", err, tt.wantNewAgentFail)
+			}
+			if err != nil {
+				return
+			}
+
+			a.applyDefaultParameters(slog.Default(), tt.request)
+
+			if !equalPointers(tt.request.Temperature, tt.wantTemperature) {
+				t.Errorf("temperature = %v, want %v", z.Dereference(tt.request.Temperature), z.Dereference(tt.wantTemperature))
+			}
+			if !equalPointers(tt.request.TopP, tt.wantTopP) {
+				t.Errorf("top_p = %v, want %v", z.Dereference(tt.request.TopP), z.Dereference(tt.wantTopP))
+			}
+			if !equalPointers(tt.request.MaxTokens, tt.wantMaxTokens) {
+				t.Errorf("max_tokens = %v, want %v", z.Dereference(tt.request.MaxTokens), z.Dereference(tt.wantMaxTokens))
+			}
+		})
+	}
+}
+
+func equalPointers[T comparable](a, b *T) bool {
+	return (a == nil && b == nil) || (a != nil && b != nil && *a == *b)
+}
+
+func TestResponseIDAndCreated(t *testing.T) {
+	// The provider omits the id and created fields of the response.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{
+			"object": "chat.completion",
+			"model": "gpt-4",
+			"choices": [{"index": 0, "finish_reason": "stop", "message": {"role": "assistant", "content": "Hello"}}]
+		}`))
+	}))
+	defer srv.Close()
+
+	a, gdb := newTestAgent(t, Config{
+		ChatCompletionURL: srv.URL,
+	})
+
+	ctx := context.Background()
+	cc := &db.CreateChatCompletionRequest{Model: "gpt-4"}
+	if err := db.Create(gdb.WithContext(ctx), cc); err != nil {
+		t.Fatalf("failed to create chat completion request: %v", err)
+	}
+
+	start := time.Now().Unix()
+	if err := a.process(ctx, slog.Default(), cc); err != nil {
+		t.Fatalf("process() error = %v", err)
+	}
+
+	ccr := new(db.CreateChatCompletionResponse)
+	if err := gdb.WithContext(ctx).Where("request_id = ?", cc.ID).First(ccr).Error; err != nil {
+		t.Fatalf("failed to get chat completion response: %v", err)
+	}
+
+	response := ccr.ToPublic().(*openai.CreateChatCompletionResponse)
+	if !regexp.MustCompile(`^chatcmpl-[A-Za-z0-9_-]+$`).MatchString(response.Id) {
+		t.Errorf("id = %q, want a chatcmpl- id", response.Id)
+	}
+	if response.Created < int(start) || response.Created > int(time.Now().Unix()) {
+		t.Errorf("created = %d, want a timestamp from when the response was stored", response.Created)
+	}
+}
+
+func TestModelFallbacks(t *testing.T) {
+	// The primary model is out of capacity and the first fallback is overloaded.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Model  string `json:"model"`
+			Stream bool   `json:"stream"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+		}
+
+		switch body.Model {
+		case "gpt-4":
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"error": {"message": "You exceeded your current quota", "type": "insufficient_quota", "code": "insufficient_quota"}}` + "\n"))
+		case "gpt-4-turbo":
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"error": {"message": "The engine is currently overloaded", "type": "server_error"}}` + "\n"))
+		case "gpt-3.5-turbo":
+			if body.Stream {
+				_, _ = w.Write([]byte("data: {\"id\": \"chatcmpl-1\", \"object\": \"chat.completion.chunk\", \"model\": \"gpt-3.5-turbo\", \"choices\": [{\"index\": 0, \"delta\": {\"content\": \"Hello\"}}]}\n\ndata: [DONE]\n\n"))
+				return
+			}
+			_, _ = w.Write([]byte(`{
+				"id": "chatcmpl-1",
+				"object": "chat.completion",
+				"model": "gpt-3.5-turbo",
+				"choices": [{"index": 0, "finish_reason": "stop", "message": {"role": "assistant", "content": "Hello"}}]
+			}`))
+		default:
+			t.Errorf("unexpected model %q", body.Model)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+
+	a, gdb := newTestAgent(t, Config{
+		ChatCompletionURL: srv.URL,
+		ModelFallbacks:    map[string][]string{"gpt-4": {"gpt-4-turbo", "gpt-3.5-turbo"}},
+	})
+
+	ctx := context.Background()
+	t.Run("non-streaming", func(t *testing.T) {
+		cc := &db.CreateChatCompletionRequest{Model: "gpt-4"}
+		if err := db.Create(gdb.WithContext(ctx), cc); err != nil {
+			t.Fatalf("failed to create chat completion request: %v", err)
+		}
+		if err := a.process(ctx, slog.Default(), cc); err != nil {
+			t.Fatalf("process() error = %v", err)
+		}
+
+		ccr := new(db.CreateChatCompletionResponse)
+		if err := gdb.WithContext(ctx).Where("request_id = ?", cc.ID).First(ccr).Error; err != nil {
+			t.Fatalf("failed to get chat completion response: %v", err)
+		}
+		if ccr.Error != nil {
+			t.Fatalf("unexpected error response: %s", *ccr.Error)
+		}
+		if ccr.Model != "gpt-3.5-turbo" {
+			t.Errorf("model = %q, want %q", ccr.Model, "gpt-3.5-turbo")
+		}
+	})
+
+	t.Run("streaming", func(t *testing.T) {
+		cc := &db.CreateChatCompletionRequest{Model: "gpt-4", Stream: z.Pointer(true)}
+		if err := db.Create(gdb.WithContext(ctx), cc); err != nil {
+			t.Fatalf("failed to create chat completion request: %v", err)
+		}
+		if err := a.process(ctx, slog.Default(), cc); err != nil {
+			t.Fatalf("process() error = %v", err)
+		}
+
+		var chunks []db.ChatCompletionResponseChunk
+		if err := gdb.WithContext(ctx).Where("request_id = ?", cc.ID).Order("response_idx asc").Find(&chunks).Error; err != nil {
+			t.Fatalf("failed to get chat completion response chunks: %v", err)
+		}
+		if len(chunks) != 2 {
+			t.Fatalf("got %d chunks, want 2", len(chunks))
+		}
+		if chunks[0].Error != nil {
+			t.Fatalf("unexpected error chunk: %s", *chunks[0].Error)
+		}
+		if chunks[0].Model != "gpt-3.5-turbo" {
+			t.Errorf("model = %q, want %q", chunks[0].Model, "gpt-3.5-turbo")
+		}
+	})
+}
+
+func TestRoleRemappings(t *testing.T) {
+	publicRequest := new(openai.CreateChatCompletionRequest)
+	if err := json.Unmarshal([]byte(`{
+		"model": "gpt-4",
+		"messages": [
+			{"role": "user", "content": "What is the weather?"},
+			{"role": "assistant", "content": null, "tool_calls": [{"id": "call_1", "type": "function", "function": {"name": "get_weather", "arguments": "{}"}}]},
+			{"role": "tool", "tool_call_id": "call_1", "content": "Sunny"}
+		]
+	}`), publicRequest); err != nil {
+		t.Fatalf("failed to unmarshal request: %v", err)
+	}
+
+	cc := new(db.CreateChatCompletionRequest)
+	if err := cc.FromPublic(publicRequest); err != nil {
+		t.Fatalf("FromPublic() error = %v", err)
+	}
+
+	if _, err := RequestBody(Config{RoleRemappings: map[string]string{"tool": "user"}}, cc); err == nil {
+		t.Errorf("RequestBody() with an unsupported role remapping should have failed")
+	}
+
+	body, err := RequestBody(Config{RoleRemappings: map[string]string{"tool": "function"}}, cc)
+	if err != nil {
+		t.Fatalf("RequestBody() error = %v", err)
+	}
+
+	var got struct {
+		Messages []map[string]any `json:"messages"`
+	}
+	if err = json.Unmarshal(body, &got); err != nil {
+		t.Fatalf("failed to unmarshal request body: %v", err)
+	}
+	if len(got.Messages) != 3 {
+		t.Fatalf("expected 3 messages, got %v", got.Messages)
+	}
+	if got.Messages[0]["role"] != "user" {
+		t.Errorf("user message = %v, want it to be unchanged", got.Messages[0])
+	}
+	functionCall, _ := got.Messages[1]["function_call"].(map[string]any)
+	if m := got.Messages[1]; m["role"] != "assistant" || m["tool_calls"] != nil || functionCall["name"] != "get_weather" || functionCall["arguments"] != "{}" {
+		t.Errorf("assistant message = %v, want a function call to get_weather in place of its tool calls", m)
+	}
+	if m := got.Messages[2]; m["role"] != "function" || m["name"] != "get_weather" || m["content"] != "Sunny" {
+		t.Errorf("tool message = %v, want a function message for get_weather", m)
+	}
+
+	// The canonical roles should be kept in the request.
+	if tool, err := cc.Messages[2].AsChatCompletionRequestToolMessage(); err != nil || tool.Role != openai.ChatCompletionRequestToolMessageRoleTool {
+		t.Errorf("request message = %+v, want it to keep the tool role", tool)
+	}
+
+	// An assistant message can only have one function call, so parallel tool calls and their results are sent unchanged.
+	parallelRequest := new(openai.CreateChatCompletionRequest)
+	if err = json.Unmarshal([]byte(`{
+		"model": "gpt-4",
+		"messages": [
+			{"role": "user", "content": "What is the weather?"},
+			{"role": "assistant", "content": null, "tool_calls": [
+				{"id": "call_1", "type": "function", "function": {"name": "get_weather", "arguments": "{\"city\": \"Paris\"}"}},
+				{"id": "call_2", "type": "function", "function": {"name": "get_weather", "arguments": "{\"city\": \"Rome\"}"}}
+			]},
+			{"role": "tool", "tool_call_id": "call_1", "content": "Sunny"},
+			{"role": "tool", "tool_call_id": "call_2", "content": "Rainy"}
+		]
+	}`), parallelRequest); err != nil {
+		t.Fatalf("failed to unmarshal request: %v", err)
+	}
+
+	cc = new(db.CreateChatCompletionRequest)
+	if err = cc.FromPublic(parallelRequest); err != nil {
+		t.Fatalf("FromPublic() error = %v", err)
+	}
+	if body, err = RequestBody(Config{RoleRemappings: map[string]string{"tool": "function"}}, cc); err != nil {
+		t.Fatalf("RequestBody() error = %v", err)
+	}
+	got.Messages = nil
+	if err = json.Unmarshal(body, &got); err != nil {
+		t.Fatalf("failed to unmarshal request body: %v", err)
+	}
+	if len(got.Messages) != 4 {
+		t.Fatalf("expected 4 messages, got %v", got.Messages)
+	}
+	if m := got.Messages[1]; m["function_call"] != nil || len(m["tool_calls"].([]any)) != 2 {
+		t.Errorf("assistant message = %v, want its tool calls to be unchanged", m)
+	}
+	for _, m := range got.Messages[2:] {
+		if m["role"] != "tool" {
+			t.Errorf("tool message = %v, want it to be unchanged", m)
+		}
+	}
+
+}
+
+func TestDedupeSystemMessages(t *testing.T) {
+	publicRequest := new(openai.CreateChatCompletionRequest)
+	if err := json.Unmarshal([]byte(`{
+		"model": "gpt-4",
+		"messages": [
+			{"role": "system", "content": "You are a helpful assistant."},
+			{"role": "system", "content": "You are a helpful assistant."},
+			{"role": "user", "content": "Hello"},
+			{"role": "system", "content": "You are a helpful assistant."}
+		]
+	}`), publicRequest); err != nil {
+		t.Fatalf("failed to unmarshal request: %v", err)
+	}
+
+	cc := new(db.CreateChatCompletionRequest)
+	if err := cc.FromPublic(publicRequest); err != nil {
+		t.Fatalf("FromPublic() error = %v", err)
+	}
+
+	for _, tt := range []struct {
+		name  string
+		dedup bool
+		want  []string
+	}{
+		{name: "Disabled", want: []string{"system", "system", "user", "system"}},
+		{name: "Enabled", dedup: true, want: []string{"system", "user", "system"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := RequestBody(Config{DedupeSystemMessages: tt.dedup}, cc)
+			if err != nil {
+				t.Fatalf("RequestBody() error = %v", err)
+			}
+
+			var got struct {
+				Messages []map[string]any `json:"messages"`
+			}
+			if err = json.Unmarshal(body, &got); err != nil {
+				t.Fatalf("failed to unmarshal request body: %v", err)
+			}
+
+			roles := make([]string, 0, len(got.Messages))
+			for _, m := range got.Messages {
+				roles = append(roles, m["role"].(string))
+			}
+			if !slices.Equal(roles, tt.want) {
+				t.Errorf("roles = %v, want %v", roles, tt.want)
+			}
+		})
+	}
+
+	if len(cc.Messages) != 4 {
+		t.Errorf("request has %d messages, want all 4 to be kept", len(cc.Messages))
+	}
+}
+
+func TestMaxHistoryMessages(t *testing.T) {
+	publicRequest := new(openai.CreateChatCompletionRequest)
+	if err := json.Unmarshal([]byte(`{
+		"model": "gpt-4",
+		"messages": [
+			{"role": "system", "content": "You are a helpful assistant."},
+			{"role": "user", "content": "What is the weather?"},
+			{"role": "assistant", "tool_calls": [{"id": "call_1", "type": "function", "function": {"name": "weather", "arguments": "{}"}}]},
+			{"role": "tool", "tool_call_id": "call_1", "content": "Sunny"},
+			{"role": "system", "content": "Answer briefly."},
+			{"role": "assistant", "content": "It is sunny."},
+			{"role": "user", "content": "Thanks"}
+		]
+	}`), publicRequest); err != nil {
+		t.Fatalf("failed to unmarshal request: %v", err)
+	}
+
+	cc := new(db.CreateChatCompletionRequest)
+	if err := cc.FromPublic(publicRequest); err != nil {
+		t.Fatalf("FromPublic() error = %v", err)
+	}
+
+	for _, tt := range []struct {
+		name        string
+		maxMessages int
+		want        []string
+	}{
+		{name: "Disabled", want: []string{"system", "user", "assistant", "tool", "system", "assistant", "user"}},
+		{name: "Larger than history", maxMessages: 10, want: []string{"system", "user", "assistant", "tool", "system", "assistant", "user"}},
+		{name: "Last two", maxMessages: 2, want: []string{"system", "system", "assistant", "user"}},
+		// The tool message would be the first in the window, without the tool call it responds to.
+		{name: "Orphaned tool message", maxMessages: 3, want: []string{"system", "system", "assistant", "user"}},
+		{name: "Fewer than system messages", maxMessages: 1, want: []string{"system", "system", "user"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := RequestBody(Config{MaxHistoryMessages: tt.maxMessages}, cc)
+			if err != nil {
+				t.Fatalf("RequestBody() error = %v", err)
+			}
+
+			var got struct {
+				Messages []map[string]any `json:"messages"`
+			}
+			if err = json.Unmarshal(body, &got); err != nil {
+				t.Fatalf("failed to unmarshal request body: %v", err)
+			}
+
+			roles := make([]string, 0, len(got.Messages))
+			for _, m := range got.Messages {
+				roles = append(roles, m["role"].(string))
+			}
+			if !slices.Equal(roles, tt.want) {
+				t.Errorf("roles = %v, want %v", roles, tt.want)
+			}
+		})
+	}
+
+	if len(cc.Messages) != 7 {
+		t.Errorf("request has %d messages, want all 7 to be kept", len(cc.Messages))
+	}
+}
+
+func TestRemoveExpiredChatCompletions(t *testing.T) {
+	a, gdb := newTestAgent(t, Config{
+		StorageTTL:       time.Hour,
+		CleanupBatchSize: 1,
+	})
+
+	tx := gdb.WithContext(context.Background())
+	old := int(time.Now().Add(-2 * time.Hour).Unix())
+	create := func(createdAt int, done bool, store *bool) string {
+		t.Helper()
+		cc := &db.CreateChatCompletionRequest{Model: "gpt-4", Store: store}
+		if err := db.Create(tx, cc); err != nil {
+			t.Fatalf("failed to create chat completion request: %v", err)
+		}
+		if err := tx.Model(cc).Where("id = ?", cc.ID).Updates(map[string]any{"created_at": createdAt, "done": done}).Error; err != nil {
+			t.Fatalf("failed to update chat completion request: %v", err)
+		}
+		if err := db.Create(tx, &db.CreateChatCompletionResponse{JobResponse: db.JobResponse{RequestID: cc.ID, Done: true}, Model: "gpt-4"}); err != nil {
+			t.Fatalf("failed to create chat completion response: %v", err)
+		}
+		return cc.ID
+	}
+
+	var (
+		expired   = []string{create(old, true, nil), create(old, true, z.Pointer(false)), create(old, true, nil)}
+		stored    = create(old, true, z.Pointer(true))
+		recent    = create(int(time.Now().Unix()), true, nil)
+		notDone   = create(old, false, nil)
+		remaining = []string{stored, recent, notDone}
+	)
+
+	if err := a.removeExpiredChatCompletions(tx); err != nil {
+		t.Fatalf("removeExpiredChatCompletions() error = %v", err)
+	}
+
+	var requestIDs, responseRequestIDs []string
+	if err := tx.Model(new(db.CreateChatCompletionRequest)).Order("id").Pluck("id", &requestIDs).Error; err != nil {
+		t.Fatalf("failed to list chat completion requests: %v", err)
+	}
+	if err := tx.Model(new(db.CreateChatCompletionResponse)).Order("request_id").Pluck("request_id", &responseRequestIDs).Error; err != nil {
+		t.Fatalf("failed to list chat completion responses: %v", err)
+	}
+
+	slices.Sort(remaining)
+	if !slices.Equal(requestIDs, remaining) {
+		t.Errorf("remaining requests = %v, want %v, expired requests were %v", requestIDs, remaining, expired)
+	}
+	if !slices.Equal(responseRequestIDs, remaining) {
+		t.Errorf("remaining responses are for requests %v, want %v", responseRequestIDs, remaining)
+	}
+}
+
+func TestTimeToFirstToken(t *testing.T) {
+	const firstTokenDelay = 300 * time.Millisecond
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		// The first chunk only has the role, so it doesn't count as the first token.
+		_, _ = w.Write([]byte(`data: {"id": "chatcmpl-test", "object": "chat.completion.chunk", "created": 1, "model": "gpt-4", "choices": [{"index": 0, "delta": {"role": "assistant"}, "finish_reason": null}]}` + "\n\n"))
+		w.(http.Flusher).Flush()
+
+		time.Sleep(firstTokenDelay)
+		_, _ = w.Write([]byte(`data: {"id": "chatcmpl-test", "object": "chat.completion.chunk", "created": 1, "model": "gpt-4", "choices": [{"index": 0, "delta": {"content": "Hello"}, "finish_reason": null}]}` + "\n\n"))
+		_, _ = w.Write([]byte("data: [DONE]\n\n"))
+	}))
+	defer srv.Close()
+
+	a, gdb := newTestAgent(t, Config{
+		ChatCompletionURL: srv.URL,
+	})
+
+	ctx := context.Background()
+	cc := &db.CreateChatCompletionRequest{Model: "gpt-4", Stream: z.Pointer(true)}
+	if err := db.Create(gdb.WithContext(ctx), cc); err != nil {
+		t.Fatalf("failed to create chat completion request: %v", err)
+	}
+
+	if err := a.process(ctx, slog.Default(), cc); err != nil {
+		t.Fatalf("process() error = %v", err)
+	}
+
+	stored := new(db.CreateChatCompletionRequest)
+	if err := db.Get(gdb.WithContext(ctx), stored, cc.ID); err != nil {
+		t.Fatalf("failed to get chat completion request: %v", err)
+	}
+	if stored.TimeToFirstToken == nil {
+		t.Fatal("time to first token was not recorded")
+	}
+	if ttft := time.Duration(*stored.TimeToFirstToken) * time.Millisecond; ttft < firstTokenDelay || ttft > firstTokenDelay+time.Second {
+		t.Errorf("time to first token = %v, want about %v", ttft, firstTokenDelay)
+	}
+}
+
+func TestRetryPolicy(t *testing.T) {
+	// Requests for the overloaded model fail once, and requests for the invalid model always fail.
+	var (
+		lock     sync.Mutex
+		requests = make(map[string]int)
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Model  string `json:"model"`
+			Stream bool   `json:"stream"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+		}
+
+		lock.Lock()
+		requests[body.Model]++
+		n := requests[body.Model]
+		lock.Unlock()
+
+		switch {
+		case body.Model == "invalid":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error": {"message": "Invalid request", "type": "invalid_request_error"}}` + "\n"))
+		case n == 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"error": {"message": "The engine is currently overloaded", "type": "server_error"}}` + "\n"))
+		case body.Stream:
+			_, _ = w.Write([]byte("data: {\"id\": \"chatcmpl-1\", \"object\": \"chat.completion.chunk\", \"model\": \"" + body.Model + "\", \"choices\": [{\"index\": 0, \"delta\": {\"content\": \"Hello\"}}]}\n\ndata: [DONE]\n\n"))
+		default:
+			_, _ = w.Write([]byte(`{
+				"id": "chatcmpl-1",
+				"object": "chat.completion",
+				"model": "` + body.Model + `",
+				"choices": [{"index": 0, "finish_reason": "stop", "message": {"role": "assistant", "content": "Hello"}}]
+			}`))
+		}
+	}))
+	defer srv.Close()
+
+	a, gdb := newTestAgent(t, Config{
+		ChatCompletionURL: srv.URL,
+		MaxRetries:        2,
+	})
+
+	tests := []struct {
+		name, model  string
+		stream       bool
+		wantRequests int
+		wantError    bool
+	}{
+		{name: "503 is retried", model: "overloaded", wantRequests: 2},
+		{name: "503 is retried when streaming", model: "overloaded-stream", stream: true, wantRequests: 2},
+		{name: "400 is not retried", model: "invalid", wantRequests: 1, wantError: true},
+	}
+	ctx := context.Background()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cc := &db.CreateChatCompletionRequest{Model: tt.model}
+			if tt.stream {
+				cc.Stream = z.Pointer(true)
+			}
+			if err := db.Create(gdb.WithContext(ctx), cc); err != nil {
+				t.Fatalf("failed to create chat completion request: %v", err)
+			}
+			if err := a.process(ctx, slog.Default(), cc); err != nil {
+				t.Fatalf("process() error = %v", err)
+			}
+
+			var gotError bool
+			if tt.stream {
+				first := new(db.ChatCompletionResponseChunk)
+				if err := gdb.WithContext(ctx).Where("request_id = ?", cc.ID).Order("response_idx asc").First(first).Error; err != nil {
+					t.Fatalf("failed to get chat completion response chunk: %v", err)
+				}
+				gotError = first.Error != nil
+			} else {
+				ccr := new(db.CreateChatCompletionResponse)
+				if err := gdb.WithContext(ctx).Where("request_id = ?", cc.ID).First(ccr).Error; err != nil {
+					t.Fatalf("failed to get chat completion response: %v", err)
+				}
+				gotError = ccr.Error != nil
+			}
+			if gotError != tt.wantError {
+				t.Errorf("response has error = %v, want %v", gotError, tt.wantError)
+			}
+
+			wantStatus := openai.Completed
+			if tt.wantError {
+				wantStatus = openai.Failed
+			}
+			stored := new(db.CreateChatCompletionRequest)
+			if err := db.Get(gdb.WithContext(ctx), stored, cc.ID); err != nil {
+				t.Fatalf("failed to get chat completion request: %v", err)
+			}
+			if stored.Status != string(wantStatus) {
+				t.Errorf("status = %q, want %q", stored.Status, wantStatus)
+			}
+
+			lock.Lock()
+			defer lock.Unlock()
+			if requests[tt.model] != tt.wantRequests {
+				t.Errorf("got %d requests, want %d", requests[tt.model], tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestStorePrompts(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{
+			"id": "chatcmpl-1",
+			"object": "chat.completion",
+			"model": "gpt-4",
+			"choices": [{"index": 0, "finish_reason": "stop", "message": {"role": "assistant", "content": "Hello"}}]
+		}`))
+	}))
+	defer srv.Close()
+
+	publicRequest := new(openai.CreateChatCompletionRequest)
+	if err := json.Unmarshal([]byte(`{
+		"model": "gpt-4",
+		"messages": [
+			{"role": "system", "content": "You are a helpful assistant."},
+			{"role": "user", "content": "Hello"}
+		]
+	}`), publicRequest); err != nil {
+		t.Fatalf("failed to unmarshal request: %v", err)
+	}
+
+	ctx := context.Background()
+	for _, tt := range []struct {
+		name      string
+		store     bool
+		wantRoles []string
+	}{
+		{name: "Disabled"},
+		// The system message is sent to the model provider as a user message.
+		{name: "Enabled", store: true, wantRoles: []string{"user", "user"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			a, gdb := newTestAgent(t, Config{
+				ChatCompletionURL: srv.URL,
+				RoleRemappings:    map[string]string{"system": "user"},
+				StorePrompts:      tt.store,
+			})
+
+			cc := new(db.CreateChatCompletionRequest)
+			if err := cc.FromPublic(publicRequest); err != nil {
+				t.Fatalf("FromPublic() error = %v", err)
+			}
+			if err := db.Create(gdb.WithContext(ctx), cc); err != nil {
+				t.Fatalf("failed to create chat completion request: %v", err)
+			}
+			if err := a.process(ctx, slog.Default(), cc); err != nil {
+				t.Fatalf("process() error = %v", err)
+			}
+
+			stored := new(db.CreateChatCompletionRequest)
+			if err := gdb.WithContext(ctx).Where("id = ?", cc.ID).First(stored).Error; err != nil {
+				t.Fatalf("failed to get chat completion request: %v", err)
+			}
+
+			var roles []string
+			for _, message := range stored.Prompt {
+				b, err := message.MarshalJSON()
+				if err != nil {
+					t.Fatalf("failed to marshal prompt message: %v", err)
+				}
+				var m struct {
+					Role string `json:"role"`
+				}
+				if err = json.Unmarshal(b, &m); err != nil {
+					t.Fatalf("failed to unmarshal prompt message: %v", err)
+				}
+				roles = append(roles, m.Role)
+			}
+			if !slices.Equal(roles, tt.wantRoles) {
+				t.Errorf("prompt roles = %v, want %v", roles, tt.wantRoles)
+			}
+
+			// The messages of the original request are kept.
+			if system, err := stored.Messages[0].AsChatCompletionRequestSystemMessage(); err != nil || system.Role != openai.ChatCompletionRequestSystemMessageRoleSystem {
+				t.Errorf("first request message = %v, want the system message", stored.Messages[0])
+			}
+		})
+	}
+}
+
+func TestReportUsage(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{
+			"id": "chatcmpl-1",
+			"object": "chat.completion",
+			"model": "gpt-4-0613",
+			"choices": [{"index": 0, "finish_reason": "stop", "message": {"role": "assistant", "content": "Hello"}}],
+			"usage": {"prompt_tokens": 9, "completion_tokens": 3, "total_tokens": 12}
+		}`))
+	}))
+	defer srv.Close()
+
+	type report struct {
+		id, model string
+		usage     openai.CompletionUsage
+	}
+	var reports []report
+	a, gdb := newTestAgent(t, Config{
+		ChatCompletionURL: srv.URL,
+		ReportUsage: func(_ context.Context, chatCompletionID, model string, usage openai.CompletionUsage) {
+			reports = append(reports, report{id: chatCompletionID, model: model, usage: usage})
+		},
+	})
+
+	ctx := context.Background()
+	cc := &db.CreateChatCompletionRequest{Model: "gpt-4"}
+	if err := db.Create(gdb.WithContext(ctx), cc); err != nil {
+		t.Fatalf("failed to create chat completion request: %v", err)
+	}
+	if err := a.process(ctx, slog.Default(), cc); err != nil {
+		t.Fatalf("process() error = %v", err)
+	}
+
+	if len(reports) != 1 {
+		t.Fatalf("usage was reported %d times, want once", len(reports))
+	}
+	if got := reports[0]; got.id != cc.ID || got.model != "gpt-4-0613" || got.usage.PromptTokens != 9 || got.usage.CompletionTokens != 3 || got.usage.TotalTokens != 12 {
+		t.Errorf("reported usage = %+v, want 9 prompt, 3 completion, and 12 total tokens for %s with gpt-4-0613", got, cc.ID)
+	}
+}
+
+func TestSkipCancelledQueuedChatCompletion(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		_, _ = w.Write([]byte(`{"id": "chatcmpl-1", "object": "chat.completion", "model": "gpt-4", "choices": []}`))
+	}))
+	defer srv.Close()
+
+	a, gdb := newTestAgent(t, Config{
+		ChatCompletionURL: srv.URL,
+	})
+
+	ctx := context.Background()
+	cc := &db.CreateChatCompletionRequest{Model: "gpt-4"}
+	if err := db.Create(gdb.WithContext(ctx), cc); err != nil {
+		t.Fatalf("failed to create chat completion request: %v", err)
+	}
+	if cancelled, err := db.CancelQueuedChatCompletion(gdb.WithContext(ctx), cc.ID); err != nil || !cancelled {
+		t.Fatalf("CancelQueuedChatCompletion() = %v, %v, want the request to be cancelled", cancelled, err)
+	}
+
+	if err := a.run(ctx); err != nil {
+		t.Fatalf("run() error = %v", err)
+	}
+	a.pool.Wait()
+
+	if got := requests.Load(); got != 0 {
+		t.Errorf("made %d requests to the model provider, want none", got)
+	}
+
+	ccr := new(db.CreateChatCompletionResponse)
+	if err := gdb.WithContext(ctx).Where("request_id = ?", cc.ID).First(ccr).Error; err != nil {
+		t.Fatalf("failed to get chat completion response: %v", err)
+	}
+	if ccr.StatusCode != statusClientClosedRequest {
+		t.Errorf("status code = %d, want %d", ccr.StatusCode, statusClientClosedRequest)
+	}
+
+	stored := new(db.CreateChatCompletionRequest)
+	if err := db.Get(gdb.WithContext(ctx), stored, cc.ID); err != nil {
+		t.Fatalf("failed to get chat completion request: %v", err)
+	}
+	if stored.Status != string(openai.Cancelled) {
+		t.Errorf("status = %q, want %q", stored.Status, openai.Cancelled)
+	}
+
+	// The request can't be cancelled again once it has been claimed.
+	if cancelled, err := db.CancelQueuedChatCompletion(gdb.WithContext(ctx), cc.ID); err != nil || cancelled {
+		t.Errorf("CancelQueuedChatCompletion() = %v, %v, want the claimed request to be left unchanged", cancelled, err)
+	}
+}
+
+func TestLeaveRequestsQueuedWhileWorkersAreBusy(t *testing.T) {
+	started, release := make(chan struct{}, 3), make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		started <- struct{}{}
+		<-release
+		_, _ = w.Write([]byte(`{"id": "chatcmpl-1", "object": "chat.completion", "model": "gpt-4", "choices": []}`))
+	}))
+	defer srv.Close()
+
+	a, gdb := newTestAgent(t, Config{
+		ChatCompletionURL:     srv.URL,
+		MaxConcurrentRequests: 2,
+	})
+
+	ctx := context.Background()
+	requests := make([]*db.CreateChatCompletionRequest, 3)
+	for i := range requests {
+		requests[i] = &db.CreateChatCompletionRequest{Model: "gpt-4"}
+		if err := db.Create(gdb.WithContext(ctx), requests[i]); err != nil {
+			t.Fatalf("failed to create chat completion request: %v", err)
+		}
+	}
+
+	// Both workers are busy with the first two requests.
+	for range 2 {
+		if err := a.run(ctx); err != nil {
+			t.Fatalf("run() error = %v", err)
+		}
+	}
+	for range 2 {
+		select {
+		case <-started:
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for request to model provider")
+		}
+	}
+
+	// The third request isn't claimed, so that another agent can process it.
+	if err := a.run(ctx); !errors.Is(err, agents.ErrWorkerPoolFull) {
+		t.Fatalf("run() error = %v, want %v", err, agents.ErrWorkerPoolFull)
+	}
+
+	var unclaimed []string
+	if err := gdb.WithContext(ctx).Model(new(db.CreateChatCompletionRequest)).Where("claimed_by IS NULL").Pluck("id", &unclaimed).Error; err != nil {
+		t.Fatalf("failed to list unclaimed chat completion requests: %v", err)
+	}
+	var responses int64
+	if err := gdb.WithContext(ctx).Model(new(db.CreateChatCompletionResponse)).Count(&responses).Error; err != nil {
+		t.Fatalf("failed to count chat completion responses: %v", err)
+	}
+	if len(unclaimed) != 1 || responses != 0 {
+		t.Errorf("%d requests are unclaimed and %d were answered, want 1 unclaimed and none answered", len(unclaimed), responses)
+	}
+
+	// Once the workers are free, the third request is claimed.
+	close(release)
+	a.pool.Wait()
+	if err := a.run(ctx); err != nil {
+		t.Fatalf("run() error = %v", err)
+	}
+	a.pool.Wait()
+
+	if err := gdb.WithContext(ctx).Model(new(db.CreateChatCompletionResponse)).Count(&responses).Error; err != nil {
+		t.Fatalf("failed to count chat completion responses: %v", err)
+	}
+	if responses != 3 {
+		t.Errorf("%d requests were answered, want 3", responses)
+	}
+}
@@ -0,0 +1,101 @@
+package chatcompletion
+
+import (
+	"log/slog"
+
+	"github.com/acorn-io/z"
+	"github.com/gptscript-ai/clicky-chats/pkg/db"
+	"github.com/gptscript-ai/clicky-chats/pkg/generated/openai"
+)
+
+// deduplicateSystemMessages replaces the messages of the chat completion request with messages where identical
+// consecutive system messages are collapsed into one. The messages are copied, so the messages of the request are kept
+// wherever else the request is used.
+func (a *agent) deduplicateSystemMessages(l *slog.Logger, cc *db.CreateChatCompletionRequest) {
+	if !a.deduplicateSystem {
+		return
+	}
+
+	var (
+		messages = make([]openai.ChatCompletionRequestMessage, 0, len(cc.Messages))
+		previous *openai.ChatCompletionRequestSystemMessage
+	)
+	for _, message := range cc.Messages {
+		system, err := message.AsChatCompletionRequestSystemMessage()
+		if err != nil || string(system.Role) != systemRole {
+			previous = nil
+			messages = append(messages, message)
+			continue
+		}
+
+		if previous != nil && previous.Content == system.Content && z.Dereference(previous.Name) == z.Dereference(system.Name) {
+			continue
+		}
+
+		previous = &system
+		messages = append(messages, message)
+	}
+
+	if removed := len(cc.Messages) - len(messages); removed > 0 {
+		l.Debug("Removed duplicate system messages", "count", removed)
+		cc.Messages = messages
+	}
+}
+
+// windowHistory replaces the messages of the chat completion request with the system messages and the most recent
+// messages that aren't system messages, keeping at most maxHistoryMessages of those. The messages keep their order.
+//
+// Tool messages at the start of the window are removed too, since the assistant message with their tool calls is not
+// in the window, and model providers reject tool messages that don't respond to a tool call.
+func (a *agent) windowHistory(l *slog.Logger, cc *db.CreateChatCompletionRequest) {
+	if a.maxHistoryMessages <= 0 {
+		return
+	}
+
+	var history int
+	for _, message := range cc.Messages {
+		if !isSystemMessage(message) {
+			history++
+		}
+	}
+	if history <= a.maxHistoryMessages {
+		return
+	}
+
+	var (
+		messages = make([]openai.ChatCompletionRequestMessage, 0, len(cc.Messages)-history+a.maxHistoryMessages)
+		skip     = history - a.maxHistoryMessages
+		inWindow bool
+	)
+	for _, message := range cc.Messages {
+		if isSystemMessage(message) {
+			messages = append(messages, message)
+			continue
+		}
+
+		if skip > 0 {
+			skip--
+			continue
+		}
+
+		if !inWindow && isToolMessage(message) {
+			continue
+		}
+
+		inWindow = true
+		messages = append(messages, message)
+	}
+
+	l.Debug("Removed messages outside of the history window", "count", len(cc.Messages)-len(messages))
+	cc.Messages = messages
+}
+
+func isSystemMessage(message openai.ChatCompletionRequestMessage) bool {
+	system, err := message.AsChatCompletionRequestSystemMessage()
+	return err == nil && string(system.Role) == systemRole
+}
+
+func isToolMessage(message openai.ChatCompletionRequestMessage) bool {
+	tool, err := message.AsChatCompletionRequestToolMessage()
+	return err == nil && string(tool.Role) == toolRole
+}
@@ -0,0 +1,90 @@
+package chatcompletion
+
+import (
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	"github.com/gptscript-ai/clicky-chats/pkg/db"
+)
+
+const (
+	temperatureParameter = "temperature"
+	topPParameter        = "top_p"
+	maxTokensParameter   = "max_tokens"
+)
+
+// Parameters are the parameters of chat completion requests that can be set by the operator.
+type Parameters struct {
+	Temperature, TopP *float32
+	MaxTokens         *int
+}
+
+// ParseParameters parses parameters from a map of parameter names to values.
+func ParseParameters(values map[string]string) (Parameters, error) {
+	var p Parameters
+	for name, value := range values {
+		switch name {
+		case temperatureParameter, topPParameter:
+			f, err := strconv.ParseFloat(value, 32)
+			if err != nil {
+				return p, fmt.Errorf("invalid value %q for parameter %s: %w", value, name, err)
+			}
+
+			if f32 := float32(f); name == temperatureParameter {
+				p.Temperature = &f32
+			} else {
+				p.TopP = &f32
+			}
+		case maxTokensParameter:
+			i, err := strconv.Atoi(value)
+			if err != nil {
+				return p, fmt.Errorf("invalid value %q for parameter %s: %w", value, name, err)
+			}
+			p.MaxTokens = &i
+		default:
+			return p, fmt.Errorf("unknown parameter %s", name)
+		}
+	}
+
+	return p, nil
+}
+
+// validateLockedParameters returns the set of locked parameters, or an error if any of them are unknown.
+func validateLockedParameters(names []string) (map[string]struct{}, error) {
+	locked := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		switch name {
+		case temperatureParameter, topPParameter, maxTokensParameter:
+			locked[name] = struct{}{}
+		default:
+			return nil, fmt.Errorf("[chatcompletion] unknown locked parameter %s", name)
+		}
+	}
+
+	return locked, nil
+}
+
+// applyDefaultParameters sets the parameters that the chat completion request omits to their defaults. Locked
+// parameters are always set to their default, even if that means unsetting them.
+func (a *agent) applyDefaultParameters(l *slog.Logger, cc *db.CreateChatCompletionRequest) {
+	cc.Temperature = parameter(a, l, temperatureParameter, cc.Temperature, a.defaultParameters.Temperature)
+	cc.TopP = parameter(a, l, topPParameter, cc.TopP, a.defaultParameters.TopP)
+	cc.MaxTokens = parameter(a, l, maxTokensParameter, cc.MaxTokens, a.defaultParameters.MaxTokens)
+}
+
+// parameter returns the value that should be used for the parameter of a chat completion request.
+func parameter[T any](a *agent, l *slog.Logger, name string, value, defaultValue *T) *T {
+	if _, ok := a.lockedParameters[name]; ok {
+		if value != nil {
+			l.Debug("Ignoring locked parameter in request", "parameter", name)
+		}
+		return defaultValue
+	}
+
+	if value == nil {
+		return defaultValue
+	}
+
+	return value
+}
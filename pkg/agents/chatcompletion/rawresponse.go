@@ -0,0 +1,66 @@
+package chatcompletion
+
+import (
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/acorn-io/z"
+	"github.com/gptscript-ai/clicky-chats/pkg/db"
+	"gorm.io/gorm"
+)
+
+const redacted = "[REDACTED]"
+
+// rawResponse returns the raw response that should be stored with a chat completion response, or nil if raw responses
+// are not stored.
+func (a *agent) rawResponse(l *slog.Logger, raw *string) *string {
+	if !a.storeRawResponses {
+		return nil
+	}
+	if raw == nil || len(a.rawResponseRedactions) == 0 {
+		return raw
+	}
+
+	var body any
+	if err := json.Unmarshal([]byte(*raw), &body); err != nil {
+		// If the body can't be parsed, then it can't be redacted, so don't store it.
+		l.Warn("Not storing raw response that is not JSON", "err", err)
+		return nil
+	}
+
+	b, err := json.Marshal(redact(body, a.rawResponseRedactions))
+	if err != nil {
+		l.Warn("Failed to marshal redacted raw response", "err", err)
+		return nil
+	}
+
+	return z.Pointer(string(b))
+}
+
+// redact replaces the values of the given keys, at any depth in the JSON value, with a placeholder.
+func redact(value any, keys map[string]struct{}) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, val := range v {
+			if _, ok := keys[key]; ok {
+				v[key] = redacted
+			} else {
+				v[key] = redact(val, keys)
+			}
+		}
+	case []any:
+		for i, val := range v {
+			v[i] = redact(val, keys)
+		}
+	}
+
+	return value
+}
+
+// removeRawResponses removes the raw responses that are older than the retention period.
+func (a *agent) removeRawResponses(gdb *gorm.DB) error {
+	return gdb.Model(new(db.CreateChatCompletionResponse)).
+		Where("created_at < ? AND raw_response IS NOT NULL", int(time.Now().Add(-a.rawResponseRetention).Unix())).
+		Update("raw_response", nil).Error
+}
@@ -0,0 +1,107 @@
+package chatcompletion
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/acorn-io/z"
+	"github.com/gptscript-ai/clicky-chats/pkg/db"
+	"github.com/gptscript-ai/clicky-chats/pkg/generated/openai"
+)
+
+const (
+	systemRole   = string(openai.ChatCompletionRequestSystemMessageRoleSystem)
+	userRole     = string(openai.ChatCompletionRequestUserMessageRoleUser)
+	toolRole     = string(openai.ChatCompletionRequestToolMessageRoleTool)
+	functionRole = string(openai.ChatCompletionRequestFunctionMessageRoleFunction)
+)
+
+// supportedRoleRemappings are the roles that messages can be sent with in place of their canonical role.
+var supportedRoleRemappings = map[string][]string{
+	systemRole: {userRole},
+	toolRole:   {functionRole},
+}
+
+func validateRoleRemappings(remappings map[string]string) error {
+	for role, remapped := range remappings {
+		if !slices.Contains(supportedRoleRemappings[role], remapped) {
+			return fmt.Errorf("[chatcompletion] unsupported role remapping %s=%s", role, remapped)
+		}
+	}
+
+	return nil
+}
+
+// remapRoles replaces the messages of the chat completion request with messages that use the roles the model provider
+// requires. The messages are copied, so the canonical messages are kept wherever else the request is used.
+//
+// A tool message is sent as a function message for the function of the tool call it responds to, and the assistant
+// message with that tool call is sent with it as its function call. An assistant message can only have one function
+// call, so an assistant message with more than one tool call, and the tool messages that respond to it, are sent
+// unchanged.
+func (a *agent) remapRoles(l *slog.Logger, cc *db.CreateChatCompletionRequest) {
+	if len(a.roleRemappings) == 0 {
+		return
+	}
+
+	var (
+		messages      = make([]openai.ChatCompletionRequestMessage, 0, len(cc.Messages))
+		functionNames = make(map[string]string)
+	)
+	for _, message := range cc.Messages {
+		remapped, err := a.remapRole(functionNames, message)
+		if err != nil {
+			l.Warn("Failed to remap message role, sending the message unchanged", "err", err)
+			remapped = message
+		}
+		messages = append(messages, remapped)
+	}
+
+	cc.Messages = messages
+}
+
+// remapRole returns the message with the role that the model provider requires in place of its role.
+func (a *agent) remapRole(functionNames map[string]string, message openai.ChatCompletionRequestMessage) (openai.ChatCompletionRequestMessage, error) {
+	var remapped openai.ChatCompletionRequestMessage
+	if assistant, err := message.AsChatCompletionRequestAssistantMessage(); err == nil && assistant.Role == openai.ChatCompletionRequestAssistantMessageRoleAssistant && len(z.Dereference(assistant.ToolCalls)) > 0 && a.roleRemappings[toolRole] == functionRole {
+		toolCalls := *assistant.ToolCalls
+		if len(toolCalls) != 1 {
+			return message, fmt.Errorf("%d tool calls can't be sent as a function call", len(toolCalls))
+		}
+
+		functionNames[toolCalls[0].Id] = toolCalls[0].Function.Name
+		functionCall := toolCalls[0].Function
+		assistant.FunctionCall, assistant.ToolCalls = &functionCall, nil
+
+		return remapped, remapped.FromChatCompletionRequestAssistantMessage(assistant)
+	}
+
+	if system, err := message.AsChatCompletionRequestSystemMessage(); err == nil && string(system.Role) == systemRole && a.roleRemappings[systemRole] == userRole {
+		content := openai.ChatCompletionRequestUserMessage_Content{}
+		if err = content.FromChatCompletionRequestUserMessageContent0(system.Content); err != nil {
+			return message, err
+		}
+
+		return remapped, remapped.FromChatCompletionRequestUserMessage(openai.ChatCompletionRequestUserMessage{
+			Content: content,
+			Name:    system.Name,
+			Role:    openai.ChatCompletionRequestUserMessageRoleUser,
+		})
+	}
+
+	if tool, err := message.AsChatCompletionRequestToolMessage(); err == nil && string(tool.Role) == toolRole && a.roleRemappings[toolRole] == functionRole {
+		name, ok := functionNames[tool.ToolCallId]
+		if !ok {
+			return message, fmt.Errorf("no tool call found with id %s", tool.ToolCallId)
+		}
+
+		return remapped, remapped.FromChatCompletionRequestFunctionMessage(openai.ChatCompletionRequestFunctionMessage{
+			Content: &tool.Content,
+			Name:    name,
+			Role:    openai.ChatCompletionRequestFunctionMessageRoleFunction,
+		})
+	}
+
+	return message, nil
+}
@@ -0,0 +1,47 @@
+package chatcompletion
+
+import (
+	"time"
+
+	"github.com/gptscript-ai/clicky-chats/pkg/db"
+	"gorm.io/gorm"
+)
+
+// defaultCleanupBatchSize is the number of expired chat completions that are deleted in each transaction if the batch
+// size is not configured.
+const defaultCleanupBatchSize = 100
+
+// removeExpiredChatCompletions deletes the chat completion requests that were done before the storage TTL, along with
+// their responses and chunks. Requests that were created with store set are kept. The requests are deleted in batches,
+// each in its own transaction, so that the cleanup doesn't hold long-running transactions.
+func (a *agent) removeExpiredChatCompletions(gdb *gorm.DB) error {
+	expiration := int(time.Now().Add(-a.storageTTL).Unix())
+	for {
+		var ids []string
+		if err := gdb.Model(new(db.CreateChatCompletionRequest)).
+			Where("created_at < ? AND done = true AND (store IS NULL OR store = false)", expiration).
+			Limit(a.cleanupBatchSize).Pluck("id", &ids).Error; err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		if err := gdb.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Delete(new(db.ChatCompletionResponseChunk), "request_id IN ?", ids).Error; err != nil {
+				return err
+			}
+			if err := tx.Delete(new(db.CreateChatCompletionResponse), "request_id IN ?", ids).Error; err != nil {
+				return err
+			}
+			return tx.Delete(new(db.CreateChatCompletionRequest), "id IN ?", ids).Error
+		}); err != nil {
+			return err
+		}
+
+		a.logger.Debug("Removed expired chat completions", "count", len(ids))
+		if len(ids) < a.cleanupBatchSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package embeddings
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/acorn-io/z"
+	"github.com/gptscript-ai/clicky-chats/pkg/db"
+	"github.com/gptscript-ai/clicky-chats/pkg/generated/openai"
+	"gorm.io/datatypes"
+	"gorm.io/gorm/clause"
+)
+
+// embed returns the embeddings for the request. Each distinct input is only sent to the model provider once, and its
+// embedding is used for every position it appears at in the request. If the cache is enabled, then the cached embeddings
+// are used for the inputs that have been embedded before, and the embeddings of the other inputs are cached.
+func (a *agent) embed(ctx context.Context, l *slog.Logger, url string, er *db.CreateEmbeddingRequest) (*db.CreateEmbeddingResponse, error) {
+	inputs, batch, err := splitInputs(er.Input.Data())
+	if err != nil || len(inputs) == 0 {
+		l.Debug("Sending embeddings request with unsupported input as is", "err", err)
+		return makeEmbeddingsRequest(ctx, l, a.client, url, a.apiKey, er)
+	}
+
+	keys := make([]string, 0, len(inputs))
+	for _, input := range inputs {
+		keys = append(keys, cacheKey(er, input))
+	}
+
+	embeddings := make(map[string]openai.Embedding_Embedding, len(keys))
+	if a.cache {
+		var cached []db.CachedEmbedding
+		if err = a.db.WithContext(ctx).Where("id IN ?", keys).Find(&cached).Error; err != nil {
+			l.Warn("Failed to get cached embeddings", "err", err)
+		}
+
+		for _, c := range cached {
+			embeddings[c.ID] = c.Embedding.Data()
+		}
+	}
+
+	// Only embed each input that isn't cached once, even if it appears multiple times in the request.
+	var (
+		missKeys   []string
+		missInputs []json.RawMessage
+	)
+	for i, key := range keys {
+		if _, ok := embeddings[key]; ok || slices.Contains(missKeys, key) {
+			continue
+		}
+		missKeys = append(missKeys, key)
+		missInputs = append(missInputs, inputs[i])
+	}
+
+	l.Debug("Looked up embeddings", "inputs", len(inputs), "misses", len(missInputs))
+	if !a.cache && len(missInputs) == len(inputs) {
+		// There are no duplicate inputs, so the request can be sent as is.
+		return makeEmbeddingsRequest(ctx, l, a.client, url, a.apiKey, er)
+	}
+
+	embedresp := &db.CreateEmbeddingResponse{
+		Model: er.Model,
+	}
+	if len(missInputs) > 0 {
+		missInput, err := joinInputs(missInputs, batch)
+		if err != nil {
+			return nil, err
+		}
+
+		missRequest := *er
+		missRequest.Input = datatypes.NewJSONType(missInput)
+
+		resp, err := makeEmbeddingsRequest(ctx, l, a.client, url, a.apiKey, &missRequest)
+		if err != nil || resp.Error != nil {
+			return resp, err
+		}
+
+		newEmbeddings := make([]db.CachedEmbedding, 0, len(resp.Data))
+		for _, e := range resp.Data {
+			if e.Index < 0 || e.Index >= len(missKeys) {
+				return nil, fmt.Errorf("model provider returned an embedding for unknown input %d", e.Index)
+			}
+
+			embeddings[missKeys[e.Index]] = e.Embedding.Data()
+			newEmbeddings = append(newEmbeddings, db.CachedEmbedding{
+				Base: db.Base{
+					ID:        missKeys[e.Index],
+					CreatedAt: int(time.Now().Unix()),
+				},
+				Embedding: e.Embedding,
+			})
+		}
+
+		if a.cache {
+			if err = a.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&newEmbeddings).Error; err != nil {
+				l.Warn("Failed to cache embeddings", "err", err)
+			}
+		}
+
+		embedresp.Model = resp.Model
+		embedresp.Usage = resp.Usage
+	}
+
+	for i, key := range keys {
+		embedding, ok := embeddings[key]
+		if !ok {
+			return nil, fmt.Errorf("model provider did not return an embedding for input %d", i)
+		}
+
+		embedresp.Data = append(embedresp.Data, db.Embedding{
+			Index:     i,
+			Embedding: datatypes.NewJSONType(embedding),
+		})
+	}
+
+	embedresp.StatusCode = http.StatusOK
+	embedresp.RequestID = er.ID
+	embedresp.Done = true
+
+	return embedresp, nil
+}
+
+// splitInputs splits the input of an embeddings request into the inputs that are each turned into an embedding. A single
+// string or array of tokens is not a batch.
+func splitInputs(input openai.CreateEmbeddingRequest_Input) ([]json.RawMessage, bool, error) {
+	raw, err := input.MarshalJSON()
+	if err != nil {
+		return nil, false, err
+	}
+
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || raw[0] != '[' {
+		return []json.RawMessage{raw}, false, nil
+	}
+
+	var inputs []json.RawMessage
+	if err = json.Unmarshal(raw, &inputs); err != nil {
+		return nil, false, err
+	}
+
+	if len(inputs) > 0 {
+		if first := bytes.TrimSpace(inputs[0]); len(first) > 0 && first[0] != '"' && first[0] != '[' {
+			// This is a single array of tokens.
+			return []json.RawMessage{raw}, false, nil
+		}
+	}
+
+	return inputs, true, nil
+}
+
+// joinInputs is the reverse of splitInputs.
+func joinInputs(inputs []json.RawMessage, batch bool) (openai.CreateEmbeddingRequest_Input, error) {
+	var (
+		input openai.CreateEmbeddingRequest_Input
+		raw   = inputs[0]
+	)
+	if batch {
+		var err error
+		if raw, err = json.Marshal(inputs); err != nil {
+			return input, err
+		}
+	}
+
+	return input, input.UnmarshalJSON(raw)
+}
+
+// cacheKey returns the key of the cached embedding for the input, which includes all the request parameters that affect
+// the embedding.
+func cacheKey(er *db.CreateEmbeddingRequest, input json.RawMessage) string {
+	// Compact the input so that the formatting of the request doesn't matter.
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, input); err != nil {
+		compacted.Reset()
+		compacted.Write(input)
+	}
+
+	h := sha256.New()
+	for _, s := range []string{er.Model, strconv.Itoa(z.Dereference(er.Dimensions)), z.Dereference(er.EncodingFormat), compacted.String()} {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
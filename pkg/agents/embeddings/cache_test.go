@@ -0,0 +1,178 @@
+package embeddings
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/gptscript-ai/clicky-chats/pkg/db"
+	"github.com/gptscript-ai/clicky-chats/pkg/generated/openai"
+	"gorm.io/datatypes"
+)
+
+// newLengthProvider returns a model provider that returns the length of each input as its embedding. The inputs of
+// each request are appended to received.
+func newLengthProvider(t *testing.T, received *[][]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := new(openai.CreateEmbeddingRequest)
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		inputs, err := req.Input.AsCreateEmbeddingRequestInput1()
+		if err != nil {
+			input, _ := req.Input.AsCreateEmbeddingRequestInput0()
+			inputs = []string{input}
+		}
+		*received = append(*received, inputs)
+
+		data := make([]map[string]any, 0, len(inputs))
+		for i, input := range inputs {
+			data = append(data, map[string]any{"object": "embedding", "index": i, "embedding": []float32{float32(len(input))}})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"object": "list",
+			"model":  req.Model,
+			"data":   data,
+			"usage":  map[string]any{"prompt_tokens": len(inputs), "total_tokens": len(inputs)},
+		})
+	}))
+}
+
+func TestEmbedWithCache(t *testing.T) {
+	var received [][]string
+	srv := newLengthProvider(t, &received)
+	defer srv.Close()
+
+	gdb, err := db.New("sqlite://"+filepath.Join(t.TempDir(), "clicky-chats.db"), true)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	if err = gdb.AutoMigrate(); err != nil {
+		t.Fatalf("failed to migrate database: %v", err)
+	}
+
+	a := &agent{
+		client: http.DefaultClient,
+		db:     gdb,
+		cache:  true,
+	}
+
+	type testCase struct {
+		name         string
+		input        string
+		wantReceived []string
+		want         []float32
+	}
+	tests := []testCase{
+		{
+			name:         "Nothing is cached",
+			input:        `["a", "bb"]`,
+			wantReceived: []string{"a", "bb"},
+			want:         []float32{1, 2},
+		},
+		{
+			name:         "Mixed batch only embeds the uncached inputs",
+			input:        `["bb", "ccc", "ccc"]`,
+			wantReceived: []string{"ccc"},
+			want:         []float32{2, 3, 3},
+		},
+		{
+			name:  "Repeated input is served from the cache",
+			input: `"a"`,
+			want:  []float32{1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			received = nil
+
+			var input openai.CreateEmbeddingRequest_Input
+			if err := input.UnmarshalJSON([]byte(tt.input)); err != nil {
+				t.Fatalf("failed to unmarshal input: %v", err)
+			}
+
+			resp, err := a.embed(context.Background(), slog.Default(), srv.URL, &db.CreateEmbeddingRequest{
+				Model: "text-embedding-3-small",
+				Input: datatypes.NewJSONType(input),
+			})
+			if err != nil {
+				t.Fatalf("embed() error = %v", err)
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error response: %s", *resp.Error)
+			}
+
+			if tt.wantReceived == nil && len(received) > 0 {
+				t.Errorf("provider received %v, want no requests", received)
+			} else if tt.wantReceived != nil && (len(received) != 1 || !slices.Equal(received[0], tt.wantReceived)) {
+				t.Errorf("provider received %v, want %v", received, tt.wantReceived)
+			}
+
+			var got []float32
+			for i, e := range resp.Data {
+				if e.Index != i {
+					t.Errorf("embedding %d has index %d", i, e.Index)
+				}
+				embedding, err := e.Embedding.Data().AsEmbeddingEmbedding0()
+				if err != nil {
+					t.Fatalf("unexpected embedding: %v", err)
+				}
+				got = append(got, embedding...)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("embeddings = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEmbedDeduplicatesInputs(t *testing.T) {
+	var received [][]string
+	srv := newLengthProvider(t, &received)
+	defer srv.Close()
+
+	a := &agent{client: http.DefaultClient}
+
+	var input openai.CreateEmbeddingRequest_Input
+	if err := input.UnmarshalJSON([]byte(`["ccc", "a", "ccc", "bb", "a"]`)); err != nil {
+		t.Fatalf("failed to unmarshal input: %v", err)
+	}
+
+	resp, err := a.embed(context.Background(), slog.Default(), srv.URL, &db.CreateEmbeddingRequest{
+		Model: "text-embedding-3-small",
+		Input: datatypes.NewJSONType(input),
+	})
+	if err != nil {
+		t.Fatalf("embed() error = %v", err)
+	}
+	if resp.Error != nil {
+		t.Fatalf("unexpected error response: %s", *resp.Error)
+	}
+
+	if wantReceived := []string{"ccc", "a", "bb"}; len(received) != 1 || !slices.Equal(received[0], wantReceived) {
+		t.Errorf("provider received %v, want %v", received, wantReceived)
+	}
+
+	var got []float32
+	for i, e := range resp.Data {
+		if e.Index != i {
+			t.Errorf("embedding %d has index %d", i, e.Index)
+		}
+		embedding, err := e.Embedding.Data().AsEmbeddingEmbedding0()
+		if err != nil {
+			t.Fatalf("unexpected embedding: %v", err)
+		}
+		got = append(got, embedding...)
+	}
+	if want := []float32{3, 1, 3, 2, 1}; !slices.Equal(got, want) {
+		t.Errorf("embeddings = %v, want %v", got, want)
+	}
+}
@@ -29,7 +29,17 @@ type Config struct {
 	Logger                           *slog.Logger
 	PollingInterval, RetentionPeriod time.Duration
 	EmbeddingsURL, APIKey, AgentID   string
-	Trigger                          trigger.Trigger
+	// JobLease is how long a claimed request can go without being renewed before another agent can claim it. Requests
+	// are claimed without a lease if it is not positive.
+	JobLease time.Duration
+	// Cache enables caching embeddings so that inputs that have been embedded before are not sent to the model
+	// provider again. Cached embeddings are removed after the CacheRetention.
+	Cache          bool
+	CacheRetention time.Duration
+	// Normalize enables scaling the embeddings to unit length before they are stored and returned.
+	Normalize bool
+	Client    *http.Client
+	Trigger   trigger.Trigger
 }
 
 func Start(ctx context.Context, wg *sync.WaitGroup, gdb *db.DB, cfg Config) error {
@@ -54,9 +64,13 @@ type agent struct {
 	client                            *http.Client
 	db                                *db.DB
 	trigger                           trigger.Trigger
+	queue                             *db.Queue
+	cache                             bool
+	cacheRetention                    time.Duration
+	normalize                         bool
 }
 
-func newAgent(db *db.DB, cfg Config) (*agent, error) {
+func newAgent(gdb *db.DB, cfg Config) (*agent, error) {
 	if cfg.PollingInterval < minPollingInterval {
 		return nil, fmt.Errorf("[embeddings] polling interval must be at least %s", minPollingInterval)
 	}
@@ -69,16 +83,24 @@ func newAgent(db *db.DB, cfg Config) (*agent, error) {
 		cfg.Trigger = trigger.NewNoop()
 	}
 
+	if cfg.Client == nil {
+		cfg.Client = http.DefaultClient
+	}
+
 	return &agent{
 		logger:           cfg.Logger,
 		pollingInterval:  cfg.PollingInterval,
 		requestRetention: cfg.RetentionPeriod,
-		client:           http.DefaultClient,
+		client:           cfg.Client,
 		apiKey:           cfg.APIKey,
-		db:               db,
+		db:               gdb,
 		id:               cfg.AgentID,
 		url:              cfg.EmbeddingsURL,
 		trigger:          cfg.Trigger,
+		queue:            db.NewQueue(cfg.AgentID, cfg.JobLease),
+		cache:            cfg.Cache,
+		cacheRetention:   cfg.CacheRetention,
+		normalize:        cfg.Normalize,
 	}, nil
 }
 
@@ -144,6 +166,12 @@ func (a *agent) Start(ctx context.Context, wg *sync.WaitGroup) {
 				a.logger.Error("failed to delete expired embeddings requests/responses", "err", err)
 			}
 
+			if a.cache {
+				if err := db.DeleteExpired(cdb, time.Now().Add(-a.cacheRetention), new(db.CachedEmbedding)); err != nil {
+					a.logger.Error("failed to delete expired cached embeddings", "err", err)
+				}
+			}
+
 			select {
 			case <-ctx.Done():
 				// Ensure the timer channel is drained
@@ -166,20 +194,7 @@ func (a *agent) run(ctx context.Context) error {
 	a.logger.Debug("Checking for an embeddings request to process")
 	// Look for a new embeddings request and claim it.
 	embedreq := new(db.CreateEmbeddingRequest)
-	if err := a.db.WithContext(ctx).Model(embedreq).Transaction(func(tx *gorm.DB) error {
-		if err := tx.Where("claimed_by IS NULL").Or("claimed_by = ? AND done = false", a.id).
-			Order("created_at desc").
-			First(embedreq).Error; err != nil {
-			return err
-		}
-
-		if err := tx.Where("id = ?", embedreq.ID).
-			Updates(map[string]interface{}{"claimed_by": a.id}).Error; err != nil {
-			return err
-		}
-
-		return nil
-	}); err != nil {
+	if err := a.queue.Claim(a.db.WithContext(ctx), embedreq); err != nil {
 		if !errors.Is(err, gorm.ErrRecordNotFound) {
 			return fmt.Errorf("failed to get embeddings request: %w", err)
 		}
@@ -190,6 +205,15 @@ func (a *agent) run(ctx context.Context) error {
 	l := a.logger.With("id", embeddingsID)
 	l.Debug("Processing request")
 
+	// Keep the claim on the request until it has been processed.
+	keepAliveCtx, stop := context.WithCancel(ctx)
+	defer stop()
+	go func() {
+		if err := a.queue.KeepAlive(keepAliveCtx, a.db.WithContext(keepAliveCtx), embedreq); err != nil {
+			l.Error("Failed to keep embeddings request claimed", "err", err)
+		}
+	}()
+
 	url := embedreq.ModelAPI
 	if url == "" {
 		url = a.url
@@ -197,13 +221,22 @@ func (a *agent) run(ctx context.Context) error {
 
 	l.Debug("Found embeddings request", "er", embedreq)
 
-	embedresp, err := makeEmbeddingsRequest(ctx, l, a.client, url, a.apiKey, embedreq)
+	embedresp, err := a.embed(ctx, l, url, embedreq)
 	if err != nil {
 		return fmt.Errorf("failed to make embeddings request: %w", err)
 	}
 
 	l.Debug("Made embeddings request", "status_code", embedresp.StatusCode)
 
+	// Embeddings are normalized after they are cached, so that the cache holds the embeddings from the model provider.
+	if a.normalize && embedresp.Error == nil {
+		if err = normalizeEmbeddings(embedresp); err != nil {
+			l.Error("Failed to normalize embeddings", "err", err)
+			embedresp.Error = z.Pointer(err.Error())
+			embedresp.StatusCode = http.StatusInternalServerError
+		}
+	}
+
 	if err = a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		if err = db.Create(tx, embedresp); err != nil {
 			return err
@@ -0,0 +1,77 @@
+package embeddings
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/gptscript-ai/clicky-chats/pkg/db"
+	"github.com/gptscript-ai/clicky-chats/pkg/generated/openai"
+	"gorm.io/datatypes"
+)
+
+// normalizeEmbeddings scales each embedding of the response to unit length, so that the cosine similarity of two
+// embeddings is their dot product. Embeddings that are base64 encoded are decoded, normalized, and encoded again.
+func normalizeEmbeddings(embedresp *db.CreateEmbeddingResponse) error {
+	for i, e := range embedresp.Data {
+		normalized, err := normalizeEmbedding(e.Embedding.Data())
+		if err != nil {
+			return fmt.Errorf("failed to normalize embedding %d: %w", e.Index, err)
+		}
+		embedresp.Data[i].Embedding = datatypes.NewJSONType(normalized)
+	}
+
+	embedresp.Normalized = true
+	return nil
+}
+
+func normalizeEmbedding(embedding openai.Embedding_Embedding) (openai.Embedding_Embedding, error) {
+	var normalized openai.Embedding_Embedding
+	if vector, err := embedding.AsEmbeddingEmbedding0(); err == nil {
+		return normalized, normalized.FromEmbeddingEmbedding0(l2Normalize(vector))
+	}
+
+	encoded, err := embedding.AsEmbeddingEmbedding1()
+	if err != nil {
+		return normalized, err
+	}
+
+	// Base64 encoded embeddings are little-endian float32 values.
+	b, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return normalized, err
+	}
+	if len(b)%4 != 0 {
+		return normalized, fmt.Errorf("base64 encoded embedding has %d bytes, which is not a multiple of 4", len(b))
+	}
+
+	vector := make([]float32, len(b)/4)
+	for i := range vector {
+		vector[i] = math.Float32frombits(binary.LittleEndian.Uint32(b[4*i:]))
+	}
+	for i, v := range l2Normalize(vector) {
+		binary.LittleEndian.PutUint32(b[4*i:], math.Float32bits(v))
+	}
+
+	return normalized, normalized.FromEmbeddingEmbedding1(base64.StdEncoding.EncodeToString(b))
+}
+
+// l2Normalize returns the vector divided by its L2 norm. A zero vector can't be normalized, so it is returned as is.
+func l2Normalize(vector []float32) []float32 {
+	var sum float64
+	for _, v := range vector {
+		sum += float64(v) * float64(v)
+	}
+	if sum == 0 {
+		return vector
+	}
+
+	norm := math.Sqrt(sum)
+	normalized := make([]float32, len(vector))
+	for i, v := range vector {
+		normalized[i] = float32(float64(v) / norm)
+	}
+
+	return normalized
+}
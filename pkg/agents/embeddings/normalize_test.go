@@ -0,0 +1,119 @@
+package embeddings
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"log/slog"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/acorn-io/z"
+	"github.com/gptscript-ai/clicky-chats/pkg/db"
+	"github.com/gptscript-ai/clicky-chats/pkg/generated/openai"
+	"gorm.io/datatypes"
+)
+
+func TestNormalizeEmbeddings(t *testing.T) {
+	// The model provider always returns the embedding (3, 4), which has a length of 5.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := new(openai.CreateEmbeddingRequest)
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		var embedding any = []float32{3, 4}
+		if z.Dereference(req.EncodingFormat) == openai.Base64 {
+			b := make([]byte, 8)
+			binary.LittleEndian.PutUint32(b, math.Float32bits(3))
+			binary.LittleEndian.PutUint32(b[4:], math.Float32bits(4))
+			embedding = base64.StdEncoding.EncodeToString(b)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"object": "list",
+			"model":  req.Model,
+			"data":   []map[string]any{{"object": "embedding", "index": 0, "embedding": embedding}},
+			"usage":  map[string]any{"prompt_tokens": 1, "total_tokens": 1},
+		})
+	}))
+	defer srv.Close()
+
+	gdb, err := db.New("sqlite://"+filepath.Join(t.TempDir(), "clicky-chats.db"), true)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	if err = gdb.AutoMigrate(); err != nil {
+		t.Fatalf("failed to migrate database: %v", err)
+	}
+
+	a, err := newAgent(gdb, Config{
+		Logger:          slog.Default(),
+		PollingInterval: minPollingInterval,
+		RetentionPeriod: minRequestRetention,
+		EmbeddingsURL:   srv.URL,
+		Normalize:       true,
+	})
+	if err != nil {
+		t.Fatalf("newAgent() error = %v", err)
+	}
+
+	ctx := context.Background()
+	for _, encodingFormat := range []string{"float", "base64"} {
+		t.Run(encodingFormat, func(t *testing.T) {
+			var input openai.CreateEmbeddingRequest_Input
+			if err := input.FromCreateEmbeddingRequestInput0("hello"); err != nil {
+				t.Fatalf("failed to create input: %v", err)
+			}
+			er := &db.CreateEmbeddingRequest{
+				Input:          datatypes.NewJSONType(input),
+				Model:          "text-embedding-3-small",
+				EncodingFormat: z.Pointer(encodingFormat),
+			}
+			if err := db.Create(gdb.WithContext(ctx), er); err != nil {
+				t.Fatalf("failed to create embeddings request: %v", err)
+			}
+			if err := a.run(ctx); err != nil {
+				t.Fatalf("run() error = %v", err)
+			}
+
+			embedresp := new(db.CreateEmbeddingResponse)
+			if err := gdb.WithContext(ctx).Where("request_id = ?", er.ID).First(embedresp).Error; err != nil {
+				t.Fatalf("failed to get embeddings response: %v", err)
+			}
+			if embedresp.Error != nil {
+				t.Fatalf("unexpected error response: %s", *embedresp.Error)
+			}
+			if !embedresp.Normalized {
+				t.Errorf("response is not marked as normalized")
+			}
+
+			embedding := embedresp.Data[0].Embedding.Data()
+			vector, err := embedding.AsEmbeddingEmbedding0()
+			if err != nil {
+				encoded, _ := embedding.AsEmbeddingEmbedding1()
+				b, err := base64.StdEncoding.DecodeString(encoded)
+				if err != nil {
+					t.Fatalf("failed to decode embedding: %v", err)
+				}
+				vector = make([]float32, len(b)/4)
+				for i := range vector {
+					vector[i] = math.Float32frombits(binary.LittleEndian.Uint32(b[4*i:]))
+				}
+			}
+
+			var sum float64
+			for _, v := range vector {
+				sum += float64(v) * float64(v)
+			}
+			if len(vector) != 2 || math.Abs(math.Sqrt(sum)-1) > 1e-6 {
+				t.Errorf("embedding = %v, want a vector with unit length", vector)
+			}
+		})
+	}
+}
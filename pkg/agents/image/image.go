@@ -37,6 +37,7 @@ type Config struct {
 	Logger                           *slog.Logger
 	PollingInterval, RetentionPeriod time.Duration
 	ImagesBaseURL, APIKey, AgentID   string
+	Client                           *http.Client
 	Trigger                          trigger.Trigger
 }
 
@@ -63,6 +64,10 @@ func newAgent(db *db.DB, cfg Config) (*agent, error) {
 		cfg.Trigger = trigger.NewNoop()
 	}
 
+	if cfg.Client == nil {
+		cfg.Client = http.DefaultClient
+	}
+
 	return &agent{
 		logger:           cfg.Logger,
 		pollingInterval:  cfg.PollingInterval,
@@ -70,7 +75,7 @@ func newAgent(db *db.DB, cfg Config) (*agent, error) {
 		generationsURL:   cfg.ImagesBaseURL + "/generations",
 		editsURL:         cfg.ImagesBaseURL + "/edits",
 		variationsURL:    cfg.ImagesBaseURL + "/variations",
-		client:           http.DefaultClient,
+		client:           cfg.Client,
 		apiKey:           cfg.APIKey,
 		db:               db,
 		id:               cfg.AgentID,
@@ -0,0 +1,151 @@
+package agents
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrWorkerPoolFull is returned when all workers are busy and the queue of waiting work is full.
+var ErrWorkerPoolFull = errors.New("too many requests are being processed, try again later")
+
+// WorkerPool bounds the number of requests an agent sends to the model provider concurrently. Work that is submitted
+// while all workers are busy will wait in a queue with a bounded depth. Once the queue is full, work is rejected.
+//
+// Queued work is dispatched in order of priority, so that, for example, interactive requests are not stuck behind batch
+// requests. Work with the same priority is dispatched in the order it was submitted.
+type WorkerPool struct {
+	workers, capacity int
+	wg                sync.WaitGroup
+
+	lock     sync.Mutex
+	running  int
+	queue    workQueue
+	sequence int
+}
+
+func NewWorkerPool(workers, queueDepth int) *WorkerPool {
+	if workers < 1 {
+		workers = 1
+	}
+	if queueDepth < 0 {
+		queueDepth = 0
+	}
+
+	return &WorkerPool{
+		workers:  workers,
+		capacity: workers + queueDepth,
+	}
+}
+
+// Full returns true if all workers are busy and the queue is full, so that submitted work would be rejected.
+func (p *WorkerPool) Full() bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	return p.running+p.queue.Len() >= p.capacity
+}
+
+// Submit will run f in its own goroutine once a worker is available and no work with a higher priority is waiting. If
+// all workers are busy and the queue is full, then ErrWorkerPoolFull is returned and f is not run. If the context is
+// canceled while f is waiting in the queue, then f is not run.
+func (p *WorkerPool) Submit(ctx context.Context, priority int, f func(context.Context)) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.running+p.queue.Len() >= p.capacity {
+		return ErrWorkerPoolFull
+	}
+
+	p.wg.Add(1)
+	w := &work{ctx: ctx, f: f, priority: priority, sequence: p.sequence}
+	p.sequence++
+
+	if p.running < p.workers {
+		p.running++
+		go p.run(w)
+		return nil
+	}
+
+	heap.Push(&p.queue, w)
+	w.stop = context.AfterFunc(ctx, func() {
+		p.lock.Lock()
+		defer p.lock.Unlock()
+
+		// The work is only removed if it hasn't been dispatched yet.
+		if w.index >= 0 {
+			heap.Remove(&p.queue, w.index)
+			p.wg.Done()
+		}
+	})
+
+	return nil
+}
+
+// run runs the work, and then the queued work with the highest priority until there is none left.
+func (p *WorkerPool) run(w *work) {
+	for w != nil {
+		w.f(w.ctx)
+		p.wg.Done()
+
+		p.lock.Lock()
+		w = nil
+		if p.queue.Len() > 0 {
+			w = heap.Pop(&p.queue).(*work)
+			w.stop()
+		} else {
+			p.running--
+		}
+		p.lock.Unlock()
+	}
+}
+
+// Wait blocks until all submitted work has completed.
+func (p *WorkerPool) Wait() {
+	p.wg.Wait()
+}
+
+type work struct {
+	ctx                context.Context
+	f                  func(context.Context)
+	stop               func() bool
+	priority, sequence int
+	// index is the index of the work in the queue, or -1 if it isn't in the queue.
+	index int
+}
+
+// workQueue is a heap of work ordered by priority, and then by the order in which the work was submitted.
+type workQueue []*work
+
+func (q workQueue) Len() int {
+	return len(q)
+}
+
+func (q workQueue) Less(i, j int) bool {
+	if q[i].priority != q[j].priority {
+		return q[i].priority > q[j].priority
+	}
+	return q[i].sequence < q[j].sequence
+}
+
+func (q workQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *workQueue) Push(x any) {
+	w := x.(*work)
+	w.index = len(*q)
+	*q = append(*q, w)
+}
+
+func (q *workQueue) Pop() any {
+	old := *q
+	w := old[len(old)-1]
+	old[len(old)-1] = nil
+	w.index = -1
+	*q = old[:len(old)-1]
+	return w
+}
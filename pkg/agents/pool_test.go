@@ -0,0 +1,161 @@
+package agents
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWorkerPool(t *testing.T) {
+	type testCase struct {
+		name       string
+		workers    int
+		queueDepth int
+		wantErr    error
+	}
+	tests := []testCase{
+		{
+			name:       "Third request is rejected without a queue",
+			workers:    2,
+			queueDepth: 0,
+			wantErr:    ErrWorkerPoolFull,
+		},
+		{
+			name:       "Third request is queued",
+			workers:    2,
+			queueDepth: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			var (
+				p       = NewWorkerPool(tt.workers, tt.queueDepth)
+				release = make(chan struct{})
+				started = make(chan int, 3)
+			)
+			for i := 0; i < 2; i++ {
+				if err := p.Submit(ctx, 0, func(context.Context) {
+					started <- i
+					<-release
+				}); err != nil {
+					t.Fatalf("Submit() unexpected error = %v", err)
+				}
+			}
+
+			// Ensure both workers are busy before submitting the third request.
+			for i := 0; i < 2; i++ {
+				<-started
+			}
+
+			if full := p.Full(); full != (tt.wantErr != nil) {
+				t.Errorf("Full() = %v, want %v", full, tt.wantErr != nil)
+			}
+
+			err := p.Submit(ctx, 0, func(context.Context) {
+				started <- 2
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Submit() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if err == nil {
+				select {
+				case <-started:
+					t.Fatalf("queued request started before a worker was available")
+				case <-time.After(50 * time.Millisecond):
+				}
+			}
+
+			close(release)
+			p.Wait()
+
+			if err == nil && len(started) != 1 {
+				t.Errorf("queued request did not run after a worker was available")
+			}
+		})
+	}
+}
+
+func TestWorkerPoolPriority(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		p        = NewWorkerPool(1, 2)
+		release  = make(chan struct{})
+		started  = make(chan struct{})
+		finished = make(chan string, 2)
+	)
+	if err := p.Submit(ctx, 0, func(context.Context) {
+		close(started)
+		<-release
+	}); err != nil {
+		t.Fatalf("Submit() unexpected error = %v", err)
+	}
+
+	// Ensure the worker is busy before queueing the other requests.
+	<-started
+
+	for _, w := range []struct {
+		name     string
+		priority int
+	}{
+		{name: "batch", priority: 0},
+		{name: "interactive", priority: 1},
+	} {
+		if err := p.Submit(ctx, w.priority, func(context.Context) {
+			finished <- w.name
+		}); err != nil {
+			t.Fatalf("Submit() unexpected error = %v", err)
+		}
+	}
+
+	close(release)
+	p.Wait()
+
+	if first := <-finished; first != "interactive" {
+		t.Errorf("%s request was dispatched first, want the interactive request", first)
+	}
+}
+
+func TestWorkerPoolCancelQueued(t *testing.T) {
+	var (
+		p       = NewWorkerPool(1, 1)
+		release = make(chan struct{})
+		started = make(chan struct{})
+	)
+	if err := p.Submit(context.Background(), 0, func(context.Context) {
+		close(started)
+		<-release
+	}); err != nil {
+		t.Fatalf("Submit() unexpected error = %v", err)
+	}
+	<-started
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := p.Submit(ctx, 0, func(context.Context) {
+		t.Errorf("cancelled request was run")
+	}); err != nil {
+		t.Fatalf("Submit() unexpected error = %v", err)
+	}
+	cancel()
+
+	// The cancelled request no longer takes up space in the queue.
+	var err error
+	for i := 0; i < 100; i++ {
+		if err = p.Submit(context.Background(), 0, func(context.Context) {}); !errors.Is(err, ErrWorkerPoolFull) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err != nil {
+		t.Errorf("Submit() error = %v after the queued request was cancelled", err)
+	}
+
+	close(release)
+	p.Wait()
+}
@@ -0,0 +1,77 @@
+package agents
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// maxRetryBackoff bounds how many times longer than the backoff the wait before a retry can be.
+const maxRetryBackoff = 16
+
+// DefaultRetryStatusCodes are the status codes of responses from the model provider that are retried if no status codes
+// are configured. These indicate that the provider is overloaded or failing, and that the same request may succeed if
+// it is sent again. Responses for invalid requests, like 400, 401, 404, and 422, are never retried by default.
+var DefaultRetryStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+}
+
+// RetryPolicy determines which failed requests to the model provider are sent again, and how long to wait before each
+// retry. The wait doubles with each retry, up to maxRetryBackoff times the backoff.
+type RetryPolicy struct {
+	maxRetries  int
+	backoff     time.Duration
+	statusCodes map[int]struct{}
+}
+
+// NewRetryPolicy returns a policy that retries a request up to maxRetries times when the model provider responds with
+// one of the status codes. If no status codes are given, then DefaultRetryStatusCodes are used. If maxRetries is not
+// positive, then requests are never retried.
+func NewRetryPolicy(maxRetries int, backoff time.Duration, statusCodes []int) *RetryPolicy {
+	if len(statusCodes) == 0 {
+		statusCodes = DefaultRetryStatusCodes
+	}
+
+	codes := make(map[int]struct{}, len(statusCodes))
+	for _, code := range statusCodes {
+		codes[code] = struct{}{}
+	}
+
+	return &RetryPolicy{
+		maxRetries:  maxRetries,
+		backoff:     backoff,
+		statusCodes: codes,
+	}
+}
+
+// ShouldRetry returns true if a request that has been retried the given number of times should be sent again after the
+// model provider responded with the status code.
+func (p *RetryPolicy) ShouldRetry(retries, statusCode int) bool {
+	if retries >= p.maxRetries {
+		return false
+	}
+
+	_, ok := p.statusCodes[statusCode]
+	return ok
+}
+
+// Wait blocks until a request that has been retried the given number of times can be sent again. The context's error
+// is returned if it is done first.
+func (p *RetryPolicy) Wait(ctx context.Context, retries int) error {
+	if p.backoff <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(p.backoff * time.Duration(min(1<<retries, maxRetryBackoff)))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -22,21 +22,11 @@ import (
 func prepareChatCompletionRequest(ctx context.Context, builtInFunctionDefinitions map[string]*openai.FunctionObject, run *db.Run, assistant *db.Assistant, tools []db.Tool, messages []db.Message, runSteps []db.RunStep) (*db.CreateChatCompletionRequest, error) {
 	chatMessages := make([]openai.ChatCompletionRequestMessage, 0, len(messages))
 
-	if run.Instructions != "" {
+	if instructions := runInstructions(run, assistant); instructions != "" {
 		m := new(openai.ChatCompletionRequestMessage)
 		if err := m.FromChatCompletionRequestSystemMessage(openai.ChatCompletionRequestSystemMessage{
 			Role:    openai.ChatCompletionRequestSystemMessageRoleSystem,
-			Content: run.Instructions,
-		}); err != nil {
-			return nil, err
-		}
-
-		chatMessages = append(chatMessages, *m)
-	} else if assistantInstructions := z.Dereference(assistant.Instructions); assistantInstructions != "" {
-		m := new(openai.ChatCompletionRequestMessage)
-		if err := m.FromChatCompletionRequestSystemMessage(openai.ChatCompletionRequestSystemMessage{
-			Role:    openai.ChatCompletionRequestSystemMessageRoleSystem,
-			Content: assistantInstructions,
+			Content: instructions,
 		}); err != nil {
 			return nil, err
 		}
@@ -88,6 +78,24 @@ func prepareChatCompletionRequest(ctx context.Context, builtInFunctionDefinition
 	}, nil
 }
 
+// runInstructions returns the instructions for the run. The instructions of the run override those of the assistant, and
+// the additional instructions of the run are appended to whichever is used.
+func runInstructions(run *db.Run, assistant *db.Assistant) string {
+	instructions := run.Instructions
+	if instructions == "" {
+		instructions = z.Dereference(assistant.Instructions)
+	}
+
+	if additionalInstructions := z.Dereference(run.AdditionalInstructions); additionalInstructions != "" {
+		if instructions != "" {
+			instructions += "\n\n"
+		}
+		instructions += additionalInstructions
+	}
+
+	return instructions
+}
+
 func createChatMessageFromThreadMessage(threadMessage *db.Message) (*openai.ChatCompletionRequestMessage, error) {
 	m := new(openai.ChatCompletionRequestMessage)
 	sb := strings.Builder{}
@@ -222,6 +230,15 @@ func processAllChunks(ctx context.Context, gdb *gorm.DB, run *db.Run, runStep *d
 			}
 
 			// These chat completions should only have one choice.
+			if len(chunk.Choices) > 0 && chunk.Choices[0].FinishReason == string(openai.CreateChatCompletionStreamResponseChoicesFinishReasonContentFilter) {
+				// The provider omitted content because of its content filters, so the message is incomplete.
+				message.IncompleteDetails = datatypes.NewJSONType(&struct {
+					Reason openai.MessageObjectIncompleteDetailsReason `json:"reason"`
+				}{
+					Reason: openai.ContentFilter,
+				})
+			}
+
 			responseIsMessage = responseIsMessage || len(chunk.Choices) > 0 && chunk.Choices[0].Delta.Data().Content != nil
 			if !responseIsMessage {
 				// If the response is not a message, then the response is a tool call.
@@ -527,10 +544,20 @@ func finalizeStatuses(gdb *gorm.DB, l *slog.Logger, run *db.Run, runStep *db.Run
 		}
 
 		if message.ID != "" {
-			if err := tx.Model(message).Where("id = ?", message.ID).Updates(map[string]any{
-				"status":       string(openai.ThreadMessageCompleted),
+			messageUpdates, messageEvent := map[string]any{
+				"status":       string(openai.MessageObjectStatusCompleted),
 				"completed_at": completedAt,
-			}).Error; err != nil {
+			}, string(openai.ThreadMessageCompleted)
+			if message.IncompleteDetails.Data() != nil {
+				// The content of the message was filtered by the provider.
+				messageUpdates, messageEvent = map[string]any{
+					"status":             string(openai.MessageObjectStatusIncomplete),
+					"incomplete_at":      z.Pointer(int(time.Now().Unix())),
+					"incomplete_details": message.IncompleteDetails,
+				}, string(openai.ThreadMessageIncomplete)
+			}
+
+			if err := tx.Model(message).Clauses(clause.Returning{}).Where("id = ?", message.ID).Updates(messageUpdates).Error; err != nil {
 				return err
 			}
 
@@ -541,7 +568,7 @@ func finalizeStatuses(gdb *gorm.DB, l *slog.Logger, run *db.Run, runStep *db.Run
 					RequestID: run.ID,
 				},
 				Message:     datatypes.NewJSONType(message),
-				EventName:   string(openai.ThreadMessageCompleted),
+				EventName:   messageEvent,
 				ResponseIdx: run.EventIndex,
 			})
 
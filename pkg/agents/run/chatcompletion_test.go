@@ -0,0 +1,312 @@
+package run
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"testing"
+
+	"github.com/acorn-io/z"
+	"github.com/gptscript-ai/clicky-chats/pkg/db"
+	"github.com/gptscript-ai/clicky-chats/pkg/generated/openai"
+	"gorm.io/datatypes"
+)
+
+func TestPrepareChatCompletionRequestInstructions(t *testing.T) {
+	type testCase struct {
+		name                   string
+		assistantInstructions  *string
+		runInstructions        string
+		additionalInstructions *string
+		want                   string
+	}
+	tests := []testCase{
+		{
+			name:                  "Assistant instructions",
+			assistantInstructions: z.Pointer("You are a helpful assistant."),
+			want:                  "You are a helpful assistant.",
+		},
+		{
+			name:                  "Run instructions override assistant instructions",
+			assistantInstructions: z.Pointer("You are a helpful assistant."),
+			runInstructions:       "You are a pirate.",
+			want:                  "You are a pirate.",
+		},
+		{
+			name:                   "Additional instructions are appended",
+			assistantInstructions:  z.Pointer("You are a helpful assistant."),
+			additionalInstructions: z.Pointer("Address the user as Jane."),
+			want:                   "You are a helpful assistant.\n\nAddress the user as Jane.",
+		},
+		{
+			name:                   "Additional instructions are appended to the override",
+			assistantInstructions:  z.Pointer("You are a helpful assistant."),
+			runInstructions:        "You are a pirate.",
+			additionalInstructions: z.Pointer("Address the user as Jane."),
+			want:                   "You are a pirate.\n\nAddress the user as Jane.",
+		},
+		{
+			name:                   "Only additional instructions",
+			additionalInstructions: z.Pointer("Address the user as Jane."),
+			want:                   "Address the user as Jane.",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			run := &db.Run{
+				Instructions:           tt.runInstructions,
+				AdditionalInstructions: tt.additionalInstructions,
+			}
+			assistant := &db.Assistant{
+				Instructions: tt.assistantInstructions,
+				Model:        "gpt-4",
+			}
+
+			cc, err := prepareChatCompletionRequest(context.Background(), nil, run, assistant, nil, nil, nil)
+			if err != nil {
+				t.Fatalf("prepareChatCompletionRequest() error = %v", err)
+			}
+
+			if len(cc.Messages) != 1 {
+				t.Fatalf("expected a single system message, got %d messages", len(cc.Messages))
+			}
+
+			systemMessage, err := cc.Messages[0].AsChatCompletionRequestSystemMessage()
+			if err != nil {
+				t.Fatalf("failed to get system message: %v", err)
+			}
+			if systemMessage.Content != tt.want {
+				t.Errorf("system message = %q, want %q", systemMessage.Content, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunMessageOrdering(t *testing.T) {
+	gdb := newTestDB(t)
+
+	tx := gdb.WithContext(context.Background())
+	thread := new(db.Thread)
+	if err := db.Create(tx, thread); err != nil {
+		t.Fatalf("failed to create thread: %v", err)
+	}
+	run := &db.Run{AssistantID: "asst_test", ThreadID: thread.ID}
+	if err := db.Create(tx, run); err != nil {
+		t.Fatalf("failed to create run: %v", err)
+	}
+
+	// The messages are created in quick succession, so most of them have the same creation time.
+	var ids []string
+	for i := 0; i < 10; i++ {
+		message := &db.Message{
+			Role:        "assistant",
+			AssistantID: &run.AssistantID,
+			ThreadID:    run.ThreadID,
+			RunID:       &run.ID,
+		}
+		db.SetNewID(message)
+		if err := message.WithTextContent(fmt.Sprintf("message %d", i)); err != nil {
+			t.Fatalf("failed to set message content: %v", err)
+		}
+		if err := createMessageObject(tx, run, message); err != nil {
+			t.Fatalf("createMessageObject() error = %v", err)
+		}
+		ids = append(ids, message.ID)
+	}
+
+	var messages []db.Message
+	if err := tx.Where("thread_id = ?", thread.ID).Order("created_at asc").Order("thread_index asc").Find(&messages).Error; err != nil {
+		t.Fatalf("failed to list messages: %v", err)
+	}
+
+	if len(messages) != len(ids) {
+		t.Fatalf("expected %d messages, got %d", len(ids), len(messages))
+	}
+	for i, message := range messages {
+		if message.ID != ids[i] {
+			t.Errorf("message %d has id %s, want %s", i, message.ID, ids[i])
+		}
+		if message.ThreadIndex != i+1 {
+			t.Errorf("message %d has thread index %d, want %d", i, message.ThreadIndex, i+1)
+		}
+		if z.Dereference(message.RunID) != run.ID {
+			t.Errorf("message %d has run id %q, want %q", i, z.Dereference(message.RunID), run.ID)
+		}
+		if z.Dereference(message.AssistantID) != run.AssistantID {
+			t.Errorf("message %d has assistant id %q, want %q", i, z.Dereference(message.AssistantID), run.AssistantID)
+		}
+	}
+}
+
+func TestContentFilterFinishReason(t *testing.T) {
+	type testCase struct {
+		name             string
+		finishReason     openai.CreateChatCompletionStreamResponseChoicesFinishReason
+		wantStatus       openai.MessageObjectStatus
+		wantIncomplete   bool
+		wantMessageEvent string
+	}
+	tests := []testCase{
+		{
+			name:             "Stop",
+			finishReason:     openai.CreateChatCompletionStreamResponseChoicesFinishReasonStop,
+			wantStatus:       openai.MessageObjectStatusCompleted,
+			wantMessageEvent: string(openai.ThreadMessageCompleted),
+		},
+		{
+			name:             "Content filter",
+			finishReason:     openai.CreateChatCompletionStreamResponseChoicesFinishReasonContentFilter,
+			wantStatus:       openai.MessageObjectStatusIncomplete,
+			wantIncomplete:   true,
+			wantMessageEvent: string(openai.ThreadMessageIncomplete),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gdb := newTestDB(t)
+
+			tx := gdb.WithContext(context.Background())
+			thread := new(db.Thread)
+			if err := db.Create(tx, thread); err != nil {
+				t.Fatalf("failed to create thread: %v", err)
+			}
+			run := &db.Run{AssistantID: "asst_test", ThreadID: thread.ID}
+			if err := db.Create(tx, run); err != nil {
+				t.Fatalf("failed to create run: %v", err)
+			}
+
+			stream := make(chan db.ChatCompletionResponseChunk, 2)
+			stream <- db.ChatCompletionResponseChunk{
+				Choices: []db.ChunkChoice{{
+					Delta: datatypes.NewJSONType(openai.ChatCompletionStreamResponseDelta{Content: z.Pointer("Hello")}),
+				}},
+			}
+			stream <- db.ChatCompletionResponseChunk{
+				Choices: []db.ChunkChoice{{FinishReason: string(tt.finishReason)}},
+			}
+			close(stream)
+
+			if err := compileChunksAndApplyStatuses(context.Background(), slog.Default(), tx, run, stream); err != nil {
+				t.Fatalf("compileChunksAndApplyStatuses() error = %v", err)
+			}
+
+			message := new(db.Message)
+			if err := tx.Where("run_id = ?", run.ID).First(message).Error; err != nil {
+				t.Fatalf("failed to get message: %v", err)
+			}
+
+			if openai.MessageObjectStatus(message.Status) != tt.wantStatus {
+				t.Errorf("message status = %q, want %q", message.Status, tt.wantStatus)
+			}
+			if details := message.IncompleteDetails.Data(); (details != nil && details.Reason == openai.ContentFilter) != tt.wantIncomplete {
+				t.Errorf("message incomplete details = %#v, want content filter = %t", details, tt.wantIncomplete)
+			}
+
+			var count int64
+			if err := tx.Model(new(db.RunEvent)).Where("request_id = ? AND event_name = ?", run.ID, tt.wantMessageEvent).Count(&count).Error; err != nil {
+				t.Fatalf("failed to count run events: %v", err)
+			}
+			if count != 1 {
+				t.Errorf("expected one %s event, got %d", tt.wantMessageEvent, count)
+			}
+		})
+	}
+}
+
+func TestThreadChatCompletionMessages(t *testing.T) {
+	gdb := newTestDB(t)
+
+	tx := gdb.WithContext(context.Background())
+	thread := new(db.Thread)
+	if err := db.Create(tx, thread); err != nil {
+		t.Fatalf("failed to create thread: %v", err)
+	}
+
+	newMessage := func(role, content string, runID *string) {
+		t.Helper()
+		message := &db.Message{Role: role, ThreadID: thread.ID, RunID: runID}
+		if err := message.WithTextContent(content); err != nil {
+			t.Fatalf("failed to set message content: %v", err)
+		}
+		if err := db.Create(tx, message); err != nil {
+			t.Fatalf("failed to create message: %v", err)
+		}
+	}
+	newToolCallStep := func(runID, status, callID string, output *string) {
+		t.Helper()
+		item := new(openai.RunStepDetailsToolCallsObject_ToolCalls_Item)
+		functionCall := openai.RunStepDetailsToolCallsFunctionObject{Id: callID, Type: openai.RunStepDetailsToolCallsFunctionObjectTypeFunction}
+		functionCall.Function.Name = "get_weather"
+		functionCall.Function.Arguments = `{"city": "Paris"}`
+		functionCall.Function.Output = output
+		if err := item.FromRunStepDetailsToolCallsFunctionObject(functionCall); err != nil {
+			t.Fatalf("failed to create tool call: %v", err)
+		}
+
+		stepDetails := new(openai.RunStepObject_StepDetails)
+		if err := stepDetails.FromRunStepDetailsToolCallsObject(openai.RunStepDetailsToolCallsObject{
+			ToolCalls: []openai.RunStepDetailsToolCallsObject_ToolCalls_Item{*item},
+			Type:      openai.RunStepDetailsToolCallsObjectTypeToolCalls,
+		}); err != nil {
+			t.Fatalf("failed to create step details: %v", err)
+		}
+
+		if err := db.Create(tx, &db.RunStep{
+			RunID:       runID,
+			ThreadID:    thread.ID,
+			Status:      status,
+			Type:        string(openai.RunStepDetailsToolCallsObjectTypeToolCalls),
+			StepDetails: datatypes.NewJSONType(*stepDetails),
+		}); err != nil {
+			t.Fatalf("failed to create run step: %v", err)
+		}
+	}
+
+	// Everything is created in the same second, so the order has to come from the messages' indexes and the runs.
+	newMessage(string(openai.User), "What is the weather in Paris?", nil)
+	newToolCallStep("run_1", string(openai.RunObjectStatusCompleted), "call_1", z.Pointer("Sunny"))
+	newMessage(string(openai.Assistant), "It is sunny in Paris.", z.Pointer("run_1"))
+	newMessage(string(openai.User), "And tomorrow?", nil)
+	// This tool call is still waiting for its output.
+	newToolCallStep("run_2", string(openai.RunObjectStatusInProgress), "call_2", nil)
+
+	messages, err := ThreadChatCompletionMessages(tx, thread.ID)
+	if err != nil {
+		t.Fatalf("ThreadChatCompletionMessages() error = %v", err)
+	}
+
+	var roles []string
+	for _, m := range messages {
+		b, err := m.MarshalJSON()
+		if err != nil {
+			t.Fatalf("failed to marshal message: %v", err)
+		}
+
+		var message struct {
+			Role       string `json:"role"`
+			ToolCallID string `json:"tool_call_id"`
+			ToolCalls  []struct {
+				ID string `json:"id"`
+			} `json:"tool_calls"`
+		}
+		if err = json.Unmarshal(b, &message); err != nil {
+			t.Fatalf("failed to unmarshal message: %v", err)
+		}
+
+		role := message.Role
+		switch {
+		case len(message.ToolCalls) > 0:
+			role += "(tool_calls: " + message.ToolCalls[0].ID + ")"
+		case message.ToolCallID != "":
+			role += "(" + message.ToolCallID + ")"
+		}
+		roles = append(roles, role)
+	}
+
+	want := []string{"user", "assistant(tool_calls: call_1)", "tool(call_1)", "assistant", "user"}
+	if !slices.Equal(roles, want) {
+		t.Errorf("messages = %v, want %v", roles, want)
+	}
+}
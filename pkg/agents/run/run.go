@@ -29,6 +29,13 @@ type Config struct {
 	PollingInterval, RetentionPeriod time.Duration
 	APIURL, APIKey, AgentID          string
 	Trigger, RunStepTrigger          trigger.Trigger
+	// MaxToolIterations is the number of tool call round trips a run can make before it fails. Runs can override it. If
+	// zero, then the number of round trips is not limited.
+	MaxToolIterations int
+	// MaxPollingInterval is the longest the agent waits between polls for runs. The wait doubles from the
+	// PollingInterval each time there is no run to process. If it is not more than the PollingInterval, then the agent
+	// always polls at the PollingInterval.
+	MaxPollingInterval time.Duration
 }
 
 func Start(ctx context.Context, wg *sync.WaitGroup, gdb *db.DB, cfg Config) error {
@@ -58,6 +65,8 @@ type agent struct {
 	db                               *db.DB
 	builtInToolDefinitions           map[string]*openai.FunctionObject
 	trigger, runStepTrigger          trigger.Trigger
+	maxToolIterations                int
+	pollingBackoff                   *agents.PollingBackoff
 }
 
 func newAgent(db *db.DB, cfg Config) (*agent, error) {
@@ -67,6 +76,9 @@ func newAgent(db *db.DB, cfg Config) (*agent, error) {
 	if cfg.RetentionPeriod < minRequestRetention {
 		return nil, fmt.Errorf("[run] request retention must be at least %s", minRequestRetention)
 	}
+	if cfg.MaxToolIterations < 0 {
+		return nil, fmt.Errorf("[run] max tool iterations must not be negative")
+	}
 
 	if cfg.Trigger == nil {
 		cfg.Logger.Warn("[run] No trigger provided, using noop")
@@ -88,6 +100,9 @@ func newAgent(db *db.DB, cfg Config) (*agent, error) {
 		url:             cfg.APIURL,
 		trigger:         cfg.Trigger,
 		runStepTrigger:  cfg.RunStepTrigger,
+
+		maxToolIterations: cfg.MaxToolIterations,
+		pollingBackoff:    agents.NewPollingBackoff(cfg.PollingInterval, cfg.MaxPollingInterval),
 	}, nil
 }
 
@@ -98,7 +113,20 @@ func (a *agent) Start(ctx context.Context, wg *sync.WaitGroup) {
 		defer wg.Done()
 		timer := time.NewTimer(a.pollingInterval)
 		for {
-			if err := a.run(ctx); err != nil {
+			err := a.run(ctx)
+
+			if !timer.Stop() {
+				// Ensure the timer channel has been drained.
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+
+			// The wait grows while there are no runs to process.
+			timer.Reset(a.pollingBackoff.Next(err == nil))
+
+			if err != nil {
 				if !errors.Is(err, gorm.ErrRecordNotFound) {
 					a.logger.Error("failed run iteration", "err", err)
 				}
@@ -116,16 +144,6 @@ func (a *agent) Start(ctx context.Context, wg *sync.WaitGroup) {
 				case <-a.trigger.Triggered():
 				}
 			}
-
-			if !timer.Stop() {
-				// Ensure the timer channel has been drained.
-				select {
-				case <-timer.C:
-				default:
-				}
-			}
-
-			timer.Reset(a.pollingInterval)
 		}
 	}()
 
@@ -221,6 +239,15 @@ func (a *agent) run(ctx context.Context) error {
 			return err
 		}
 
+		// The run is processed with the tools it was created with, even if the assistant's tools have changed since.
+		if run.AssistantToolsVersion != nil {
+			runTools, err := run.AssistantTools()
+			if err != nil {
+				return err
+			}
+			assistant.Tools = runTools
+		}
+
 		toolIDs, err := assistant.ExtractGPTScriptTools(a.builtInToolDefinitions)
 		if err != nil {
 			return err
@@ -230,7 +257,7 @@ func (a *agent) run(ctx context.Context) error {
 			return err
 		}
 
-		if err := tx.Model(new(db.Message)).Where("thread_id = ?", run.ThreadID).Where("created_at <= ?", run.CreatedAt).Order("created_at asc").Find(&messages).Error; err != nil {
+		if err := tx.Model(new(db.Message)).Where("thread_id = ?", run.ThreadID).Where("created_at <= ?", run.CreatedAt).Order("created_at asc").Order("thread_index asc").Order("id asc").Find(&messages).Error; err != nil {
 			return err
 		}
 
@@ -293,6 +320,25 @@ func (a *agent) run(ctx context.Context) error {
 	}()
 
 	l.Debug("Found run", "run", run)
+
+	// Each tool calls run step is a round trip to the model, so the run has to stop once it has made as many as it is
+	// allowed to.
+	maxToolIterations := a.maxToolIterations
+	if run.MaxToolIterations != nil {
+		maxToolIterations = *run.MaxToolIterations
+	}
+	if maxToolIterations > 0 && len(runSteps) >= maxToolIterations {
+		l.Warn("Run exceeded the max tool iterations", "maxToolIterations", maxToolIterations)
+		if err := a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			return failRun(tx, run, fmt.Errorf("max tool iterations exceeded: the run made %d tool call round trips", len(runSteps)), openai.RunObjectLastErrorCodeMaxToolIterationsExceeded)
+		}); err != nil {
+			l.Error("failed to fail run", "error", err)
+		}
+
+		a.trigger.Ready(runID)
+		return nil
+	}
+
 	cc, err := prepareChatCompletionRequest(ctx, a.builtInToolDefinitions, run, assistant, tools, messages, runSteps)
 	if err != nil {
 		l.Error("Failed to prepare chat completion request", "err", err)
@@ -0,0 +1,124 @@
+package run
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/acorn-io/z"
+	"github.com/gptscript-ai/clicky-chats/pkg/db"
+	"github.com/gptscript-ai/clicky-chats/pkg/generated/openai"
+)
+
+// newTestDB returns a new, migrated database for a test.
+func newTestDB(t *testing.T) *db.DB {
+	t.Helper()
+
+	gdb, err := db.New("sqlite://"+filepath.Join(t.TempDir(), "clicky-chats.db"), true)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	if err = gdb.AutoMigrate(); err != nil {
+		t.Fatalf("failed to migrate database: %v", err)
+	}
+
+	return gdb
+}
+
+func TestMaxToolIterations(t *testing.T) {
+	// The model responds to every request with another tool call, so the run would never finish on its own.
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		n := requests.Add(1)
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = fmt.Fprintf(w, `data: {"id": "chatcmpl-test", "object": "chat.completion.chunk", "created": 1, "model": "gpt-4", "choices": [{"index": 0, "delta": {"role": "assistant", "tool_calls": [{"index": 0, "id": "call_%d", "type": "function", "function": {"name": "gptscript_loop", "arguments": "{}"}}]}, "finish_reason": null}]}`+"\n\n", n)
+		_, _ = w.Write([]byte(`data: {"id": "chatcmpl-test", "object": "chat.completion.chunk", "created": 1, "model": "gpt-4", "choices": [{"index": 0, "delta": {}, "finish_reason": "tool_calls"}]}` + "\n\n"))
+		_, _ = w.Write([]byte("data: [DONE]\n\n"))
+	}))
+	defer srv.Close()
+
+	gdb := newTestDB(t)
+
+	ctx := context.Background()
+	tx := gdb.WithContext(ctx)
+
+	assistant := &db.Assistant{Model: "gpt-4"}
+	if err := db.Create(tx, assistant); err != nil {
+		t.Fatalf("failed to create assistant: %v", err)
+	}
+	thread := new(db.Thread)
+	if err := db.Create(tx, thread); err != nil {
+		t.Fatalf("failed to create thread: %v", err)
+	}
+	// The run overrides the limit of the agent.
+	run := &db.Run{
+		AssistantID:       assistant.ID,
+		ThreadID:          thread.ID,
+		Model:             "gpt-4",
+		Status:            string(openai.RunObjectStatusQueued),
+		MaxToolIterations: z.Pointer(2),
+	}
+	if err := db.Create(tx, run); err != nil {
+		t.Fatalf("failed to create run: %v", err)
+	}
+	if err := tx.Model(thread).Update("locked_by_run_id", run.ID).Error; err != nil {
+		t.Fatalf("failed to lock thread: %v", err)
+	}
+
+	a, err := newAgent(gdb, Config{
+		Logger:            slog.Default(),
+		PollingInterval:   minPollingInterval,
+		RetentionPeriod:   minRequestRetention,
+		APIURL:            srv.URL,
+		AgentID:           "test-agent",
+		MaxToolIterations: 5,
+	})
+	if err != nil {
+		t.Fatalf("newAgent() error = %v", err)
+	}
+
+	for i := 0; i < 5; i++ {
+		if err = a.run(ctx); err != nil {
+			t.Fatalf("run() error = %v", err)
+		}
+
+		if err = tx.Where("id = ?", run.ID).First(run).Error; err != nil {
+			t.Fatalf("failed to get run: %v", err)
+		}
+		if run.Status == string(openai.RunObjectStatusFailed) {
+			break
+		}
+
+		// Complete the tool calls the way the step runner would, so that the run is sent back to the model.
+		if err = tx.Model(new(db.RunStep)).Where("run_id = ?", run.ID).Where("status = ?", openai.RunObjectStatusInProgress).Update("status", openai.RunObjectStatusCompleted).Error; err != nil {
+			t.Fatalf("failed to complete run step: %v", err)
+		}
+		if err = tx.Model(run).Where("id = ?", run.ID).Update("system_status", openai.RunObjectStatusQueued).Error; err != nil {
+			t.Fatalf("failed to queue run: %v", err)
+		}
+	}
+
+	if run.Status != string(openai.RunObjectStatusFailed) {
+		t.Fatalf("run status = %s, want %s", run.Status, openai.RunObjectStatusFailed)
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("made %d chat completion requests, want 2", got)
+	}
+	if lastError := run.LastError.Data(); lastError == nil || lastError.Code != string(openai.RunObjectLastErrorCodeMaxToolIterationsExceeded) {
+		t.Errorf("last error = %+v, want code %s", lastError, openai.RunObjectLastErrorCodeMaxToolIterationsExceeded)
+	}
+
+	// The thread is unlocked so that it can be run again.
+	unlocked := new(db.Thread)
+	if err = tx.Where("id = ?", thread.ID).First(unlocked).Error; err != nil {
+		t.Fatalf("failed to get thread: %v", err)
+	}
+	if unlocked.LockedByRunID != "" {
+		t.Errorf("thread is locked by %s, want it to be unlocked", unlocked.LockedByRunID)
+	}
+}
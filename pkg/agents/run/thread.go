@@ -0,0 +1,84 @@
+package run
+
+import (
+	"github.com/gptscript-ai/clicky-chats/pkg/db"
+	"github.com/gptscript-ai/clicky-chats/pkg/generated/openai"
+	"gorm.io/gorm"
+)
+
+// ThreadChatCompletionMessages returns the full history of the thread as chat completion messages that can be used in a
+// new chat completion request. The tool calls made by the thread's runs are reconstructed from their completed run
+// steps: each is an assistant message with the tool calls, followed by a tool message with the output of each call.
+// Tool calls that are still waiting for their outputs are left out, since a chat completion request must have an output
+// for every tool call.
+func ThreadChatCompletionMessages(gdb *gorm.DB, threadID string) ([]openai.ChatCompletionRequestMessage, error) {
+	var (
+		messages []db.Message
+		runSteps []db.RunStep
+	)
+	if err := gdb.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(new(db.Message)).Where("thread_id = ?", threadID).Order("created_at asc").Order("thread_index asc").Find(&messages).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(new(db.RunStep)).Where("thread_id = ?", threadID).
+			Where("type = ?", openai.RunStepDetailsToolCallsObjectTypeToolCalls).
+			Where("status = ?", openai.RunObjectStatusCompleted).
+			Order("created_at asc").Find(&runSteps).Error
+	}); err != nil {
+		return nil, err
+	}
+
+	// A run makes its tool calls before it responds with a message, so the run steps of a run are placed right before its
+	// first message. The run steps of runs that haven't created a message are placed by their creation time.
+	runsWithMessages := make(map[string]bool, len(messages))
+	for _, message := range messages {
+		if message.RunID != nil {
+			runsWithMessages[*message.RunID] = true
+		}
+	}
+
+	var (
+		chatMessages = make([]openai.ChatCompletionRequestMessage, 0, len(messages)+2*len(runSteps))
+		added        = make(map[string]bool, len(runSteps))
+	)
+	addRunSteps := func(include func(db.RunStep) bool) error {
+		for _, runStep := range runSteps {
+			if added[runStep.ID] || !include(runStep) {
+				continue
+			}
+
+			m, err := createChatMessageFromToolOutput(runStep.StepDetails.Data())
+			if err != nil {
+				return err
+			}
+
+			chatMessages = append(chatMessages, m...)
+			added[runStep.ID] = true
+		}
+		return nil
+	}
+
+	for _, message := range messages {
+		if err := addRunSteps(func(runStep db.RunStep) bool {
+			if message.RunID != nil && runStep.RunID == *message.RunID {
+				return true
+			}
+			return !runsWithMessages[runStep.RunID] && runStep.CreatedAt < message.CreatedAt
+		}); err != nil {
+			return nil, err
+		}
+
+		m, err := createChatMessageFromThreadMessage(&message)
+		if err != nil {
+			return nil, err
+		}
+		chatMessages = append(chatMessages, *m)
+	}
+
+	if err := addRunSteps(func(db.RunStep) bool { return true }); err != nil {
+		return nil, err
+	}
+
+	return chatMessages, nil
+}
@@ -46,7 +46,12 @@ type Config struct {
 	PollingInterval         time.Duration
 	APIURL, APIKey, AgentID string
 	Cache, Confirm          bool
-	Trigger, RunTrigger     trigger.Trigger
+	// ToolTimeout bounds each tool call, unless the tool has a timeout in ToolTimeouts. A tool call that times out is
+	// aborted and its output says so, and the run continues. Tool calls are only bounded by the timeout of the whole run
+	// step if the timeout is not positive.
+	ToolTimeout         time.Duration
+	ToolTimeouts        map[string]time.Duration
+	Trigger, RunTrigger trigger.Trigger
 }
 
 var inputModifiers = map[string]func(*agent, *db.RunStep, []string, string) ([]string, string, error){
@@ -89,6 +94,8 @@ type agent struct {
 	db                  *db.DB
 	kbm                 *kb.KnowledgeBaseManager
 	trigger, runTrigger trigger.Trigger
+	toolTimeout         time.Duration
+	toolTimeouts        map[string]time.Duration
 
 	builtInToolDefinitions map[string]types.Program
 }
@@ -120,6 +127,8 @@ func newAgent(db *db.DB, kbm *kb.KnowledgeBaseManager, cfg Config) (*agent, erro
 		url:             cfg.APIURL,
 		trigger:         cfg.Trigger,
 		runTrigger:      cfg.RunTrigger,
+		toolTimeout:     cfg.ToolTimeout,
+		toolTimeouts:    cfg.ToolTimeouts,
 	}, nil
 }
 
@@ -283,7 +292,9 @@ func (a *agent) processRunStep(ctx context.Context, events *broadcaster.Subscrip
 
 		gdb := a.db.WithContext(ctx)
 		confirmCtx := confirm.WithConfirm(timeoutCtx, &stepConfirmer{db: gdb, run: run, runStep: runStep, toolCallID: id, confirm: a.confirm})
-		output, err := agents.RunTool(confirmCtx, l, events, gdb, opts, prg, envs, arguments, run.ID, runStep.ID)
+		toolCtx, cancelTool := a.toolContext(confirmCtx, functionName)
+		output, err := agents.RunTool(toolCtx, l, events, gdb, opts, prg, envs, arguments, run.ID, runStep.ID)
+		cancelTool()
 		if err != nil {
 			return fmt.Errorf("failed to run tool call at index %d: %w", i, err)
 		}
@@ -437,6 +448,19 @@ func extractToolCalls(runStepDetails *openai.RunStepObject_StepDetails) ([]opena
 	return toolCallDetails.ToolCalls, nil
 }
 
+// toolContext returns a context for a call of the tool that is bounded by the timeout for the tool.
+func (a *agent) toolContext(ctx context.Context, functionName string) (context.Context, context.CancelFunc) {
+	timeout, ok := a.toolTimeouts[functionName]
+	if !ok {
+		timeout = a.toolTimeout
+	}
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
+
 func determineFunctionAndArguments(toolCall *openai.RunStepDetailsToolCallsObject_ToolCalls_Item) (string, string, string, error) {
 	info, err := db.GetOutputForRunStepToolCall(toolCall)
 	if err != nil {
@@ -68,8 +68,30 @@ func RunTool(ctx context.Context, l *slog.Logger, events *broadcaster.Subscripti
 		l.Debug("done receiving events")
 	}()
 
-	output, err := runToolCall(server.ContextWithNewID(ctx), opts, prg, envs, arguments)
-	if errors.Is(err, context.DeadlineExceeded) {
+	// Don't wait for the tool to exit once the context is done: a tool that doesn't exit when it is killed, for example
+	// because its child processes keep running, would otherwise block the run.
+	type result struct {
+		output string
+		err    error
+	}
+	done := make(chan result, 1)
+	go func() {
+		output, err := runToolCall(server.ContextWithNewID(ctx), opts, prg, envs, arguments)
+		done <- result{output, err}
+	}()
+
+	var (
+		output string
+		err    error
+	)
+	select {
+	case r := <-done:
+		output, err = r.output, r.err
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	if err != nil && (errors.Is(err, context.DeadlineExceeded) || errors.Is(ctx.Err(), context.DeadlineExceeded)) {
 		output = "The tool call took too long to complete, aborting"
 	} else if execErr := new(exec.ExitError); errors.As(err, &execErr) {
 		output = fmt.Sprintf("The tool call returned an exit code of %d with message %q, aborting", execErr.ExitCode(), execErr.String())
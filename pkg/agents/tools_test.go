@@ -0,0 +1,78 @@
+package agents
+
+import (
+	"context"
+	"log/slog"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/acorn-io/broadcaster"
+	"github.com/acorn-io/z"
+	"github.com/gptscript-ai/clicky-chats/pkg/db"
+	"github.com/gptscript-ai/gptscript/pkg/cache"
+	"github.com/gptscript-ai/gptscript/pkg/gptscript"
+	"github.com/gptscript-ai/gptscript/pkg/loader"
+	"github.com/gptscript-ai/gptscript/pkg/runner"
+	"github.com/gptscript-ai/gptscript/pkg/server"
+)
+
+func TestRunToolTimeout(t *testing.T) {
+	gdb, err := db.New("sqlite://"+filepath.Join(t.TempDir(), "clicky-chats.db"), true)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	if err = gdb.AutoMigrate(); err != nil {
+		t.Fatalf("failed to migrate database: %v", err)
+	}
+
+	caster := broadcaster.New[server.Event]()
+	go caster.Start(context.Background())
+	defer caster.Shutdown()
+
+	opts := &gptscript.Options{
+		Cache:  cache.Options{Cache: z.Pointer(false)},
+		Runner: runner.Options{MonitorFactory: server.NewSessionFactory(caster)},
+	}
+
+	tests := []struct {
+		name, source, wantOutput string
+	}{
+		{
+			name:       "Fast tool",
+			source:     "name: fast\n\n#!/bin/sh\necho done\n",
+			wantOutput: "done\n",
+		},
+		{
+			// The shell is killed when the tool times out, but its child keeps the tool's output open until it exits.
+			name:       "Slow tool",
+			source:     "name: slow\n\n#!/bin/sh\nsleep 10\necho done\n",
+			wantOutput: "The tool call took too long to complete, aborting",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			prg, err := loader.ProgramFromSource(ctx, tt.source, "")
+			if err != nil {
+				t.Fatalf("failed to load program: %v", err)
+			}
+
+			ctx, cancel := context.WithTimeout(ctx, 500*time.Millisecond)
+			defer cancel()
+
+			start := time.Now()
+			output, err := RunTool(ctx, slog.Default(), caster.Subscribe(), gdb.WithContext(context.Background()), opts, prg, nil, "", "run_test", "step_test")
+			// A tool that times out is not an error, so that the run can continue with the output saying what happened.
+			if err != nil {
+				t.Fatalf("RunTool() error = %v", err)
+			}
+			if output != tt.wantOutput {
+				t.Errorf("output = %q, want %q", output, tt.wantOutput)
+			}
+			if elapsed := time.Since(start); elapsed > 2*time.Second {
+				t.Errorf("RunTool() took %s, want it to return once the tool times out", elapsed)
+			}
+		})
+	}
+}
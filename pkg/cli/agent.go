@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -15,6 +17,7 @@ import (
 	"github.com/gptscript-ai/clicky-chats/pkg/agents/run"
 	"github.com/gptscript-ai/clicky-chats/pkg/agents/steprunner"
 	"github.com/gptscript-ai/clicky-chats/pkg/agents/toolrunner"
+	"github.com/gptscript-ai/clicky-chats/pkg/client"
 	"github.com/gptscript-ai/clicky-chats/pkg/db"
 	kb "github.com/gptscript-ai/clicky-chats/pkg/knowledgebases"
 	"github.com/gptscript-ai/clicky-chats/pkg/server"
@@ -36,12 +39,70 @@ type Agent struct {
 	DefaultImagesURL string `usage:"The default base URL for the image agent to use" default:"https://api.openai.com/v1/images" env:"CLICKY_CHATS_IMAGES_SERVER_URL"`
 
 	DefaultEmbeddingsURL string `usage:"The defaultURL for the embedding agent to use" default:"https://api.openai.com/v1/embeddings" env:"CLICKY_CHATS_EMBEDDINGS_SERVER_URL"`
+	CacheEmbeddings      bool   `usage:"Cache embeddings so that repeated inputs are not sent to the model provider" default:"false" env:"CLICKY_CHATS_CACHE_EMBEDDINGS"`
+	EmbeddingsCacheTTL   string `usage:"How long cached embeddings are kept" default:"24h" env:"CLICKY_CHATS_EMBEDDINGS_CACHE_TTL"`
+	NormalizeEmbeddings  bool   `usage:"Scale embeddings to unit length before they are stored and returned" default:"false" env:"CLICKY_CHATS_NORMALIZE_EMBEDDINGS"`
 
-	DefaultAudioURL string `usage:"The default URL for the translation agent to use" default:"https://api.openai.com/v1/audio" env:"CLICKY_CHATS_AUDIO_SERVER_URL"`
+	DefaultAudioURL        string `usage:"The default URL for the translation agent to use" default:"https://api.openai.com/v1/audio" env:"CLICKY_CHATS_AUDIO_SERVER_URL"`
+	TranscriptionChunkSize int    `usage:"Size in bytes above which WAV audio is split on silence and transcribed in chunks, 0 disables chunking" default:"0" env:"CLICKY_CHATS_TRANSCRIPTION_CHUNK_SIZE"`
 
 	APIURL      string `usage:"URL for API calls" default:"http://localhost:8080/v1/chat/completions" env:"CLICKY_CHATS_SERVER_URL"`
 	ModelAPIKey string `usage:"API key for API calls" env:"CLICKY_CHATS_MODEL_API_KEY"`
 	AgentID     string `usage:"Agent ID to identify this agent" default:"my-agent" env:"CLICKY_CHATS_AGENT_ID"`
+	JobLease    string `usage:"How long a claimed chat completion or embeddings request can go without being renewed before another agent can claim it, 0 disables leases" default:"0s" env:"CLICKY_CHATS_JOB_LEASE"`
+
+	MaxConcurrentChatCompletions int `usage:"Maximum number of chat completion requests sent to the model provider concurrently" default:"1" env:"CLICKY_CHATS_MAX_CONCURRENT_CHAT_COMPLETIONS"`
+	MaxQueuedChatCompletions     int `usage:"Maximum number of claimed chat completion requests waiting for a worker, more requests are left queued for other agents" default:"100" env:"CLICKY_CHATS_MAX_QUEUED_CHAT_COMPLETIONS"`
+
+	ModelMaxIdleConnsPerHost int    `usage:"Maximum number of idle connections kept to each model provider host, 0 uses the Go default" default:"0" env:"CLICKY_CHATS_MODEL_MAX_IDLE_CONNS_PER_HOST"`
+	ModelIdleConnTimeout     string `usage:"How long idle connections to model providers are kept open" default:"90s" env:"CLICKY_CHATS_MODEL_IDLE_CONN_TIMEOUT"`
+	ModelDisableHTTP2        bool   `usage:"Disable HTTP/2 for connections to model providers" default:"false" env:"CLICKY_CHATS_MODEL_DISABLE_HTTP2"`
+
+	ModelHeaders      map[string]string `usage:"Headers added to all requests to model providers (header=value)" env:"CLICKY_CHATS_MODEL_HEADERS"`
+	ModelOrganization string            `usage:"OpenAI organization sent in the OpenAI-Organization header of requests to model providers" env:"CLICKY_CHATS_MODEL_ORGANIZATION"`
+	ModelProject      string            `usage:"OpenAI project sent in the OpenAI-Project header of requests to model providers" env:"CLICKY_CHATS_MODEL_PROJECT"`
+
+	ModelAuthScheme string `usage:"How the model API key is sent to model providers: bearer, header:<name>, or query:<param>" default:"bearer" env:"CLICKY_CHATS_MODEL_AUTH_SCHEME"`
+
+	ModelFailoverHosts  map[string]string `usage:"Hosts of other endpoints of model providers that requests fail over to, in order, when a host is unreachable (host=failover1|failover2)" env:"CLICKY_CHATS_MODEL_FAILOVER_HOSTS"`
+	ModelFailoverPeriod string            `usage:"How long an unreachable model provider host is skipped before requests are sent to it again" default:"30s" env:"CLICKY_CHATS_MODEL_FAILOVER_PERIOD"`
+
+	ChatCompletionRequestTimeout       string            `usage:"Timeout for chat completion requests to the model provider, 0 disables the timeout" default:"0s" env:"CLICKY_CHATS_CHAT_COMPLETION_REQUEST_TIMEOUT"`
+	ModelChatCompletionRequestTimeouts map[string]string `usage:"Per-model timeouts for chat completion requests that override the default timeout (model=timeout)" env:"CLICKY_CHATS_MODEL_CHAT_COMPLETION_REQUEST_TIMEOUTS"`
+
+	ChatCompletionFailureThreshold int    `usage:"Number of consecutive failed chat completion requests after which requests fail fast until the model provider recovers, 0 disables the circuit breaker" default:"0" env:"CLICKY_CHATS_CHAT_COMPLETION_FAILURE_THRESHOLD"`
+	ChatCompletionRecoveryPeriod   string `usage:"How long chat completion requests fail fast before a probe request is sent to the model provider" default:"30s" env:"CLICKY_CHATS_CHAT_COMPLETION_RECOVERY_PERIOD"`
+
+	ChatCompletionMaxRetries       int      `usage:"Number of times a chat completion request is retried when the model provider responds with a retryable status code, 0 disables retries" default:"2" env:"CLICKY_CHATS_CHAT_COMPLETION_MAX_RETRIES"`
+	ChatCompletionRetryBackoff     string   `usage:"How long to wait before the first retry of a chat completion request, doubled for each retry after it" default:"500ms" env:"CLICKY_CHATS_CHAT_COMPLETION_RETRY_BACKOFF"`
+	ChatCompletionRetryStatusCodes []string `usage:"Status codes of model provider responses that are retried, defaults to 429, 500, 502, and 503" env:"CLICKY_CHATS_CHAT_COMPLETION_RETRY_STATUS_CODES"`
+
+	StoreRawChatCompletionResponses     bool     `usage:"Store the body of non-streaming chat completion responses from the model provider for auditing" default:"false" env:"CLICKY_CHATS_STORE_RAW_CHAT_COMPLETION_RESPONSES"`
+	RawChatCompletionResponseRedactions []string `usage:"JSON keys whose values are redacted in stored raw chat completion responses" env:"CLICKY_CHATS_RAW_CHAT_COMPLETION_RESPONSE_REDACTIONS"`
+	RawChatCompletionResponseRetention  string   `usage:"How long raw chat completion responses are stored, 0 uses the retention period" default:"0s" env:"CLICKY_CHATS_RAW_CHAT_COMPLETION_RESPONSE_RETENTION"`
+	StoreChatCompletionPrompts          bool     `usage:"Store the messages sent to the model provider, after chat completion requests are normalized, for debugging" default:"false" env:"CLICKY_CHATS_STORE_CHAT_COMPLETION_PROMPTS"`
+
+	ChatCompletionStorageTTL       string `usage:"How long chat completions are stored once they are done, unless they are created with store set, 0 stores them indefinitely" default:"0s" env:"CLICKY_CHATS_CHAT_COMPLETION_STORAGE_TTL"`
+	ChatCompletionCleanupBatchSize int    `usage:"Number of expired chat completions that are deleted in each transaction" default:"100" env:"CLICKY_CHATS_CHAT_COMPLETION_CLEANUP_BATCH_SIZE"`
+
+	ChatCompletionDefaultParameters map[string]string `usage:"Defaults for the temperature, top_p and max_tokens parameters of chat completion requests that omit them (parameter=value)" env:"CLICKY_CHATS_CHAT_COMPLETION_DEFAULT_PARAMETERS"`
+	ChatCompletionLockedParameters  []string          `usage:"Chat completion parameters that are always set to their default, ignoring the value in requests" env:"CLICKY_CHATS_CHAT_COMPLETION_LOCKED_PARAMETERS"`
+	ChatCompletionRoleRemappings    map[string]string `usage:"Message roles that are sent to the model provider in place of the canonical ones, tool=function and system=user are supported (role=remapped)" env:"CLICKY_CHATS_CHAT_COMPLETION_ROLE_REMAPPINGS"`
+
+	DedupeChatCompletionSystemMessages bool `usage:"Collapse identical consecutive system messages in chat completion requests into one" default:"false" env:"CLICKY_CHATS_DEDUPE_CHAT_COMPLETION_SYSTEM_MESSAGES"`
+	ChatCompletionMaxHistoryMessages   int  `usage:"The number of most recent messages, besides system messages, sent to the model provider for chat completions, 0 sends them all" default:"0" env:"CLICKY_CHATS_CHAT_COMPLETION_MAX_HISTORY_MESSAGES"`
+
+	SupportedModels []string `usage:"Models from the model provider that are available to clients, defaults to a built-in list" env:"CLICKY_CHATS_SUPPORTED_MODELS"`
+
+	ModelDeprecations map[string]string `usage:"Mapping of retired model names to the model that should be used instead (deprecated=replacement)" env:"CLICKY_CHATS_MODEL_DEPRECATIONS"`
+	ModelFallbacks    map[string]string `usage:"Models that are tried in order when the model provider is out of capacity for a model (model=fallback|fallback)" env:"CLICKY_CHATS_MODEL_FALLBACKS"`
+
+	ToolTimeout  string            `usage:"Timeout for each tool call in a run, 0 only bounds tool calls by the run step timeout" default:"0s" env:"CLICKY_CHATS_TOOL_TIMEOUT"`
+	ToolTimeouts map[string]string `usage:"Per-tool timeouts for tool calls in runs that override the default timeout (tool=timeout)" env:"CLICKY_CHATS_TOOL_TIMEOUTS"`
+
+	MaxToolIterations int `usage:"Maximum number of tool call round trips a run can make before it fails, 0 disables the limit" default:"0" env:"CLICKY_CHATS_MAX_TOOL_ITERATIONS"`
+
+	RunMaxPollingInterval string `usage:"Longest wait between polls for runs, the wait doubles from the polling interval while there are no runs, 0 always polls at the polling interval" default:"0s" env:"CLICKY_CHATS_RUN_MAX_POLLING_INTERVAL"`
 
 	Cache   bool `usage:"Enable the cache for Function calling" default:"true" env:"CLICKY_CHATS_CACHE"`
 	Confirm bool `usage:"Enable the confirmation for Function calling" default:"false" env:"CLICKY_CHATS_CONFIRM"`
@@ -82,21 +143,165 @@ func runAgents(ctx context.Context, wg *sync.WaitGroup, gormDB *db.DB, kbm *kb.K
 		return fmt.Errorf("failed to parse chat completion polling interval: %w", err)
 	}
 
+	idleConnTimeout, err := time.ParseDuration(s.ModelIdleConnTimeout)
+	if err != nil {
+		return fmt.Errorf("failed to parse model idle connection timeout: %w", err)
+	}
+
+	failoverPeriod, err := time.ParseDuration(s.ModelFailoverPeriod)
+	if err != nil {
+		return fmt.Errorf("failed to parse model failover period: %w", err)
+	}
+
+	failoverHosts := make(map[string][]string, len(s.ModelFailoverHosts))
+	for host, failovers := range s.ModelFailoverHosts {
+		failoverHosts[host] = strings.Split(failovers, "|")
+	}
+
 	apiKey := s.ModelAPIKey
 	if apiKey == "" {
 		apiKey = os.Getenv("OPENAI_API_KEY")
 	}
 
+	// With the default bearer scheme, the model agents set the Authorization header themselves. Otherwise, the
+	// transport sends the API key, and the model agents are given no API key so that they don't also send it as a
+	// bearer token.
+	var (
+		authenticator client.Authenticator
+		modelAPIKey   = apiKey
+	)
+	if s.ModelAuthScheme != "" && s.ModelAuthScheme != "bearer" {
+		if authenticator, err = client.ParseAuthScheme(s.ModelAuthScheme, apiKey); err != nil {
+			return fmt.Errorf("failed to parse model authentication scheme: %w", err)
+		}
+		modelAPIKey = ""
+	}
+
+	modelClient := client.NewHTTPClient(client.TransportConfig{
+		MaxIdleConnsPerHost: s.ModelMaxIdleConnsPerHost,
+		IdleConnTimeout:     idleConnTimeout,
+		DisableHTTP2:        s.ModelDisableHTTP2,
+		Headers:             s.ModelHeaders,
+		Organization:        s.ModelOrganization,
+		Project:             s.ModelProject,
+		Authenticator:       authenticator,
+		Failover:            failoverHosts,
+		FailoverPeriod:      failoverPeriod,
+	})
+
+	requestTimeout, err := time.ParseDuration(s.ChatCompletionRequestTimeout)
+	if err != nil {
+		return fmt.Errorf("failed to parse chat completion request timeout: %w", err)
+	}
+
+	modelRequestTimeouts := make(map[string]time.Duration, len(s.ModelChatCompletionRequestTimeouts))
+	for model, timeout := range s.ModelChatCompletionRequestTimeouts {
+		if modelRequestTimeouts[model], err = time.ParseDuration(timeout); err != nil {
+			return fmt.Errorf("failed to parse chat completion request timeout for model %s: %w", model, err)
+		}
+	}
+
+	modelFallbacks := make(map[string][]string, len(s.ModelFallbacks))
+	for model, fallbacks := range s.ModelFallbacks {
+		modelFallbacks[model] = strings.Split(fallbacks, "|")
+	}
+
+	toolTimeout, err := time.ParseDuration(s.ToolTimeout)
+	if err != nil {
+		return fmt.Errorf("failed to parse tool timeout: %w", err)
+	}
+
+	toolTimeouts := make(map[string]time.Duration, len(s.ToolTimeouts))
+	for tool, timeout := range s.ToolTimeouts {
+		if toolTimeouts[tool], err = time.ParseDuration(timeout); err != nil {
+			return fmt.Errorf("failed to parse timeout for tool %s: %w", tool, err)
+		}
+	}
+
+	runMaxPollingInterval, err := time.ParseDuration(s.RunMaxPollingInterval)
+	if err != nil {
+		return fmt.Errorf("failed to parse run max polling interval: %w", err)
+	}
+
+	recoveryPeriod, err := time.ParseDuration(s.ChatCompletionRecoveryPeriod)
+	if err != nil {
+		return fmt.Errorf("failed to parse chat completion recovery period: %w", err)
+	}
+
+	retryBackoff, err := time.ParseDuration(s.ChatCompletionRetryBackoff)
+	if err != nil {
+		return fmt.Errorf("failed to parse chat completion retry backoff: %w", err)
+	}
+
+	retryStatusCodes := make([]int, 0, len(s.ChatCompletionRetryStatusCodes))
+	for _, code := range s.ChatCompletionRetryStatusCodes {
+		statusCode, err := strconv.Atoi(code)
+		if err != nil {
+			return fmt.Errorf("failed to parse chat completion retry status code %s: %w", code, err)
+		}
+		retryStatusCodes = append(retryStatusCodes, statusCode)
+	}
+
+	rawResponseRetention, err := time.ParseDuration(s.RawChatCompletionResponseRetention)
+	if err != nil {
+		return fmt.Errorf("failed to parse raw chat completion response retention: %w", err)
+	}
+
+	storageTTL, err := time.ParseDuration(s.ChatCompletionStorageTTL)
+	if err != nil {
+		return fmt.Errorf("failed to parse chat completion storage TTL: %w", err)
+	}
+
+	jobLease, err := time.ParseDuration(s.JobLease)
+	if err != nil {
+		return fmt.Errorf("failed to parse job lease: %w", err)
+	}
+
+	defaultParameters, err := chatcompletion.ParseParameters(s.ChatCompletionDefaultParameters)
+	if err != nil {
+		return fmt.Errorf("failed to parse chat completion default parameters: %w", err)
+	}
+
+	embeddingsCacheTTL, err := time.ParseDuration(s.EmbeddingsCacheTTL)
+	if err != nil {
+		return fmt.Errorf("failed to parse embeddings cache TTL: %w", err)
+	}
+
 	triggers.Complete()
 
 	ccCfg := chatcompletion.Config{
-		APIKey:            apiKey,
-		ModelsURL:         s.ModelsURL,
-		ChatCompletionURL: s.DefaultChatCompletionURL,
-		PollingInterval:   pollingInterval,
-		RetentionPeriod:   retentionPeriod,
-		AgentID:           s.AgentID,
-		Trigger:           triggers.ChatCompletion,
+		APIKey:                modelAPIKey,
+		ModelsURL:             s.ModelsURL,
+		ChatCompletionURL:     s.DefaultChatCompletionURL,
+		PollingInterval:       pollingInterval,
+		RetentionPeriod:       retentionPeriod,
+		AgentID:               s.AgentID,
+		MaxConcurrentRequests: s.MaxConcurrentChatCompletions,
+		MaxQueuedRequests:     s.MaxQueuedChatCompletions,
+		ModelDeprecations:     s.ModelDeprecations,
+		SupportedModels:       s.SupportedModels,
+		RequestTimeout:        requestTimeout,
+		ModelRequestTimeouts:  modelRequestTimeouts,
+		ModelFallbacks:        modelFallbacks,
+		FailureThreshold:      s.ChatCompletionFailureThreshold,
+		RecoveryPeriod:        recoveryPeriod,
+		MaxRetries:            s.ChatCompletionMaxRetries,
+		RetryBackoff:          retryBackoff,
+		RetryStatusCodes:      retryStatusCodes,
+		StoreRawResponses:     s.StoreRawChatCompletionResponses,
+		RawResponseRedactions: s.RawChatCompletionResponseRedactions,
+		RawResponseRetention:  rawResponseRetention,
+		StorePrompts:          s.StoreChatCompletionPrompts,
+		StorageTTL:            storageTTL,
+		CleanupBatchSize:      s.ChatCompletionCleanupBatchSize,
+		JobLease:              jobLease,
+		DefaultParameters:     defaultParameters,
+		LockedParameters:      s.ChatCompletionLockedParameters,
+		RoleRemappings:        s.ChatCompletionRoleRemappings,
+		DedupeSystemMessages:  s.DedupeChatCompletionSystemMessages,
+		MaxHistoryMessages:    s.ChatCompletionMaxHistoryMessages,
+		Client:                modelClient,
+		Trigger:               triggers.ChatCompletion,
 	}
 	if err := chatcompletion.Start(ctx, wg, gormDB, ccCfg); err != nil {
 		return err
@@ -110,6 +315,9 @@ func runAgents(ctx context.Context, wg *sync.WaitGroup, gormDB *db.DB, kbm *kb.K
 		AgentID:         s.AgentID,
 		Trigger:         triggers.Run,
 		RunStepTrigger:  triggers.RunStep,
+
+		MaxToolIterations:  s.MaxToolIterations,
+		MaxPollingInterval: runMaxPollingInterval,
 	}
 	if err = run.Start(ctx, wg, gormDB, runCfg); err != nil {
 		return err
@@ -122,6 +330,8 @@ func runAgents(ctx context.Context, wg *sync.WaitGroup, gormDB *db.DB, kbm *kb.K
 		AgentID:         s.AgentID,
 		Cache:           s.Cache,
 		Confirm:         s.Confirm,
+		ToolTimeout:     toolTimeout,
+		ToolTimeouts:    toolTimeouts,
 		Trigger:         triggers.RunStep,
 		RunTrigger:      triggers.Run,
 	}
@@ -133,7 +343,8 @@ func runAgents(ctx context.Context, wg *sync.WaitGroup, gormDB *db.DB, kbm *kb.K
 		PollingInterval: pollingInterval,
 		RetentionPeriod: retentionPeriod,
 		ImagesBaseURL:   s.DefaultImagesURL,
-		APIKey:          apiKey,
+		Client:          modelClient,
+		APIKey:          modelAPIKey,
 		AgentID:         s.AgentID,
 		Trigger:         triggers.Image,
 	}
@@ -142,11 +353,16 @@ func runAgents(ctx context.Context, wg *sync.WaitGroup, gormDB *db.DB, kbm *kb.K
 	}
 
 	embedCfg := embeddings.Config{
-		APIKey:          apiKey,
+		APIKey:          modelAPIKey,
 		EmbeddingsURL:   s.DefaultEmbeddingsURL,
+		Client:          modelClient,
 		PollingInterval: pollingInterval,
 		RetentionPeriod: retentionPeriod,
 		AgentID:         s.AgentID,
+		JobLease:        jobLease,
+		Cache:           s.CacheEmbeddings,
+		CacheRetention:  embeddingsCacheTTL,
+		Normalize:       s.NormalizeEmbeddings,
 		Trigger:         triggers.Embeddings,
 	}
 	if err = embeddings.Start(ctx, wg, gormDB, embedCfg); err != nil {
@@ -154,12 +370,14 @@ func runAgents(ctx context.Context, wg *sync.WaitGroup, gormDB *db.DB, kbm *kb.K
 	}
 
 	audioCfg := audio.Config{
-		PollingInterval: pollingInterval,
-		RetentionPeriod: retentionPeriod,
-		AudioBaseURL:    s.DefaultAudioURL,
-		APIKey:          apiKey,
-		AgentID:         s.AgentID,
-		Trigger:         triggers.Audio,
+		PollingInterval:        pollingInterval,
+		RetentionPeriod:        retentionPeriod,
+		AudioBaseURL:           s.DefaultAudioURL,
+		TranscriptionChunkSize: s.TranscriptionChunkSize,
+		Client:                 modelClient,
+		APIKey:                 modelAPIKey,
+		AgentID:                s.AgentID,
+		Trigger:                triggers.Audio,
 	}
 	if err = audio.Start(ctx, wg, gormDB, audioCfg); err != nil {
 		return err
@@ -1,10 +1,12 @@
 package cli
 
 import (
+	"fmt"
 	"log/slog"
 	"os/signal"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/gptscript-ai/clicky-chats/pkg/db"
 	kb "github.com/gptscript-ai/clicky-chats/pkg/knowledgebases"
@@ -22,10 +24,25 @@ type Server struct {
 	ServerPort    string `usage:"Server port" default:"8080" env:"CLICKY_CHATS_SERVER_PORT"`
 	ServerAPIBase string `usage:"Server API base" default:"/v1" env:"CLICKY_CHATS_SERVER_API_BASE"`
 
+	StreamKeepAliveInterval string `usage:"How long a stream can go without an event before a keepalive comment is sent, 0 disables keepalives" default:"0s" env:"CLICKY_CHATS_STREAM_KEEPALIVE_INTERVAL"`
+	StreamFlushInterval     string `usage:"How long streamed events can be buffered before they are flushed to the client, 0 flushes each event immediately" default:"0s" env:"CLICKY_CHATS_STREAM_FLUSH_INTERVAL"`
+	StreamFlushSize         int    `usage:"Number of buffered bytes of streamed events after which they are flushed to the client, when buffering is enabled" default:"4096" env:"CLICKY_CHATS_STREAM_FLUSH_SIZE"`
+
+	ChatCompletionOmitFields []string `usage:"Fields that are removed from chat completion responses, with nested fields separated by dots (choices.logprobs), unless a request includes them with the include parameter" env:"CLICKY_CHATS_CHAT_COMPLETION_OMIT_FIELDS"`
+
 	WithAgents bool `usage:"Run the server and agents" default:"false" env:"CLICKY_CHATS_WITH_AGENTS"`
 }
 
 func (s *Server) Run(cmd *cobra.Command, _ []string) error {
+	streamKeepAliveInterval, err := time.ParseDuration(s.StreamKeepAliveInterval)
+	if err != nil {
+		return fmt.Errorf("failed to parse stream keepalive interval: %w", err)
+	}
+	streamFlushInterval, err := time.ParseDuration(s.StreamFlushInterval)
+	if err != nil {
+		return fmt.Errorf("failed to parse stream flush interval: %w", err)
+	}
+
 	wg := new(sync.WaitGroup)
 	gormDB, err := db.New(s.DSN, s.AutoMigrate == "true")
 	if err != nil {
@@ -57,10 +74,14 @@ func (s *Server) Run(cmd *cobra.Command, _ []string) error {
 	ctx, cancel := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT, syscall.SIGKILL)
 	defer cancel()
 	if err = server.NewServer(gormDB, kbManager).Start(ctx, wg, server.Config{
-		ServerURL: s.ServerURL,
-		Port:      s.ServerPort,
-		APIBase:   s.ServerAPIBase,
-		Triggers:  triggers,
+		ServerURL:                s.ServerURL,
+		Port:                     s.ServerPort,
+		APIBase:                  s.ServerAPIBase,
+		Triggers:                 triggers,
+		StreamKeepAliveInterval:  streamKeepAliveInterval,
+		StreamFlushInterval:      streamFlushInterval,
+		StreamFlushSize:          s.StreamFlushSize,
+		ChatCompletionOmitFields: s.ChatCompletionOmitFields,
 	}); err != nil {
 		return err
 	}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Authenticator authenticates the requests sent to a model provider. It is given a copy of each request, and can set
+// headers, add query parameters, or sign the request.
+type Authenticator interface {
+	Authenticate(req *http.Request) error
+}
+
+// AuthenticatorFunc is a function that implements Authenticator.
+type AuthenticatorFunc func(req *http.Request) error
+
+func (f AuthenticatorFunc) Authenticate(req *http.Request) error {
+	return f(req)
+}
+
+// BearerAuthenticator sends the API key as a bearer token in the Authorization header, the way OpenAI expects it.
+func BearerAuthenticator(apiKey string) Authenticator {
+	return HeaderAuthenticator("Authorization", "Bearer "+apiKey)
+}
+
+// HeaderAuthenticator sends the API key in the given header, for example the "api-key" header that Azure OpenAI expects.
+func HeaderAuthenticator(header, apiKey string) Authenticator {
+	return AuthenticatorFunc(func(req *http.Request) error {
+		req.Header.Set(header, apiKey)
+		return nil
+	})
+}
+
+// QueryAuthenticator sends the API key in the given query parameter.
+func QueryAuthenticator(param, apiKey string) Authenticator {
+	return AuthenticatorFunc(func(req *http.Request) error {
+		query := req.URL.Query()
+		query.Set(param, apiKey)
+		req.URL.RawQuery = query.Encode()
+		return nil
+	})
+}
+
+// ParseAuthScheme returns the authenticator for the scheme, which is one of "bearer", "header:<name>", or
+// "query:<param>".
+func ParseAuthScheme(scheme, apiKey string) (Authenticator, error) {
+	kind, name, _ := strings.Cut(scheme, ":")
+	switch {
+	case kind == "bearer" && name == "":
+		return BearerAuthenticator(apiKey), nil
+	case kind == "header" && name != "":
+		return HeaderAuthenticator(name, apiKey), nil
+	case kind == "query" && name != "":
+		return QueryAuthenticator(name, apiKey), nil
+	default:
+		return nil, fmt.Errorf("unsupported authentication scheme %q, must be bearer, header:<name>, or query:<param>", scheme)
+	}
+}
@@ -0,0 +1,144 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// defaultFailoverPeriod is how long an unreachable endpoint is skipped if no failover period is configured.
+const defaultFailoverPeriod = 30 * time.Second
+
+// endpoint is a host that requests for a model provider can be sent to, and whether it has been reachable.
+type endpoint struct {
+	host string
+
+	lock      sync.Mutex
+	downUntil time.Time
+}
+
+// failoverTransport sends the requests for a model provider's host to the first of its endpoints that is healthy. An
+// endpoint is marked down when a request can't reach it, and the request is sent to the next endpoint. Down endpoints
+// are skipped until the failover period has passed. If every endpoint is down, then they are all tried in order, so
+// that a request is never rejected without being sent.
+type failoverTransport struct {
+	endpoints map[string][]*endpoint
+	period    time.Duration
+	now       func() time.Time
+	next      http.RoundTripper
+}
+
+func newFailoverTransport(hosts map[string][]string, period time.Duration, next http.RoundTripper) *failoverTransport {
+	if period <= 0 {
+		period = defaultFailoverPeriod
+	}
+
+	endpoints := make(map[string][]*endpoint, len(hosts))
+	for primary, failovers := range hosts {
+		endpoints[primary] = append(endpoints[primary], &endpoint{host: primary})
+		for _, host := range failovers {
+			endpoints[primary] = append(endpoints[primary], &endpoint{host: host})
+		}
+	}
+
+	return &failoverTransport{
+		endpoints: endpoints,
+		period:    period,
+		now:       time.Now,
+		next:      next,
+	}
+}
+
+func (t *failoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	endpoints := t.endpoints[req.URL.Host]
+	if len(endpoints) == 0 {
+		return t.next.RoundTrip(req)
+	}
+
+	var errs []error
+	for i, e := range t.order(endpoints) {
+		attempt, err := t.requestFor(req, e.host, i)
+		if err != nil {
+			errs = append(errs, err)
+			break
+		}
+
+		resp, err := t.next.RoundTrip(attempt)
+		if err == nil {
+			e.markUp()
+			return resp, nil
+		}
+		if req.Context().Err() != nil {
+			// The request was cancelled, so the endpoint may well be reachable.
+			return nil, err
+		}
+
+		e.markDown(t.now().Add(t.period))
+		errs = append(errs, err)
+	}
+
+	return nil, errors.Join(errs...)
+}
+
+// order returns the healthy endpoints, in the order they are configured, followed by those that are down.
+func (t *failoverTransport) order(endpoints []*endpoint) []*endpoint {
+	var (
+		now     = t.now()
+		healthy = make([]*endpoint, 0, len(endpoints))
+		down    []*endpoint
+	)
+	for _, e := range endpoints {
+		if e.isDown(now) {
+			down = append(down, e)
+		} else {
+			healthy = append(healthy, e)
+		}
+	}
+
+	return append(healthy, down...)
+}
+
+// requestFor returns a copy of the request that is sent to the host. The body of the request is read again for every
+// attempt after the first, so a request whose body can't be read again is only sent once.
+func (t *failoverTransport) requestFor(req *http.Request, host string, attempt int) (*http.Request, error) {
+	// A RoundTripper must not modify the request that it is given.
+	r := req.Clone(req.Context())
+	r.URL.Host = host
+	r.Host = ""
+
+	if attempt > 0 && req.Body != nil && req.Body != http.NoBody {
+		if req.GetBody == nil {
+			return nil, errors.New("request body can't be sent to another endpoint")
+		}
+
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		r.Body = body
+	}
+
+	return r, nil
+}
+
+func (e *endpoint) isDown(now time.Time) bool {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	return now.Before(e.downUntil)
+}
+
+func (e *endpoint) markDown(until time.Time) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	e.downUntil = until
+}
+
+func (e *endpoint) markUp() {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	e.downUntil = time.Time{}
+}
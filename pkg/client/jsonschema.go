@@ -0,0 +1,219 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// CompileJSONSchema returns a schema that JSON values can be validated against for a JSON Schema, like the schema of a
+// json_schema response format. The schema is validated with kin-openapi, which only understands OpenAPI 3.0 schemas, so
+// the JSON Schema constructs that structured outputs use are rewritten into their OpenAPI equivalents first:
+//   - A list of types, like ["string", "null"], becomes the non-null type, or an anyOf of the non-null types, and null
+//     values are allowed if the list includes "null".
+//   - A schema with the type "null" only allows null.
+//   - Local references, like "#/$defs/step", are resolved, including recursive references.
+func CompileJSONSchema(schema map[string]any) (*openapi3.Schema, error) {
+	c := &schemaCompiler{
+		root:   schema,
+		refs:   make(map[string]*openapi3.Schema),
+		linked: make(map[*openapi3.Schema]struct{}),
+	}
+
+	compiled := new(openapi3.Schema)
+	if err := c.decode(schema, compiled); err != nil {
+		return nil, err
+	}
+
+	return compiled, nil
+}
+
+// schemaCompiler resolves the references of a schema. Each reference is decoded once, so that recursive references point
+// back at the schema that is already being decoded.
+type schemaCompiler struct {
+	root   map[string]any
+	refs   map[string]*openapi3.Schema
+	linked map[*openapi3.Schema]struct{}
+}
+
+// decode decodes the raw schema into the schema and resolves its references. A schema that is only a reference, like a
+// root schema that refers to one of its definitions, becomes the schema that it refers to.
+func (c *schemaCompiler) decode(raw any, schema *openapi3.Schema) error {
+	if m, ok := raw.(map[string]any); ok {
+		if ref, ok := m["$ref"].(string); ok {
+			resolved, err := c.resolve(ref)
+			if err != nil {
+				return err
+			}
+			*schema = *resolved
+			return nil
+		}
+	}
+
+	b, err := json.Marshal(normalizeSchema(raw))
+	if err != nil {
+		return fmt.Errorf("failed to marshal schema: %w", err)
+	}
+	if err = json.Unmarshal(b, schema); err != nil {
+		return fmt.Errorf("failed to parse schema: %w", err)
+	}
+
+	return c.link(schema)
+}
+
+// link resolves the references in the subschemas of the schema.
+func (c *schemaCompiler) link(schema *openapi3.Schema) error {
+	if _, ok := c.linked[schema]; ok {
+		return nil
+	}
+	c.linked[schema] = struct{}{}
+
+	refs := []*openapi3.SchemaRef{schema.Items, schema.Not, schema.AdditionalProperties.Schema}
+	refs = append(refs, schema.AnyOf...)
+	refs = append(refs, schema.OneOf...)
+	refs = append(refs, schema.AllOf...)
+	for _, ref := range schema.Properties {
+		refs = append(refs, ref)
+	}
+
+	for _, ref := range refs {
+		if ref == nil {
+			continue
+		}
+		if ref.Ref == "" {
+			if ref.Value != nil {
+				if err := c.link(ref.Value); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		resolved, err := c.resolve(ref.Ref)
+		if err != nil {
+			return err
+		}
+		ref.Value = resolved
+	}
+
+	return nil
+}
+
+// resolve returns the schema that a local reference, a JSON pointer into the root schema, points to.
+func (c *schemaCompiler) resolve(ref string) (*openapi3.Schema, error) {
+	if schema, ok := c.refs[ref]; ok {
+		return schema, nil
+	}
+
+	pointer, ok := strings.CutPrefix(ref, "#")
+	if !ok {
+		return nil, fmt.Errorf("unsupported $ref %q, only references within the schema are supported", ref)
+	}
+
+	var target any = c.root
+	if pointer != "" {
+		for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+			token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+			switch value := target.(type) {
+			case map[string]any:
+				target, ok = value[token]
+			case []any:
+				i, err := strconv.Atoi(token)
+				ok = err == nil && i >= 0 && i < len(value)
+				if ok {
+					target = value[i]
+				}
+			default:
+				ok = false
+			}
+			if !ok {
+				return nil, fmt.Errorf("$ref %q does not point to a schema", ref)
+			}
+		}
+	}
+
+	schema := new(openapi3.Schema)
+	c.refs[ref] = schema
+	return schema, c.decode(target, schema)
+}
+
+// normalizeSchema returns a copy of the raw schema, and of its subschemas, with their type lists and null types rewritten
+// into their OpenAPI 3.0 equivalents.
+func normalizeSchema(raw any) any {
+	schema, ok := raw.(map[string]any)
+	if !ok {
+		return raw
+	}
+
+	normalized := make(map[string]any, len(schema))
+	for key, value := range schema {
+		switch key {
+		case "items", "not", "additionalProperties":
+			normalized[key] = normalizeSchema(value)
+		case "anyOf", "oneOf", "allOf":
+			if subschemas, ok := value.([]any); ok {
+				normalizedSubschemas := make([]any, 0, len(subschemas))
+				for _, subschema := range subschemas {
+					normalizedSubschemas = append(normalizedSubschemas, normalizeSchema(subschema))
+				}
+				value = normalizedSubschemas
+			}
+			normalized[key] = value
+		case "properties", "$defs", "definitions":
+			if subschemas, ok := value.(map[string]any); ok {
+				normalizedSubschemas := make(map[string]any, len(subschemas))
+				for name, subschema := range subschemas {
+					normalizedSubschemas[name] = normalizeSchema(subschema)
+				}
+				value = normalizedSubschemas
+			}
+			normalized[key] = value
+		default:
+			normalized[key] = value
+		}
+	}
+
+	var types []string
+	switch t := schema["type"].(type) {
+	case string:
+		types = []string{t}
+	case []any:
+		for _, item := range t {
+			if name, ok := item.(string); ok {
+				types = append(types, name)
+			}
+		}
+	default:
+		return normalized
+	}
+
+	nonNull := make([]any, 0, len(types))
+	for _, t := range types {
+		if t == "null" {
+			normalized["nullable"] = true
+		} else {
+			nonNull = append(nonNull, t)
+		}
+	}
+
+	delete(normalized, "type")
+	switch len(nonNull) {
+	case 0:
+		// Only null is allowed.
+		normalized["enum"] = []any{nil}
+	case 1:
+		normalized["type"] = nonNull[0]
+	default:
+		choices := make([]any, 0, len(nonNull))
+		for _, t := range nonNull {
+			choices = append(choices, map[string]any{"type": t})
+		}
+		allOf, _ := normalized["allOf"].([]any)
+		normalized["allOf"] = append(allOf, map[string]any{"anyOf": choices})
+	}
+
+	return normalized
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// The rate limit headers that OpenAI, and compatible model providers, send with their responses.
+const (
+	limitRequestsHeader     = "X-Ratelimit-Limit-Requests"
+	limitTokensHeader       = "X-Ratelimit-Limit-Tokens"
+	remainingRequestsHeader = "X-Ratelimit-Remaining-Requests"
+	remainingTokensHeader   = "X-Ratelimit-Remaining-Tokens"
+	resetRequestsHeader     = "X-Ratelimit-Reset-Requests"
+	resetTokensHeader       = "X-Ratelimit-Reset-Tokens"
+)
+
+// RateLimits are the rate limits of the model provider, as of the last response that reported them. A value is zero if
+// the provider didn't report it.
+type RateLimits struct {
+	LimitRequests, LimitTokens         int
+	RemainingRequests, RemainingTokens int
+	// ResetRequests and ResetTokens are how long after ObservedAt the remaining requests and tokens are reset.
+	ResetRequests, ResetTokens time.Duration
+	ObservedAt                 time.Time
+}
+
+// RateLimitTracker records the rate limits reported in the responses from the model provider, so that they can be
+// checked before the limits are hit. It is safe for concurrent use.
+type RateLimitTracker struct {
+	lock     sync.Mutex
+	limits   RateLimits
+	observed bool
+	now      func() time.Time
+}
+
+func NewRateLimitTracker() *RateLimitTracker {
+	return &RateLimitTracker{now: time.Now}
+}
+
+// Limits returns the last rate limits reported by the model provider. It returns false if none have been reported.
+func (t *RateLimitTracker) Limits() (RateLimits, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return t.limits, t.observed
+}
+
+// Observe records the rate limits in the headers of a response. Responses without rate limit headers are ignored, so
+// that the last reported limits are kept.
+func (t *RateLimitTracker) Observe(header http.Header) {
+	if !hasRateLimitHeaders(header) {
+		return
+	}
+
+	limits := RateLimits{
+		LimitRequests:     parseRateLimit(header.Get(limitRequestsHeader)),
+		LimitTokens:       parseRateLimit(header.Get(limitTokensHeader)),
+		RemainingRequests: parseRateLimit(header.Get(remainingRequestsHeader)),
+		RemainingTokens:   parseRateLimit(header.Get(remainingTokensHeader)),
+		ResetRequests:     parseRateLimitReset(header.Get(resetRequestsHeader)),
+		ResetTokens:       parseRateLimitReset(header.Get(resetTokensHeader)),
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	limits.ObservedAt = t.now()
+	t.limits, t.observed = limits, true
+}
+
+func hasRateLimitHeaders(header http.Header) bool {
+	for _, key := range []string{limitRequestsHeader, limitTokensHeader, remainingRequestsHeader, remainingTokensHeader, resetRequestsHeader, resetTokensHeader} {
+		if header.Get(key) != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parseRateLimit(value string) int {
+	limit, _ := strconv.Atoi(value)
+	return limit
+}
+
+// parseRateLimitReset parses a reset time, which is a duration like "1s" or "6m0s".
+func parseRateLimitReset(value string) time.Duration {
+	reset, _ := time.ParseDuration(value)
+	return reset
+}
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/acorn-io/z"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gptscript-ai/clicky-chats/pkg/generated/openai"
+)
+
+// StructuredOutput unmarshals the content of the first choice of a chat completion into a value of type T. If the
+// request has a json_schema response format with a schema, then the content is validated against the schema before it
+// is unmarshalled, so that a response that doesn't match the schema is never partially decoded into T.
+func StructuredOutput[T any](req *openai.CreateChatCompletionRequest, resp *openai.CreateChatCompletionResponse) (T, error) {
+	var out T
+	if resp == nil || len(resp.Choices) == 0 {
+		return out, errors.New("chat completion has no choices")
+	}
+
+	choice := resp.Choices[0]
+	if choice.FinishReason == openai.CreateChatCompletionResponseChoicesFinishReasonLength {
+		return out, errors.New("chat completion content was cut off before it was complete")
+	}
+
+	content := z.Dereference(choice.Message.Content)
+	if content == "" {
+		return out, errors.New("chat completion has no content")
+	}
+
+	var value any
+	if err := json.Unmarshal([]byte(content), &value); err != nil {
+		return out, fmt.Errorf("chat completion content is not valid JSON: %w", err)
+	}
+
+	if schema, err := responseFormatSchema(req); err != nil {
+		return out, err
+	} else if schema != nil {
+		if err = schema.VisitJSON(value); err != nil {
+			return out, fmt.Errorf("chat completion content does not match the response format schema: %w", err)
+		}
+	}
+
+	if err := json.Unmarshal([]byte(content), &out); err != nil {
+		return out, fmt.Errorf("failed to unmarshal chat completion content into %T: %w", out, err)
+	}
+
+	return out, nil
+}
+
+// responseFormatSchema returns the schema of the json_schema response format of the request, or nil if the request
+// doesn't have one.
+func responseFormatSchema(req *openai.CreateChatCompletionRequest) (*openapi3.Schema, error) {
+	if req == nil || req.ResponseFormat == nil || req.ResponseFormat.JsonSchema == nil || req.ResponseFormat.JsonSchema.Schema == nil {
+		return nil, nil
+	}
+
+	schema, err := CompileJSONSchema(*req.ResponseFormat.JsonSchema.Schema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse response format schema %s: %w", req.ResponseFormat.JsonSchema.Name, err)
+	}
+
+	return schema, nil
+}
@@ -0,0 +1,216 @@
+package client
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/gptscript-ai/clicky-chats/pkg/generated/openai"
+)
+
+func TestStructuredOutput(t *testing.T) {
+	type weather struct {
+		City        string  `json:"city"`
+		Temperature float64 `json:"temperature"`
+	}
+
+	req := new(openai.CreateChatCompletionRequest)
+	if err := json.Unmarshal([]byte(`{
+		"model": "gpt-4o",
+		"messages": [{"role": "user", "content": "What is the weather in Paris?"}],
+		"response_format": {
+			"type": "json_schema",
+			"json_schema": {
+				"name": "weather",
+				"strict": true,
+				"schema": {
+					"type": "object",
+					"properties": {"city": {"type": "string"}, "temperature": {"type": "number"}},
+					"required": ["city", "temperature"],
+					"additionalProperties": false
+				}
+			}
+		}
+	}`), req); err != nil {
+		t.Fatalf("failed to unmarshal request: %v", err)
+	}
+
+	response := func(finishReason, content string) *openai.CreateChatCompletionResponse {
+		resp := new(openai.CreateChatCompletionResponse)
+		body, _ := json.Marshal(map[string]any{
+			"id":      "chatcmpl-test",
+			"object":  "chat.completion",
+			"model":   "gpt-4o",
+			"created": 1,
+			"choices": []any{map[string]any{
+				"index":         0,
+				"finish_reason": finishReason,
+				"message":       map[string]any{"role": "assistant", "content": content},
+			}},
+		})
+		if err := json.Unmarshal(body, resp); err != nil {
+			t.Fatalf("failed to unmarshal response: %v", err)
+		}
+		return resp
+	}
+
+	type testCase struct {
+		name    string
+		req     *openai.CreateChatCompletionRequest
+		resp    *openai.CreateChatCompletionResponse
+		want    weather
+		wantErr bool
+	}
+	tests := []testCase{
+		{
+			name: "Matches schema",
+			req:  req,
+			resp: response("stop", `{"city": "Paris", "temperature": 21.5}`),
+			want: weather{City: "Paris", Temperature: 21.5},
+		},
+		{
+			name: "No response format",
+			req:  &openai.CreateChatCompletionRequest{},
+			resp: response("stop", `{"city": "Paris", "wind": "calm"}`),
+			want: weather{City: "Paris"},
+		},
+		{
+			name:    "Missing required property",
+			req:     req,
+			resp:    response("stop", `{"city": "Paris"}`),
+			wantErr: true,
+		},
+		{
+			name:    "Wrong type",
+			req:     req,
+			resp:    response("stop", `{"city": "Paris", "temperature": "warm"}`),
+			wantErr: true,
+		},
+		{
+			name:    "Additional property",
+			req:     req,
+			resp:    response("stop", `{"city": "Paris", "temperature": 21.5, "wind": "calm"}`),
+			wantErr: true,
+		},
+		{
+			name:    "Not JSON",
+			req:     req,
+			resp:    response("stop", `It is sunny in Paris.`),
+			wantErr: true,
+		},
+		{
+			name:    "Cut off",
+			req:     req,
+			resp:    response("length", `{"city": "Par`),
+			wantErr: true,
+		},
+		{
+			name:    "No choices",
+			req:     req,
+			resp:    &openai.CreateChatCompletionResponse{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StructuredOutput[weather](tt.req, tt.resp)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("StructuredOutput() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("StructuredOutput() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStructuredOutputSchemaConstructs(t *testing.T) {
+	type testCase struct {
+		name    string
+		schema  string
+		content string
+		wantErr bool
+	}
+	tests := []testCase{
+		{
+			name:    "Nullable type list with null",
+			schema:  `{"type": "object", "properties": {"unit": {"type": ["string", "null"]}}, "required": ["unit"], "additionalProperties": false}`,
+			content: `{"unit": null}`,
+		},
+		{
+			name:    "Nullable type list with string",
+			schema:  `{"type": "object", "properties": {"unit": {"type": ["string", "null"]}}, "required": ["unit"], "additionalProperties": false}`,
+			content: `{"unit": "celsius"}`,
+		},
+		{
+			name:    "Nullable type list with wrong type",
+			schema:  `{"type": "object", "properties": {"unit": {"type": ["string", "null"]}}, "required": ["unit"], "additionalProperties": false}`,
+			content: `{"unit": 1}`,
+			wantErr: true,
+		},
+		{
+			name:    "anyOf with null",
+			schema:  `{"type": "object", "properties": {"unit": {"anyOf": [{"type": "string"}, {"type": "null"}]}}, "required": ["unit"], "additionalProperties": false}`,
+			content: `{"unit": null}`,
+		},
+		{
+			name:    "anyOf with wrong type",
+			schema:  `{"type": "object", "properties": {"unit": {"anyOf": [{"type": "string"}, {"type": "null"}]}}, "required": ["unit"], "additionalProperties": false}`,
+			content: `{"unit": true}`,
+			wantErr: true,
+		},
+		{
+			name:    "Reference to $defs",
+			schema:  `{"type": "object", "properties": {"unit": {"$ref": "#/$defs/unit"}}, "required": ["unit"], "additionalProperties": false, "$defs": {"unit": {"type": "string", "enum": ["celsius", "fahrenheit"]}}}`,
+			content: `{"unit": "celsius"}`,
+		},
+		{
+			name:    "Reference to $defs with invalid value",
+			schema:  `{"type": "object", "properties": {"unit": {"$ref": "#/$defs/unit"}}, "required": ["unit"], "additionalProperties": false, "$defs": {"unit": {"type": "string", "enum": ["celsius", "fahrenheit"]}}}`,
+			content: `{"unit": "kelvin"}`,
+			wantErr: true,
+		},
+		{
+			name:    "Recursive reference",
+			schema:  `{"$ref": "#/$defs/node", "$defs": {"node": {"type": "object", "properties": {"value": {"type": "string"}, "next": {"anyOf": [{"$ref": "#/$defs/node"}, {"type": "null"}]}}, "required": ["value", "next"], "additionalProperties": false}}}`,
+			content: `{"value": "a", "next": {"value": "b", "next": null}}`,
+		},
+		{
+			name:    "Recursive reference with invalid nested value",
+			schema:  `{"$ref": "#/$defs/node", "$defs": {"node": {"type": "object", "properties": {"value": {"type": "string"}, "next": {"anyOf": [{"$ref": "#/$defs/node"}, {"type": "null"}]}}, "required": ["value", "next"], "additionalProperties": false}}}`,
+			content: `{"value": "a", "next": {"value": 2, "next": null}}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := new(openai.CreateChatCompletionRequest)
+			if err := json.Unmarshal([]byte(`{
+				"model": "gpt-4o",
+				"messages": [{"role": "user", "content": "What is the weather in Paris?"}],
+				"response_format": {"type": "json_schema", "json_schema": {"name": "weather", "strict": true, "schema": `+tt.schema+`}}
+			}`), req); err != nil {
+				t.Fatalf("failed to unmarshal request: %v", err)
+			}
+
+			resp := new(openai.CreateChatCompletionResponse)
+			body, _ := json.Marshal(map[string]any{
+				"id":      "chatcmpl-test",
+				"object":  "chat.completion",
+				"model":   "gpt-4o",
+				"created": 1,
+				"choices": []any{map[string]any{
+					"index":         0,
+					"finish_reason": "stop",
+					"message":       map[string]any{"role": "assistant", "content": tt.content},
+				}},
+			})
+			if err := json.Unmarshal(body, resp); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+
+			if _, err := StructuredOutput[map[string]any](req, resp); (err != nil) != tt.wantErr {
+				t.Errorf("StructuredOutput() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// TransportConfig tunes the connections made to a model provider.
+type TransportConfig struct {
+	// MaxIdleConnsPerHost is the maximum number of idle connections kept per host. If zero, the default of the
+	// http package is used.
+	MaxIdleConnsPerHost int
+	// IdleConnTimeout is how long an idle connection is kept before it is closed. If zero, the default of the
+	// http package is used.
+	IdleConnTimeout time.Duration
+	// DisableHTTP2 forces HTTP/1.1 to be used for all connections.
+	DisableHTTP2 bool
+	// Headers are added to all requests, unless the request already sets them. For example, some proxies require
+	// "OpenAI-Beta: assistants=v2".
+	Headers map[string]string
+	// Organization and Project set the OpenAI-Organization and OpenAI-Project headers on all requests.
+	Organization, Project string
+	// RateLimits, if set, records the rate limits reported in the responses.
+	RateLimits *RateLimitTracker
+	// Authenticator, if set, authenticates all requests after the headers are added. Otherwise, requests are sent
+	// with the credentials that they already have.
+	Authenticator Authenticator
+	// Failover maps the hosts of model providers to the hosts of other endpoints of the same provider, for example in
+	// other regions. When a host is unreachable, requests fail over to the next of these hosts, in order, and the
+	// unreachable host is skipped for the FailoverPeriod. If the period is zero, then 30 seconds is used.
+	Failover       map[string][]string
+	FailoverPeriod time.Duration
+}
+
+const (
+	OrganizationHeader = "OpenAI-Organization"
+	ProjectHeader      = "OpenAI-Project"
+)
+
+type headersKey struct{}
+
+// WithHeaders returns a context that adds the headers to the requests made with it by a client returned from
+// NewHTTPClient. These headers take precedence over those that are configured on the client, so that, for example, the
+// OpenAI-Organization and OpenAI-Project headers can be set per request.
+func WithHeaders(ctx context.Context, headers http.Header) context.Context {
+	if existing, ok := ctx.Value(headersKey{}).(http.Header); ok {
+		merged := existing.Clone()
+		for key, values := range headers {
+			merged[http.CanonicalHeaderKey(key)] = values
+		}
+		headers = merged
+	}
+	return context.WithValue(ctx, headersKey{}, headers)
+}
+
+// headerTransport adds headers to the requests that it sends, authenticates them, and records the rate limits in the
+// responses.
+type headerTransport struct {
+	headers       http.Header
+	rateLimits    *RateLimitTracker
+	authenticator Authenticator
+	next          http.RoundTripper
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	contextHeaders, _ := req.Context().Value(headersKey{}).(http.Header)
+	if len(t.headers) == 0 && len(contextHeaders) == 0 && t.authenticator == nil {
+		return t.observe(t.next.RoundTrip(req))
+	}
+
+	// A RoundTripper must not modify the request that it is given.
+	req = req.Clone(req.Context())
+	for key, values := range t.headers {
+		if req.Header.Get(key) == "" {
+			req.Header[key] = values
+		}
+	}
+	for key, values := range contextHeaders {
+		req.Header[http.CanonicalHeaderKey(key)] = values
+	}
+
+	if t.authenticator != nil {
+		if err := t.authenticator.Authenticate(req); err != nil {
+			return nil, fmt.Errorf("failed to authenticate request: %w", err)
+		}
+	}
+
+	return t.observe(t.next.RoundTrip(req))
+}
+
+func (t *headerTransport) observe(resp *http.Response, err error) (*http.Response, error) {
+	if t.rateLimits != nil && resp != nil {
+		t.rateLimits.Observe(resp.Header)
+	}
+
+	return resp, err
+}
+
+// NewHTTPClient returns a client with a transport configured according to the given config.
+func NewHTTPClient(cfg TransportConfig) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if cfg.MaxIdleConnsPerHost > 0 {
+		transport.MaxIdleConnsPerHost = cfg.MaxIdleConnsPerHost
+		if transport.MaxIdleConns < cfg.MaxIdleConnsPerHost {
+			transport.MaxIdleConns = cfg.MaxIdleConnsPerHost
+		}
+	}
+	if cfg.IdleConnTimeout > 0 {
+		transport.IdleConnTimeout = cfg.IdleConnTimeout
+	}
+	if cfg.DisableHTTP2 {
+		transport.ForceAttemptHTTP2 = false
+		// A non-nil, empty map disables HTTP/2 for TLS connections.
+		transport.TLSNextProto = make(map[string]func(string, *tls.Conn) http.RoundTripper)
+	}
+
+	headers := make(http.Header, len(cfg.Headers)+2)
+	for key, value := range cfg.Headers {
+		headers.Set(key, value)
+	}
+	if cfg.Organization != "" {
+		headers.Set(OrganizationHeader, cfg.Organization)
+	}
+	if cfg.Project != "" {
+		headers.Set(ProjectHeader, cfg.Project)
+	}
+
+	var next http.RoundTripper = transport
+	if len(cfg.Failover) > 0 {
+		next = newFailoverTransport(cfg.Failover, cfg.FailoverPeriod, transport)
+	}
+
+	return &http.Client{Transport: &headerTransport{headers: headers, rateLimits: cfg.RateLimits, authenticator: cfg.Authenticator, next: next}}
+}
@@ -0,0 +1,366 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNewHTTPClient(t *testing.T) {
+	type testCase struct {
+		name                    string
+		cfg                     TransportConfig
+		wantMaxIdleConnsPerHost int
+		wantIdleConnTimeout     time.Duration
+		wantHTTP2               bool
+	}
+	defaultTransport := http.DefaultTransport.(*http.Transport)
+	tests := []testCase{
+		{
+			name:                    "Defaults",
+			wantMaxIdleConnsPerHost: defaultTransport.MaxIdleConnsPerHost,
+			wantIdleConnTimeout:     defaultTransport.IdleConnTimeout,
+			wantHTTP2:               true,
+		},
+		{
+			name: "Tuned",
+			cfg: TransportConfig{
+				MaxIdleConnsPerHost: 64,
+				IdleConnTimeout:     5 * time.Minute,
+				DisableHTTP2:        true,
+			},
+			wantMaxIdleConnsPerHost: 64,
+			wantIdleConnTimeout:     5 * time.Minute,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transport, ok := NewHTTPClient(tt.cfg).Transport.(*headerTransport).next.(*http.Transport)
+			if !ok {
+				t.Fatalf("client transport is not an *http.Transport")
+			}
+
+			if transport.MaxIdleConnsPerHost != tt.wantMaxIdleConnsPerHost {
+				t.Errorf("MaxIdleConnsPerHost = %d, want %d", transport.MaxIdleConnsPerHost, tt.wantMaxIdleConnsPerHost)
+			}
+			if transport.MaxIdleConns != 0 && transport.MaxIdleConns < transport.MaxIdleConnsPerHost {
+				t.Errorf("MaxIdleConns = %d, want at least %d", transport.MaxIdleConns, transport.MaxIdleConnsPerHost)
+			}
+			if transport.IdleConnTimeout != tt.wantIdleConnTimeout {
+				t.Errorf("IdleConnTimeout = %s, want %s", transport.IdleConnTimeout, tt.wantIdleConnTimeout)
+			}
+			if http2 := transport.ForceAttemptHTTP2 && transport.TLSNextProto == nil; http2 != tt.wantHTTP2 {
+				t.Errorf("HTTP/2 enabled = %t, want %t", http2, tt.wantHTTP2)
+			}
+		})
+	}
+}
+
+func TestHeaders(t *testing.T) {
+	var got http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		got = r.Header
+	}))
+	defer srv.Close()
+
+	client := NewHTTPClient(TransportConfig{
+		Headers: map[string]string{
+			"OpenAI-Beta":   "assistants=v2",
+			"Authorization": "Bearer configured",
+		},
+		Organization: "org-configured",
+		Project:      "proj-configured",
+	})
+
+	ctx := WithHeaders(context.Background(), http.Header{ProjectHeader: []string{"proj-request"}})
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer request")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("failed to send request: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	for header, want := range map[string]string{
+		"OpenAI-Beta":      "assistants=v2",
+		"Authorization":    "Bearer request",
+		OrganizationHeader: "org-configured",
+		ProjectHeader:      "proj-request",
+	} {
+		if value := got.Get(header); value != want {
+			t.Errorf("header %s = %q, want %q", header, value, want)
+		}
+	}
+
+	if req.Header.Get("OpenAI-Beta") != "" {
+		t.Errorf("the configured headers were added to the original request")
+	}
+}
+
+func TestAuthenticator(t *testing.T) {
+	signature := AuthenticatorFunc(func(req *http.Request) error {
+		req.Header.Set("X-Signature", req.Method+" "+req.URL.Path)
+		return nil
+	})
+
+	type testCase struct {
+		name          string
+		authenticator Authenticator
+		wantHeaders   map[string]string
+		wantQuery     map[string]string
+	}
+	tests := []testCase{
+		{
+			name:          "Bearer",
+			authenticator: BearerAuthenticator("sk-test"),
+			wantHeaders:   map[string]string{"Authorization": "Bearer sk-test"},
+		},
+		{
+			name:          "Header",
+			authenticator: HeaderAuthenticator("api-key", "sk-test"),
+			wantHeaders:   map[string]string{"Api-Key": "sk-test", "Authorization": ""},
+		},
+		{
+			name:          "Query",
+			authenticator: QueryAuthenticator("key", "sk-test"),
+			wantHeaders:   map[string]string{"Authorization": ""},
+			wantQuery:     map[string]string{"key": "sk-test", "api-version": "2024-02-01"},
+		},
+		{
+			name:          "Custom",
+			authenticator: signature,
+			wantHeaders:   map[string]string{"X-Signature": "GET /v1/models"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *http.Request
+			srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				got = r
+			}))
+			defer srv.Close()
+
+			req, err := http.NewRequest(http.MethodGet, srv.URL+"/v1/models?api-version=2024-02-01", nil)
+			if err != nil {
+				t.Fatalf("failed to create request: %v", err)
+			}
+
+			resp, err := NewHTTPClient(TransportConfig{Authenticator: tt.authenticator}).Do(req)
+			if err != nil {
+				t.Fatalf("failed to send request: %v", err)
+			}
+			_ = resp.Body.Close()
+
+			for header, want := range tt.wantHeaders {
+				if value := got.Header.Get(header); value != want {
+					t.Errorf("header %s = %q, want %q", header, value, want)
+				}
+			}
+			for param, want := range tt.wantQuery {
+				if value := got.URL.Query().Get(param); value != want {
+					t.Errorf("query parameter %s = %q, want %q", param, value, want)
+				}
+			}
+
+			if len(req.Header) != 0 || req.URL.RawQuery != "api-version=2024-02-01" {
+				t.Errorf("the original request was modified")
+			}
+		})
+	}
+
+	if _, err := ParseAuthScheme("basic", "sk-test"); err == nil {
+		t.Errorf("ParseAuthScheme() accepted an unsupported scheme")
+	}
+}
+
+func TestRateLimits(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		// Only the first response reports the rate limits.
+		if requests.Add(1) == 1 {
+			w.Header().Set("x-ratelimit-limit-requests", "500")
+			w.Header().Set("x-ratelimit-limit-tokens", "30000")
+			w.Header().Set("x-ratelimit-remaining-requests", "499")
+			w.Header().Set("x-ratelimit-remaining-tokens", "29988")
+			w.Header().Set("x-ratelimit-reset-requests", "120ms")
+			w.Header().Set("x-ratelimit-reset-tokens", "6m0s")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "chatcmpl-1", "object": "chat.completion", "usage": {"prompt_tokens": 9, "completion_tokens": 3, "total_tokens": 12}}`))
+	}))
+	defer srv.Close()
+
+	rateLimits := NewRateLimitTracker()
+	client := NewHTTPClient(TransportConfig{RateLimits: rateLimits})
+	if _, ok := rateLimits.Limits(); ok {
+		t.Fatalf("rate limits are reported before any response")
+	}
+
+	want := RateLimits{
+		LimitRequests:     500,
+		LimitTokens:       30000,
+		RemainingRequests: 499,
+		RemainingTokens:   29988,
+		ResetRequests:     120 * time.Millisecond,
+		ResetTokens:       6 * time.Minute,
+	}
+	for i := 0; i < 2; i++ {
+		req, err := http.NewRequest(http.MethodPost, srv.URL, nil)
+		if err != nil {
+			t.Fatalf("failed to create request: %v", err)
+		}
+		var body map[string]any
+		if _, err = SendRequest(client, req, &body); err != nil {
+			t.Fatalf("failed to send request: %v", err)
+		}
+
+		// The limits of the first response are kept when a response doesn't report them.
+		got, ok := rateLimits.Limits()
+		if !ok || got.ObservedAt.IsZero() {
+			t.Fatalf("rate limits were not reported after request %d", i+1)
+		}
+		got.ObservedAt = time.Time{}
+		if got != want {
+			t.Errorf("rate limits after request %d = %+v, want %+v", i+1, got, want)
+		}
+	}
+}
+
+func TestFailover(t *testing.T) {
+	newServer := func(name string, requests *atomic.Int32) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			body, _ := io.ReadAll(r.Body)
+			_, _ = w.Write([]byte(name + ": " + string(body)))
+		}))
+	}
+
+	var primaryRequests, secondaryRequests atomic.Int32
+	primary := newServer("primary", &primaryRequests)
+	defer primary.Close()
+	secondary := newServer("secondary", &secondaryRequests)
+	defer secondary.Close()
+
+	// The unreachable host is the address of a server that has been shut down.
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachable.Close()
+
+	hostOf := func(srv *httptest.Server) string {
+		return strings.TrimPrefix(srv.URL, "http://")
+	}
+	client := NewHTTPClient(TransportConfig{
+		Failover: map[string][]string{
+			hostOf(primary):     {hostOf(secondary)},
+			hostOf(unreachable): {hostOf(secondary)},
+		},
+		FailoverPeriod: time.Minute,
+	})
+	transport := client.Transport.(*headerTransport).next.(*failoverTransport)
+	now := time.Now()
+	transport.now = func() time.Time { return now }
+
+	post := func(url string) string {
+		t.Helper()
+		resp, err := client.Post(url, "application/json", strings.NewReader(`{"model": "gpt-4"}`))
+		if err != nil {
+			t.Fatalf("failed to send request: %v", err)
+		}
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("failed to read response: %v", err)
+		}
+		return string(body)
+	}
+
+	want := `secondary: {"model": "gpt-4"}`
+	if got := post(primary.URL); got != `primary: {"model": "gpt-4"}` {
+		t.Errorf("response while the primary is healthy = %q", got)
+	}
+
+	// Requests are routed to the secondary while the primary is down.
+	transport.endpoints[hostOf(primary)][0].markDown(now.Add(time.Minute))
+	if got := post(primary.URL); got != want {
+		t.Errorf("response while the primary is down = %q, want %q", got, want)
+	}
+	if got := primaryRequests.Load(); got != 1 {
+		t.Errorf("primary got %d requests, want 1", got)
+	}
+
+	// The primary is used again once the failover period has passed.
+	now = now.Add(time.Minute)
+	if got := post(primary.URL); got != `primary: {"model": "gpt-4"}` {
+		t.Errorf("response after the failover period = %q", got)
+	}
+
+	// An unreachable host is marked down, and the request, with its body, is sent to the secondary instead.
+	if got := post(unreachable.URL); got != want {
+		t.Errorf("response from the unreachable host = %q, want %q", got, want)
+	}
+	if !transport.endpoints[hostOf(unreachable)][0].isDown(now) {
+		t.Errorf("unreachable host is not marked down")
+	}
+	if got := secondaryRequests.Load(); got != 2 {
+		t.Errorf("secondary got %d requests, want 2", got)
+	}
+}
+
+// BenchmarkConnectionReuse reports the number of connections opened to the server for concurrent requests. With the
+// default transport, only two idle connections are kept per host, so most connections are not reused.
+func BenchmarkConnectionReuse(b *testing.B) {
+	for _, bc := range []struct {
+		name string
+		cfg  TransportConfig
+	}{
+		{name: "Default"},
+		{name: "Tuned", cfg: TransportConfig{MaxIdleConnsPerHost: 32}},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			var conns atomic.Int64
+			srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte("ok"))
+			}))
+			srv.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+				if state == http.StateNew {
+					conns.Add(1)
+				}
+			}
+			srv.Start()
+			defer srv.Close()
+
+			client := NewHTTPClient(bc.cfg)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				var wg sync.WaitGroup
+				for j := 0; j < 32; j++ {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						resp, err := client.Get(srv.URL)
+						if err != nil {
+							b.Error(err)
+							return
+						}
+						_, _ = io.Copy(io.Discard, resp.Body)
+						_ = resp.Body.Close()
+					}()
+				}
+				wg.Wait()
+			}
+
+			b.ReportMetric(float64(conns.Load())/float64(b.N), "conns/op")
+		})
+	}
+}
@@ -16,6 +16,9 @@ type Assistant struct {
 	Model        string                                                 `json:"model"`
 	Name         *string                                                `json:"name"`
 	Tools        datatypes.JSONSlice[openai.AssistantObject_Tools_Item] `json:"tools"`
+
+	// ToolsVersion is incremented whenever the tools of the assistant change. This is not part of the public API.
+	ToolsVersion int `json:"tools_version" gorm:"not null;default:0"`
 }
 
 func (a *Assistant) IDPrefix() string {
@@ -60,6 +63,7 @@ func (a *Assistant) FromPublic(obj any) error {
 			o.Model,
 			o.Name,
 			o.Tools,
+			0,
 		}
 	}
 
@@ -127,7 +131,7 @@ func assistantToolToChatCompletionTool(t *openai.AssistantObject_Tools_Item, gpt
 		if function == nil {
 			function = tools[ob.XTool]
 			if function == nil {
-				return openai.ChatCompletionTool{}, fmt.Errorf("tool %s not found", t)
+				return openai.ChatCompletionTool{}, fmt.Errorf("tool %s not found", ob.XTool)
 			}
 		}
 
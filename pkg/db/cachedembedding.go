@@ -0,0 +1,17 @@
+package db
+
+import (
+	"github.com/gptscript-ai/clicky-chats/pkg/generated/openai"
+	"gorm.io/datatypes"
+)
+
+// CachedEmbedding is an embedding stored by the embeddings agent so that the same input isn't embedded twice. The ID is
+// the hash of the input together with the request parameters that affect the embedding.
+type CachedEmbedding struct {
+	Base      `json:",inline"`
+	Embedding datatypes.JSONType[openai.Embedding_Embedding] `json:"embedding"`
+}
+
+func (c *CachedEmbedding) IDPrefix() string {
+	return "embedcache-"
+}
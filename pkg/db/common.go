@@ -54,12 +54,32 @@ type JobRequest struct {
 	Base      `json:",inline"`
 	ClaimedBy *string `json:"claimed_by,omitempty"`
 	Done      bool    `json:"done"`
+	// Attempts is the number of times the job has been claimed, and LeaseExpiresAt is when the current claim expires if
+	// it was claimed with a lease.
+	Attempts       int  `json:"attempts" gorm:"not null;default:0"`
+	LeaseExpiresAt *int `json:"lease_expires_at,omitempty"`
 }
 
 func (j JobRequest) IsDone() bool {
 	return j.Done
 }
 
+func (j JobRequest) GetAttempts() int {
+	return j.Attempts
+}
+
+// Job is a request that is claimed and processed by an agent.
+type Job interface {
+	Storer
+	GetAttempts() int
+}
+
+// PrioritizedJob is a job that is claimed in order of its priority, highest first, and then the newest first.
+type PrioritizedJob interface {
+	Job
+	GetPriority() int
+}
+
 type JobResponse struct {
 	RequestID  string  `json:"request_id"`
 	Error      *string `json:"error"`
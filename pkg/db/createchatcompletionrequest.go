@@ -10,42 +10,91 @@ type CreateChatCompletionRequest struct {
 	// The following fields are not exposed in the public API
 	JobRequest `json:",inline"`
 	ModelAPI   string `json:"model_api"`
+	// Cancelled is set when the client that made the request disconnects before the response is complete.
+	Cancelled bool `json:"cancelled"`
+	// Status is the status of the request, one of the openai.XAsyncChatCompletionObjectStatus values. It is updated by
+	// the agent as the request is processed.
+	Status string `json:"status" gorm:"not null;default:queued"`
+	// Priority determines the order in which queued requests are sent to the model provider, higher priorities first.
+	Priority int `json:"priority"`
+	// TimeToFirstToken is the number of milliseconds from when a streamed request is sent to the model provider until
+	// the first content is received.
+	TimeToFirstToken *int `json:"time_to_first_token,omitempty"`
+	// ResponseFormatJSONSchema is the schema of a json_schema response format. It is stored separately so that the
+	// response format type can continue to be stored as a string.
+	ResponseFormatJSONSchema datatypes.JSONType[*ChatCompletionResponseFormatJSONSchema] `json:"response_format_json_schema,omitempty"`
+	// Include are the optional sections that are included in the response. They are handled by the server, so they are
+	// not sent to the model provider.
+	Include datatypes.JSONSlice[openai.CreateChatCompletionRequestInclude] `json:"include,omitempty"`
+	// Prompt are the messages that were sent to the model provider, after the request was normalized by the agent. It is
+	// only stored if enabled in the agent.
+	Prompt datatypes.JSONSlice[openai.ChatCompletionRequestMessage] `json:"prompt,omitempty"`
 
 	// The following fields are exposed in the public API
-	FrequencyPenalty *float32                                                     `json:"frequency_penalty"`
-	LogitBias        datatypes.JSONType[map[string]int]                           `json:"logit_bias"`
-	Logprobs         *bool                                                        `json:"logprobs"`
-	MaxTokens        *int                                                         `json:"max_tokens"`
-	Messages         datatypes.JSONSlice[openai.ChatCompletionRequestMessage]     `json:"messages"`
-	Model            string                                                       `json:"model"`
-	N                *int                                                         `json:"n"`
-	PresencePenalty  *float32                                                     `json:"presence_penalty"`
-	ResponseFormat   *string                                                      `json:"response_format,omitempty"`
-	Seed             *int                                                         `json:"seed"`
-	Stop             datatypes.JSONType[*openai.CreateChatCompletionRequest_Stop] `json:"stop,omitempty"`
-	Stream           *bool                                                        `json:"stream"`
-	Temperature      *float32                                                     `json:"temperature"`
-	ToolChoice       datatypes.JSONType[*openai.ChatCompletionToolChoiceOption]   `json:"tool_choice,omitempty"`
-	Tools            datatypes.JSONSlice[openai.ChatCompletionTool]               `json:"tools,omitempty"`
-	TopLogprobs      *int                                                         `json:"top_logprobs"`
-	TopP             *float32                                                     `json:"top_p"`
-	User             *string                                                      `json:"user,omitempty"`
+	Audio            datatypes.JSONType[*ChatCompletionAudio]                          `json:"audio,omitempty"`
+	FrequencyPenalty *float32                                                          `json:"frequency_penalty"`
+	LogitBias        datatypes.JSONType[map[string]int]                                `json:"logit_bias"`
+	Logprobs         *bool                                                             `json:"logprobs"`
+	MaxTokens        *int                                                              `json:"max_tokens"`
+	Messages         datatypes.JSONSlice[openai.ChatCompletionRequestMessage]          `json:"messages"`
+	Modalities       datatypes.JSONSlice[openai.CreateChatCompletionRequestModalities] `json:"modalities,omitempty"`
+	Model            string                                                            `json:"model"`
+	N                *int                                                              `json:"n"`
+	Prediction       datatypes.JSONType[*ChatCompletionPrediction]                     `json:"prediction,omitempty"`
+	PresencePenalty  *float32                                                          `json:"presence_penalty"`
+	ResponseFormat   *string                                                           `json:"response_format,omitempty"`
+	Seed             *int                                                              `json:"seed"`
+	Stop             datatypes.JSONType[*openai.CreateChatCompletionRequest_Stop]      `json:"stop,omitempty"`
+	Store            *bool                                                             `json:"store"`
+	Stream           *bool                                                             `json:"stream"`
+	Temperature      *float32                                                          `json:"temperature"`
+	ToolChoice       datatypes.JSONType[*openai.ChatCompletionToolChoiceOption]        `json:"tool_choice,omitempty"`
+	Tools            datatypes.JSONSlice[openai.ChatCompletionTool]                    `json:"tools,omitempty"`
+	TopLogprobs      *int                                                              `json:"top_logprobs"`
+	TopP             *float32                                                          `json:"top_p"`
+	User             *string                                                           `json:"user,omitempty"`
+}
+
+// ChatCompletionPrediction represents the inline CreateChatCompletionRequest.Prediction struct which is not generated as a separate type.
+type ChatCompletionPrediction = struct {
+	Content openai.CreateChatCompletionRequest_Prediction_Content `json:"content"`
+	Type    openai.CreateChatCompletionRequestPredictionType      `json:"type"`
+}
+
+// ChatCompletionResponseFormatJSONSchema represents the inline CreateChatCompletionRequest.ResponseFormat.JsonSchema struct which is not generated as a separate type.
+type ChatCompletionResponseFormatJSONSchema = struct {
+	Description *string                 `json:"description,omitempty"`
+	Name        string                  `json:"name"`
+	Schema      *map[string]interface{} `json:"schema,omitempty"`
+	Strict      *bool                   `json:"strict"`
+}
+
+// ChatCompletionResponseFormat represents the inline CreateChatCompletionRequest.ResponseFormat struct which is not generated as a separate type.
+type ChatCompletionResponseFormat = struct {
+	JsonSchema *ChatCompletionResponseFormatJSONSchema               `json:"json_schema,omitempty"`
+	Type       *openai.CreateChatCompletionRequestResponseFormatType `json:"type,omitempty"`
+}
+
+// ChatCompletionAudio represents the inline CreateChatCompletionRequest.Audio struct which is not generated as a separate type.
+type ChatCompletionAudio = struct {
+	Format openai.CreateChatCompletionRequestAudioFormat `json:"format"`
+	Voice  openai.CreateChatCompletionRequestAudioVoice  `json:"voice"`
 }
 
 func (c *CreateChatCompletionRequest) IDPrefix() string {
 	return "chatcmpl-"
 }
 
-func (c *CreateChatCompletionRequest) ToPublic() any {
-	var responseFormat *struct {
-		Type *openai.CreateChatCompletionRequestResponseFormatType `json:"type,omitempty"`
-	}
+func (c *CreateChatCompletionRequest) GetPriority() int {
+	return c.Priority
+}
 
+func (c *CreateChatCompletionRequest) ToPublic() any {
+	var responseFormat *ChatCompletionResponseFormat
 	if c.ResponseFormat != nil {
-		responseFormat = &struct {
-			Type *openai.CreateChatCompletionRequestResponseFormatType `json:"type,omitempty"`
-		}{
-			Type: (*openai.CreateChatCompletionRequestResponseFormatType)(c.ResponseFormat),
+		responseFormat = &ChatCompletionResponseFormat{
+			JsonSchema: c.ResponseFormatJSONSchema.Data(),
+			Type:       (*openai.CreateChatCompletionRequestResponseFormatType)(c.ResponseFormat),
 		}
 	}
 
@@ -56,24 +105,36 @@ func (c *CreateChatCompletionRequest) ToPublic() any {
 		}
 	}
 
+	var modalities *[]openai.CreateChatCompletionRequestModalities
+	if len(c.Modalities) > 0 {
+		modalities = z.Pointer[[]openai.CreateChatCompletionRequestModalities](c.Modalities)
+	}
+
 	//nolint:govet
 	return &openai.CreateChatCompletionRequest{
+		c.Audio.Data(),
 		c.FrequencyPenalty,
 
 		// These two fields are deprecated and will never be set.
 		nil,
 		nil,
 
+		// The included sections are handled by the server.
+		nil,
+
 		z.Pointer(c.LogitBias.Data()),
 		c.Logprobs,
 		c.MaxTokens,
 		c.Messages,
+		modalities,
 		*model,
 		c.N,
+		c.Prediction.Data(),
 		c.PresencePenalty,
 		responseFormat,
 		c.Seed,
 		c.Stop.Data(),
+		c.Store,
 		c.Stream,
 		c.Temperature,
 		c.ToolChoice.Data(),
@@ -91,9 +152,13 @@ func (c *CreateChatCompletionRequest) FromPublic(obj any) error {
 	}
 
 	if o != nil && c != nil {
-		var responseFormatType *string
+		var (
+			responseFormatType       *string
+			responseFormatJSONSchema *ChatCompletionResponseFormatJSONSchema
+		)
 		if o.ResponseFormat != nil {
 			responseFormatType = (*string)(o.ResponseFormat.Type)
+			responseFormatJSONSchema = o.ResponseFormat.JsonSchema
 		}
 
 		model, err := CreateChatCompletionModelFromPublic(o.Model)
@@ -104,17 +169,28 @@ func (c *CreateChatCompletionRequest) FromPublic(obj any) error {
 		*c = CreateChatCompletionRequest{
 			JobRequest{},
 			"",
+			false,
+			string(openai.Queued),
+			0,
+			nil,
+			datatypes.NewJSONType(responseFormatJSONSchema),
+			z.Dereference(o.Include),
+			nil,
+			datatypes.NewJSONType(o.Audio),
 			o.FrequencyPenalty,
 			datatypes.NewJSONType(z.Dereference(o.LogitBias)),
 			o.Logprobs,
 			o.MaxTokens,
 			o.Messages,
+			z.Dereference(o.Modalities),
 			model,
 			o.N,
+			datatypes.NewJSONType(o.Prediction),
 			o.PresencePenalty,
 			responseFormatType,
 			o.Seed,
 			datatypes.NewJSONType(o.Stop),
+			o.Store,
 			o.Stream,
 			o.Temperature,
 			datatypes.NewJSONType(o.ToolChoice),
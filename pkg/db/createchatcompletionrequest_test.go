@@ -0,0 +1,134 @@
+package db
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/acorn-io/z"
+	"github.com/gptscript-ai/clicky-chats/pkg/generated/openai"
+)
+
+func TestChatCompletionRequestUser(t *testing.T) {
+	publicRequest := new(openai.CreateChatCompletionRequest)
+	if err := json.Unmarshal([]byte(`{
+		"model": "gpt-4",
+		"messages": [{"role": "user", "content": "Hello"}],
+		"user": "user-1234"
+	}`), publicRequest); err != nil {
+		t.Fatalf("failed to unmarshal request: %v", err)
+	}
+
+	cc := new(CreateChatCompletionRequest)
+	if err := cc.FromPublic(publicRequest); err != nil {
+		t.Fatalf("FromPublic() error = %v", err)
+	}
+
+	if got := z.Dereference(cc.User); got != "user-1234" {
+		t.Errorf("stored user = %q, want %q", got, "user-1234")
+	}
+
+	// The user should be forwarded in the request sent to the model provider.
+	b, err := json.Marshal(cc.ToPublic())
+	if err != nil {
+		t.Fatalf("failed to marshal request: %v", err)
+	}
+
+	var forwarded map[string]any
+	if err = json.Unmarshal(b, &forwarded); err != nil {
+		t.Fatalf("failed to unmarshal forwarded request: %v", err)
+	}
+	if got := forwarded["user"]; got != "user-1234" {
+		t.Errorf("forwarded user = %v, want %q", got, "user-1234")
+	}
+}
+
+func TestChatCompletionRequestPrediction(t *testing.T) {
+	publicRequest := new(openai.CreateChatCompletionRequest)
+	if err := json.Unmarshal([]byte(`{
+		"model": "gpt-4",
+		"messages": [{"role": "user", "content": "Rename the function to run"}],
+		"prediction": {"type": "content", "content": "func main() {}"}
+	}`), publicRequest); err != nil {
+		t.Fatalf("failed to unmarshal request: %v", err)
+	}
+
+	cc := new(CreateChatCompletionRequest)
+	if err := cc.FromPublic(publicRequest); err != nil {
+		t.Fatalf("FromPublic() error = %v", err)
+	}
+
+	// Round-trip through the stored JSON representation of the prediction.
+	b, err := json.Marshal(cc.Prediction)
+	if err != nil {
+		t.Fatalf("failed to marshal prediction: %v", err)
+	}
+	if err = json.Unmarshal(b, &cc.Prediction); err != nil {
+		t.Fatalf("failed to unmarshal prediction: %v", err)
+	}
+
+	b, err = json.Marshal(cc.ToPublic())
+	if err != nil {
+		t.Fatalf("failed to marshal request: %v", err)
+	}
+
+	var forwarded struct {
+		Prediction struct {
+			Type    string `json:"type"`
+			Content string `json:"content"`
+		} `json:"prediction"`
+	}
+	if err = json.Unmarshal(b, &forwarded); err != nil {
+		t.Fatalf("failed to unmarshal forwarded request: %v", err)
+	}
+	if forwarded.Prediction.Type != "content" || forwarded.Prediction.Content != "func main() {}" {
+		t.Errorf("forwarded prediction = %+v, want content %q", forwarded.Prediction, "func main() {}")
+	}
+}
+
+func TestChatCompletionRequestAudio(t *testing.T) {
+	publicRequest := new(openai.CreateChatCompletionRequest)
+	if err := json.Unmarshal([]byte(`{
+		"model": "gpt-4o-audio-preview",
+		"messages": [{"role": "user", "content": "Say hello"}],
+		"modalities": ["text", "audio"],
+		"audio": {"voice": "alloy", "format": "wav"}
+	}`), publicRequest); err != nil {
+		t.Fatalf("failed to unmarshal request: %v", err)
+	}
+
+	cc := new(CreateChatCompletionRequest)
+	if err := cc.FromPublic(publicRequest); err != nil {
+		t.Fatalf("FromPublic() error = %v", err)
+	}
+
+	// Round-trip through the stored JSON representation of the audio parameters.
+	b, err := json.Marshal(cc.Audio)
+	if err != nil {
+		t.Fatalf("failed to marshal audio: %v", err)
+	}
+	if err = json.Unmarshal(b, &cc.Audio); err != nil {
+		t.Fatalf("failed to unmarshal audio: %v", err)
+	}
+
+	b, err = json.Marshal(cc.ToPublic())
+	if err != nil {
+		t.Fatalf("failed to marshal request: %v", err)
+	}
+
+	var forwarded struct {
+		Modalities []string `json:"modalities"`
+		Audio      struct {
+			Voice  string `json:"voice"`
+			Format string `json:"format"`
+		} `json:"audio"`
+	}
+	if err = json.Unmarshal(b, &forwarded); err != nil {
+		t.Fatalf("failed to unmarshal forwarded request: %v", err)
+	}
+	if len(forwarded.Modalities) != 2 || forwarded.Modalities[0] != "text" || forwarded.Modalities[1] != "audio" {
+		t.Errorf("forwarded modalities = %v, want [text audio]", forwarded.Modalities)
+	}
+	if forwarded.Audio.Voice != "alloy" || forwarded.Audio.Format != "wav" {
+		t.Errorf("forwarded audio = %+v, want voice alloy and format wav", forwarded.Audio)
+	}
+}
@@ -10,6 +10,8 @@ type CreateChatCompletionResponse struct {
 	// The following fields are not exposed in the public API
 	JobResponse `json:",inline"`
 	Base        `json:",inline"`
+	// RawResponse is the body of the response from the model provider. It is only stored if enabled in the agent.
+	RawResponse *string `json:"raw_response,omitempty"`
 
 	// The following fields are exposed in the public API
 	Choices           datatypes.JSONSlice[Choice]                 `json:"choices"`
@@ -36,6 +38,7 @@ func (c *CreateChatCompletionResponse) FromPublic(obj any) error {
 				CreatedAt: o.Created,
 				ID:        o.Id,
 			},
+			nil,
 			publicChoices(o.Choices).toDBChoices(),
 			o.Model,
 			o.SystemFingerprint,
@@ -0,0 +1,197 @@
+package db
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/acorn-io/z"
+	"github.com/gptscript-ai/clicky-chats/pkg/generated/openai"
+)
+
+func TestChatCompletionResponseRefusal(t *testing.T) {
+	publicResponse := new(openai.CreateChatCompletionResponse)
+	if err := json.Unmarshal([]byte(`{
+		"id": "chatcmpl-123",
+		"created": 1700000000,
+		"model": "gpt-4",
+		"object": "chat.completion",
+		"choices": [{
+			"index": 0,
+			"finish_reason": "stop",
+			"message": {"role": "assistant", "content": null, "refusal": "I can't help with that."}
+		}]
+	}`), publicResponse); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+
+	ccr := new(CreateChatCompletionResponse)
+	if err := ccr.FromPublic(publicResponse); err != nil {
+		t.Fatalf("FromPublic() error = %v", err)
+	}
+
+	// Round-trip through the stored JSON representation of the choices.
+	b, err := json.Marshal(ccr.Choices)
+	if err != nil {
+		t.Fatalf("failed to marshal choices: %v", err)
+	}
+	ccr.Choices = nil
+	if err = json.Unmarshal(b, &ccr.Choices); err != nil {
+		t.Fatalf("failed to unmarshal choices: %v", err)
+	}
+
+	message := ccr.ToPublic().(*openai.CreateChatCompletionResponse).Choices[0].Message
+	if got := z.Dereference(message.Refusal); got != "I can't help with that." {
+		t.Errorf("refusal = %q, want %q", got, "I can't help with that.")
+	}
+	if message.Content != nil {
+		t.Errorf("content = %q, want nil", *message.Content)
+	}
+}
+
+func TestChatCompletionResponsePredictionTokens(t *testing.T) {
+	publicResponse := new(openai.CreateChatCompletionResponse)
+	if err := json.Unmarshal([]byte(`{
+		"id": "chatcmpl-123",
+		"created": 1700000000,
+		"model": "gpt-4",
+		"object": "chat.completion",
+		"choices": [{
+			"index": 0,
+			"finish_reason": "stop",
+			"message": {"role": "assistant", "content": "func run() {}"}
+		}],
+		"usage": {
+			"prompt_tokens": 20,
+			"completion_tokens": 10,
+			"total_tokens": 30,
+			"completion_tokens_details": {"accepted_prediction_tokens": 6, "rejected_prediction_tokens": 2}
+		}
+	}`), publicResponse); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+
+	ccr := new(CreateChatCompletionResponse)
+	if err := ccr.FromPublic(publicResponse); err != nil {
+		t.Fatalf("FromPublic() error = %v", err)
+	}
+
+	// Round-trip through the stored JSON representation of the usage.
+	b, err := json.Marshal(ccr.Usage)
+	if err != nil {
+		t.Fatalf("failed to marshal usage: %v", err)
+	}
+	if err = json.Unmarshal(b, &ccr.Usage); err != nil {
+		t.Fatalf("failed to unmarshal usage: %v", err)
+	}
+
+	usage := ccr.ToPublic().(*openai.CreateChatCompletionResponse).Usage
+	if usage == nil || usage.CompletionTokensDetails == nil {
+		t.Fatalf("completion token details were not stored: %#v", usage)
+	}
+	if got := z.Dereference(usage.CompletionTokensDetails.AcceptedPredictionTokens); got != 6 {
+		t.Errorf("accepted prediction tokens = %d, want 6", got)
+	}
+	if got := z.Dereference(usage.CompletionTokensDetails.RejectedPredictionTokens); got != 2 {
+		t.Errorf("rejected prediction tokens = %d, want 2", got)
+	}
+}
+
+func TestChatCompletionResponseLogprobBytes(t *testing.T) {
+	// "é" is split across two tokens, so the text of each token alone is not valid UTF-8 and only the bytes can be used
+	// to reconstruct it.
+	publicResponse := new(openai.CreateChatCompletionResponse)
+	if err := json.Unmarshal([]byte(`{
+		"id": "chatcmpl-123",
+		"created": 1700000000,
+		"model": "gpt-4",
+		"object": "chat.completion",
+		"choices": [{
+			"index": 0,
+			"finish_reason": "stop",
+			"message": {"role": "assistant", "content": "é"},
+			"logprobs": {"content": [
+				{"token": "\\xc3", "logprob": -0.1, "bytes": [195], "top_logprobs": [{"token": "\\xc3", "logprob": -0.1, "bytes": [195]}]},
+				{"token": "\\xa9", "logprob": -0.2, "bytes": [169], "top_logprobs": []}
+			]}
+		}]
+	}`), publicResponse); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+
+	ccr := new(CreateChatCompletionResponse)
+	if err := ccr.FromPublic(publicResponse); err != nil {
+		t.Fatalf("FromPublic() error = %v", err)
+	}
+
+	// Round-trip through the stored JSON representation of the choices.
+	b, err := json.Marshal(ccr.Choices)
+	if err != nil {
+		t.Fatalf("failed to marshal choices: %v", err)
+	}
+	ccr.Choices = nil
+	if err = json.Unmarshal(b, &ccr.Choices); err != nil {
+		t.Fatalf("failed to unmarshal choices: %v", err)
+	}
+
+	logprobs := ccr.ToPublic().(*openai.CreateChatCompletionResponse).Choices[0].Logprobs
+	if logprobs == nil || len(z.Dereference(logprobs.Content)) != 2 {
+		t.Fatalf("unexpected logprobs: %#v", logprobs)
+	}
+
+	var text []byte
+	for _, logprob := range *logprobs.Content {
+		for _, b := range z.Dereference(logprob.Bytes) {
+			text = append(text, byte(b))
+		}
+	}
+	if string(text) != "é" {
+		t.Errorf("text from bytes = %q, want %q", text, "é")
+	}
+
+	if topLogprobs := (*logprobs.Content)[0].TopLogprobs; len(topLogprobs) != 1 || len(z.Dereference(topLogprobs[0].Bytes)) != 1 || (*topLogprobs[0].Bytes)[0] != 195 {
+		t.Errorf("unexpected top logprobs: %#v", topLogprobs)
+	}
+}
+
+func TestChatCompletionResponseAudio(t *testing.T) {
+	publicResponse := new(openai.CreateChatCompletionResponse)
+	if err := json.Unmarshal([]byte(`{
+		"id": "chatcmpl-123",
+		"created": 1700000000,
+		"model": "gpt-4o-audio-preview",
+		"object": "chat.completion",
+		"choices": [{
+			"index": 0,
+			"finish_reason": "stop",
+			"message": {
+				"role": "assistant",
+				"content": null,
+				"audio": {"id": "audio_123", "data": "UklGRg==", "transcript": "Hello", "expires_at": 1700003600}
+			}
+		}]
+	}`), publicResponse); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+
+	ccr := new(CreateChatCompletionResponse)
+	if err := ccr.FromPublic(publicResponse); err != nil {
+		t.Fatalf("FromPublic() error = %v", err)
+	}
+
+	// Round-trip through the stored JSON representation of the choices.
+	b, err := json.Marshal(ccr.Choices)
+	if err != nil {
+		t.Fatalf("failed to marshal choices: %v", err)
+	}
+	if err = json.Unmarshal(b, &ccr.Choices); err != nil {
+		t.Fatalf("failed to unmarshal choices: %v", err)
+	}
+
+	audio := ccr.ToPublic().(*openai.CreateChatCompletionResponse).Choices[0].Message.Audio
+	if audio == nil {
+		t.Fatalf("audio was not stored")
+	}
+	if audio.Id != "audio_123" || audio.Data != "UklGRg==" || audio.Transcript != "Hello" || audio.ExpiresAt != 1700003600 {
+		t.Errorf("audio = %+v, want the audio returned by the provider", *audio)
+	}
+}
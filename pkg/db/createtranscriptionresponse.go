@@ -2,6 +2,7 @@ package db
 
 import (
 	"github.com/gptscript-ai/clicky-chats/pkg/generated/openai"
+	"gorm.io/datatypes"
 )
 
 type CreateTranscriptionResponse struct {
@@ -11,6 +12,9 @@ type CreateTranscriptionResponse struct {
 	// The following fields are exposed in the public API
 	Base `json:",inline"`
 	Text string
+	// Verbose is the verbose_json transcription, with the segments and words of the text and their timestamps. It is
+	// only stored for requests with the verbose_json response format, and is returned in place of the text if it is set.
+	Verbose datatypes.JSONType[*openai.CreateTranscriptionResponseVerboseJson]
 }
 
 func (*CreateTranscriptionResponse) IDPrefix() string {
@@ -22,6 +26,10 @@ func (c *CreateTranscriptionResponse) ToPublic() any {
 		return nil
 	}
 
+	if verbose := c.Verbose.Data(); verbose != nil {
+		return verbose
+	}
+
 	//nolint:govet
 	return &openai.CreateTranscriptionResponseJson{
 		c.Text,
@@ -29,20 +37,37 @@ func (c *CreateTranscriptionResponse) ToPublic() any {
 }
 
 func (c *CreateTranscriptionResponse) FromPublic(obj any) error {
-	o, ok := obj.(*openai.CreateTranscriptionResponseJson)
-	if !ok {
-		return InvalidTypeError{Expected: o, Got: obj}
-	}
-
-	if o == nil || c == nil {
+	if c == nil {
 		return nil
 	}
 
-	//nolint:govet
-	*c = CreateTranscriptionResponse{
-		JobResponse{},
-		Base{},
-		o.Text,
+	switch o := obj.(type) {
+	case *openai.CreateTranscriptionResponseJson:
+		if o == nil {
+			return nil
+		}
+
+		//nolint:govet
+		*c = CreateTranscriptionResponse{
+			JobResponse{},
+			Base{},
+			o.Text,
+			datatypes.NewJSONType[*openai.CreateTranscriptionResponseVerboseJson](nil),
+		}
+	case *openai.CreateTranscriptionResponseVerboseJson:
+		if o == nil {
+			return nil
+		}
+
+		//nolint:govet
+		*c = CreateTranscriptionResponse{
+			JobResponse{},
+			Base{},
+			o.Text,
+			datatypes.NewJSONType(o),
+		}
+	default:
+		return InvalidTypeError{Expected: (*openai.CreateTranscriptionResponseJson)(nil), Got: obj}
 	}
 
 	return nil
@@ -85,6 +85,7 @@ func (db *DB) AutoMigrate() error {
 		ImagesResponse{},
 		CreateEmbeddingRequest{},
 		CreateEmbeddingResponse{},
+		CachedEmbedding{},
 		CreateSpeechRequest{},
 		CreateSpeechResponse{},
 		CreateTranslationRequest{},
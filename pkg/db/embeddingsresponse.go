@@ -9,6 +9,9 @@ type CreateEmbeddingResponse struct {
 	// The following fields are not exposed in the public API
 	JobResponse `json:",inline"`
 	Base        `json:",inline"`
+	// Normalized is set if the embeddings were scaled to unit length by the agent, so that their cosine similarity can be
+	// computed as their dot product.
+	Normalized bool `json:"normalized"`
 
 	// The following fields are exposed in the public API
 	Data  datatypes.JSONSlice[Embedding]     `json:"data"`
@@ -40,6 +43,7 @@ func (e *CreateEmbeddingResponse) FromPublic(obj any) error {
 		*e = CreateEmbeddingResponse{
 			JobResponse{},
 			Base{},
+			false,
 			publicEmbeddings(o.Data).toDB(),
 			o.Model,
 			datatypes.NewJSONType(EmbeddingUsage{
@@ -1,9 +1,12 @@
 package db
 
 import (
+	"fmt"
+
 	"github.com/acorn-io/z"
 	"github.com/gptscript-ai/clicky-chats/pkg/generated/openai"
 	"gorm.io/datatypes"
+	gdb "gorm.io/gorm"
 )
 
 type Message struct {
@@ -20,12 +23,32 @@ type Message struct {
 	IncompleteDetails datatypes.JSONType[*struct {
 		Reason openai.MessageObjectIncompleteDetailsReason `json:"reason"`
 	}] `json:"incomplete_details,omitempty"`
+	// This is not part of the public API
+	ThreadIndex int `json:"thread_index" gorm:"not null;default:0"`
 }
 
 func (m *Message) IDPrefix() string {
 	return "msg_"
 }
 
+// BeforeCreate gives the message the next index in its thread. Messages created in the same second have the same
+// creation time, so the index is used to keep them in the order they were created.
+func (m *Message) BeforeCreate(tx *gdb.DB) error {
+	return tx.Session(&gdb.Session{NewDB: true}).Transaction(func(tx *gdb.DB) error {
+		// Incrementing the thread's message count locks the thread so that concurrently created messages get different
+		// indexes.
+		result := tx.Model(new(Thread)).Where("id = ?", m.ThreadID).Update("message_count", gdb.Expr("message_count + 1"))
+		if result.Error != nil {
+			return fmt.Errorf("failed to increment message count of thread %s: %w", m.ThreadID, result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("thread %s not found", m.ThreadID)
+		}
+
+		return tx.Model(new(Thread)).Where("id = ?", m.ThreadID).Select("message_count").Scan(&m.ThreadIndex).Error
+	})
+}
+
 func (m *Message) ToPublic() any {
 	//nolint:govet
 	return &openai.MessageObject{
@@ -72,6 +95,7 @@ func (m *Message) FromPublic(obj any) error {
 			o.CompletedAt,
 			o.IncompleteAt,
 			datatypes.NewJSONType(o.IncompleteDetails),
+			0,
 		}
 	}
 
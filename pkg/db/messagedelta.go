@@ -18,7 +18,7 @@ func NewMessageDeltaWithText(index int, id, text string) (*MessageDelta, error)
 			nil,
 			&text,
 		}),
-		openai.Text,
+		openai.MessageDeltaContentTextObjectTypeText,
 	}); err != nil {
 		return nil, err
 	}
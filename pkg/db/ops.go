@@ -1,7 +1,6 @@
 package db
 
 import (
-	"errors"
 	"fmt"
 	"log/slog"
 	"time"
@@ -65,26 +64,9 @@ func DeleteExpired(db *gdb.DB, expiration time.Time, objs ...Storer) error {
 }
 
 // Dequeue dequeues the next request from the database, marking it as claimed by the given agent.
-func Dequeue(db *gdb.DB, request Storer, agentID string) error {
-	err := db.Model(request).Transaction(func(tx *gdb.DB) error {
-		if err := tx.Where("claimed_by IS NULL").Or("claimed_by = ? AND done = false", agentID).
-			Order("created_at desc").
-			First(request).Error; err != nil {
-			return err
-		}
-
-		if err := tx.Where("id = ?", request.GetID()).
-			Updates(map[string]interface{}{"claimed_by": agentID}).Error; err != nil {
-			return err
-		}
-
-		return nil
-	})
-	if err != nil && !errors.Is(err, gdb.ErrRecordNotFound) {
-		err = fmt.Errorf("failed to dequeue request %T: %w", request, err)
-	}
-
-	return err
+// The request is claimed without a lease.
+func Dequeue(db *gdb.DB, request Job, agentID string) error {
+	return NewQueue(agentID, 0).Claim(db, request)
 }
 
 // Modify modifies the object in the database. All validation should be done before calling this function.
@@ -95,6 +77,32 @@ func Modify(db *gdb.DB, obj any, id string, updates any) error {
 	})
 }
 
+// CancelChatCompletion marks a chat completion request as cancelled so that the agent processing it can stop. It
+// returns false if the request is already done, in which case it is not changed.
+func CancelChatCompletion(db *gdb.DB, id string) (bool, error) {
+	result := db.Model(new(CreateChatCompletionRequest)).Where("id = ? AND done = false", id).Update("cancelled", true)
+	return result.RowsAffected > 0, result.Error
+}
+
+// CancelQueuedChatCompletion marks a chat completion request as cancelled if it hasn't been claimed by an agent yet, so
+// that the agent that claims it doesn't send it to the model provider. It returns false if the request has already been
+// claimed or is done, in which case it is not changed.
+func CancelQueuedChatCompletion(db *gdb.DB, id string) (bool, error) {
+	result := db.Model(new(CreateChatCompletionRequest)).Where("id = ? AND claimed_by IS NULL AND done = false", id).
+		Updates(map[string]any{"cancelled": true, "status": string(openai.Cancelled)})
+	return result.RowsAffected > 0, result.Error
+}
+
+// ChatCompletionDoneStatus returns the status to store for a chat completion request when its response is stored. A
+// request that failed after the client cancelled it is cancelled instead.
+func ChatCompletionDoneStatus(failed bool) any {
+	if !failed {
+		return string(openai.Completed)
+	}
+
+	return gdb.Expr("CASE WHEN cancelled THEN ? ELSE ? END", string(openai.Cancelled), string(openai.Failed))
+}
+
 // CancelRun cancels a run that is in progress. If the run is not in progress, it will return an error.
 func CancelRun(db *gdb.DB, id string) (*Run, error) {
 	run := new(Run)
@@ -0,0 +1,155 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	gdb "gorm.io/gorm"
+)
+
+// maxLeaseBackoff bounds how many times longer than the lease a retried job's lease can be.
+const maxLeaseBackoff = 16
+
+// Queue claims job requests from the database for an agent. A job is claimed by at most one agent at a time.
+//
+// If the queue has a lease, then a claim expires if it isn't extended before the lease runs out, so that the job can be
+// claimed by another agent if the one that claimed it crashes. Each time a job is claimed, its lease is doubled, up to
+// maxLeaseBackoff times the lease, so that a job that keeps crashing agents is retried less often. Without a lease, a job
+// can only be reclaimed by the agent that claimed it, for example after it restarts.
+type Queue struct {
+	agentID string
+	lease   time.Duration
+	now     func() time.Time
+}
+
+func NewQueue(agentID string, lease time.Duration) *Queue {
+	return &Queue{
+		agentID: agentID,
+		lease:   lease,
+		now:     time.Now,
+	}
+}
+
+// Claim finds a job that can be claimed by the agent and claims it, filling in request. Prioritized jobs with the
+// highest priority are claimed first, and the newest job is claimed among those with the same priority. The jobs with
+// the excluded IDs are not claimed. If there is no job to claim, or another agent claimed it first, then
+// gorm.ErrRecordNotFound is returned.
+func (q *Queue) Claim(db *gdb.DB, request Job, exclude ...string) error {
+	err := db.Model(request).Transaction(func(tx *gdb.DB) error {
+		now := q.now()
+
+		query := q.claimable(tx, now)
+		if len(exclude) > 0 {
+			query = query.Where("id NOT IN ?", exclude)
+		}
+		if _, ok := request.(PrioritizedJob); ok {
+			query = query.Order("priority desc")
+		}
+		if err := query.Order("created_at desc").First(request).Error; err != nil {
+			return err
+		}
+
+		updates := map[string]any{
+			"claimed_by":       q.agentID,
+			"attempts":         gdb.Expr("attempts + 1"),
+			"lease_expires_at": nil,
+		}
+		if q.lease > 0 {
+			updates["lease_expires_at"] = int(now.Add(q.lease * time.Duration(min(1<<request.GetAttempts(), maxLeaseBackoff))).Unix())
+		}
+
+		// The job is only updated if it can still be claimed, in case another agent claimed it in the meantime.
+		result := q.claimable(tx.Model(request), now).Where("id = ?", request.GetID()).Updates(updates)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gdb.ErrRecordNotFound
+		}
+
+		return nil
+	})
+	if err != nil && !errors.Is(err, gdb.ErrRecordNotFound) {
+		err = fmt.Errorf("failed to claim request %T: %w", request, err)
+	}
+
+	return err
+}
+
+// claimable returns a query for the jobs that the agent can claim.
+func (q *Queue) claimable(tx *gdb.DB, now time.Time) *gdb.DB {
+	// The conditions are grouped on a new session, otherwise they would include the conditions that tx already has.
+	return tx.Where(
+		tx.Session(&gdb.Session{NewDB: true}).Where("claimed_by IS NULL").
+			Or("claimed_by = ? AND done = false", q.agentID).
+			Or("done = false AND lease_expires_at IS NOT NULL AND lease_expires_at < ?", int(now.Unix())),
+	)
+}
+
+// Release gives up the agent's claim on a job that it hasn't started processing, so that it can be claimed again by any
+// agent. The claim isn't counted as an attempt.
+func (q *Queue) Release(db *gdb.DB, request Job) error {
+	result := db.Model(request).Where("id = ? AND claimed_by = ?", request.GetID(), q.agentID).Updates(map[string]any{
+		"claimed_by":       nil,
+		"attempts":         gdb.Expr("attempts - 1"),
+		"lease_expires_at": nil,
+	})
+	if result.Error != nil {
+		return fmt.Errorf("failed to release request %T: %w", request, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("request %s is no longer claimed by %s", request.GetID(), q.agentID)
+	}
+
+	return nil
+}
+
+// Extend extends the lease of a job claimed by the agent. An error is returned if the job is no longer claimed by the
+// agent.
+func (q *Queue) Extend(db *gdb.DB, request Job) error {
+	if q.lease <= 0 {
+		return nil
+	}
+
+	// Use the table instead of the model so that the request isn't modified, it may be in use while it is being processed.
+	stmt := &gdb.Statement{DB: db}
+	if err := stmt.Parse(request); err != nil {
+		return fmt.Errorf("failed to parse request %T: %w", request, err)
+	}
+
+	result := db.Table(stmt.Schema.Table).Where("id = ? AND claimed_by = ?", request.GetID(), q.agentID).
+		Update("lease_expires_at", int(q.now().Add(q.lease).Unix()))
+	if result.Error != nil {
+		return fmt.Errorf("failed to extend lease of request %T: %w", request, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("request %s is no longer claimed by %s", request.GetID(), q.agentID)
+	}
+
+	return nil
+}
+
+// KeepAlive extends the lease of a job claimed by the agent until the context is done, so that the job isn't claimed by
+// another agent while it is being processed.
+func (q *Queue) KeepAlive(ctx context.Context, db *gdb.DB, request Job) error {
+	if q.lease <= 0 {
+		return nil
+	}
+
+	ticker := time.NewTicker(q.lease / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		if err := q.Extend(db.WithContext(ctx), request); err != nil && ctx.Err() == nil {
+			return err
+		}
+	}
+}
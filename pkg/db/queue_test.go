@@ -0,0 +1,183 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/acorn-io/z"
+	gdb "gorm.io/gorm"
+)
+
+func newTestDB(t *testing.T) *gdb.DB {
+	t.Helper()
+
+	db, err := New("sqlite://"+filepath.Join(t.TempDir(), "clicky-chats.db"), true)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	if err = db.AutoMigrate(); err != nil {
+		t.Fatalf("failed to migrate database: %v", err)
+	}
+
+	return db.WithContext(context.Background())
+}
+
+func TestQueueClaimsJobOnce(t *testing.T) {
+	db := newTestDB(t)
+	if err := Create(db, &CreateEmbeddingRequest{Model: "text-embedding-3-small"}); err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+
+	var (
+		wg      sync.WaitGroup
+		results = make([]error, 2)
+	)
+	for i, agentID := range []string{"agent-1", "agent-2"} {
+		wg.Add(1)
+		go func(i int, agentID string) {
+			defer wg.Done()
+			results[i] = NewQueue(agentID, time.Minute).Claim(db, new(CreateEmbeddingRequest))
+		}(i, agentID)
+	}
+	wg.Wait()
+
+	var claimed int
+	for _, err := range results {
+		if err == nil {
+			claimed++
+		} else if !errors.Is(err, gdb.ErrRecordNotFound) {
+			t.Errorf("Claim() unexpected error = %v", err)
+		}
+	}
+	if claimed != 1 {
+		t.Errorf("job was claimed %d times, want 1", claimed)
+	}
+}
+
+func TestQueueReclaimsExpiredLease(t *testing.T) {
+	db := newTestDB(t)
+	if err := Create(db, &CreateEmbeddingRequest{Model: "text-embedding-3-small"}); err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+
+	now := time.Now()
+	crashed, other := NewQueue("agent-1", time.Minute), NewQueue("agent-2", time.Minute)
+	crashed.now = func() time.Time { return now }
+	other.now = func() time.Time { return now }
+
+	if err := crashed.Claim(db, new(CreateEmbeddingRequest)); err != nil {
+		t.Fatalf("Claim() error = %v", err)
+	}
+
+	// The job can't be claimed by another agent while the lease is valid.
+	if err := other.Claim(db, new(CreateEmbeddingRequest)); !errors.Is(err, gdb.ErrRecordNotFound) {
+		t.Fatalf("Claim() before lease expired error = %v, want %v", err, gdb.ErrRecordNotFound)
+	}
+
+	// Once the lease expires, the job can be claimed by another agent.
+	now = now.Add(time.Minute + time.Second)
+	request := new(CreateEmbeddingRequest)
+	if err := other.Claim(db, request); err != nil {
+		t.Fatalf("Claim() after lease expired error = %v", err)
+	}
+
+	if err := Get(db, request, request.ID); err != nil {
+		t.Fatalf("failed to get request: %v", err)
+	}
+	if z.Dereference(request.ClaimedBy) != "agent-2" || request.Attempts != 2 {
+		t.Errorf("request claimed by %q with %d attempts, want agent-2 with 2 attempts", z.Dereference(request.ClaimedBy), request.Attempts)
+	}
+
+	// The lease of the second attempt is doubled.
+	if want := int(now.Add(2 * time.Minute).Unix()); z.Dereference(request.LeaseExpiresAt) != want {
+		t.Errorf("lease expires at %d, want %d", z.Dereference(request.LeaseExpiresAt), want)
+	}
+
+	// The crashed agent no longer holds the claim.
+	if err := crashed.Extend(db, request); err == nil {
+		t.Error("Extend() by the crashed agent should fail")
+	}
+}
+
+func TestQueueClaimsHighestPriorityJobFirst(t *testing.T) {
+	db := newTestDB(t)
+
+	high, low := &CreateChatCompletionRequest{Model: "gpt-4", Priority: 1}, &CreateChatCompletionRequest{Model: "gpt-4"}
+	for _, request := range []*CreateChatCompletionRequest{high, low} {
+		if err := Create(db, request); err != nil {
+			t.Fatalf("failed to create request: %v", err)
+		}
+	}
+
+	// The low priority job is newer, so it would be claimed first if priority were ignored.
+	if err := db.Model(low).Where("id = ?", low.ID).Update("created_at", high.CreatedAt+1).Error; err != nil {
+		t.Fatalf("failed to update request: %v", err)
+	}
+
+	queue := NewQueue("agent-1", time.Minute)
+	for _, want := range []string{high.ID, low.ID} {
+		request := new(CreateChatCompletionRequest)
+		if err := queue.Claim(db, request); err != nil {
+			t.Fatalf("Claim() error = %v", err)
+		}
+		if request.ID != want {
+			t.Errorf("claimed request %s, want %s", request.ID, want)
+		}
+
+		if err := db.Model(request).Where("id = ?", request.ID).Update("done", true).Error; err != nil {
+			t.Fatalf("failed to complete request: %v", err)
+		}
+	}
+}
+
+func TestQueueClaimsOnlyOneJob(t *testing.T) {
+	db := newTestDB(t)
+	for range 3 {
+		if err := Create(db, &CreateEmbeddingRequest{Model: "text-embedding-3-small"}); err != nil {
+			t.Fatalf("failed to create request: %v", err)
+		}
+	}
+
+	if err := NewQueue("agent-1", time.Minute).Claim(db, new(CreateEmbeddingRequest)); err != nil {
+		t.Fatalf("Claim() error = %v", err)
+	}
+
+	var claimed int64
+	if err := db.Model(new(CreateEmbeddingRequest)).Where("claimed_by IS NOT NULL").Count(&claimed).Error; err != nil {
+		t.Fatalf("failed to count claimed requests: %v", err)
+	}
+	if claimed != 1 {
+		t.Errorf("%d requests were claimed, want 1", claimed)
+	}
+}
+
+func TestQueueRelease(t *testing.T) {
+	db := newTestDB(t)
+	if err := Create(db, &CreateEmbeddingRequest{Model: "text-embedding-3-small"}); err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+
+	request := new(CreateEmbeddingRequest)
+	if err := NewQueue("agent-1", time.Minute).Claim(db, request); err != nil {
+		t.Fatalf("Claim() error = %v", err)
+	}
+	if err := NewQueue("agent-1", time.Minute).Release(db, request); err != nil {
+		t.Fatalf("Release() error = %v", err)
+	}
+
+	// The released job can be claimed by another agent, and the released claim isn't counted as an attempt.
+	request = new(CreateEmbeddingRequest)
+	if err := NewQueue("agent-2", time.Minute).Claim(db, request); err != nil {
+		t.Fatalf("Claim() after release error = %v", err)
+	}
+	if err := Get(db, request, request.ID); err != nil {
+		t.Fatalf("failed to get request: %v", err)
+	}
+	if z.Dereference(request.ClaimedBy) != "agent-2" || request.Attempts != 1 {
+		t.Errorf("request claimed by %q with %d attempts, want agent-2 with 1 attempt", z.Dereference(request.ClaimedBy), request.Attempts)
+	}
+}
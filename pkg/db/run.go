@@ -1,6 +1,7 @@
 package db
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/acorn-io/z"
@@ -32,6 +33,13 @@ type Run struct {
 	SystemClaimedBy *string `json:"system_claimed_by,omitempty"`
 	SystemStatus    *string `json:"system_status,omitempty"`
 	EventIndex      int     `json:"event_index,omitempty"`
+	// AdditionalInstructions are appended to the instructions of the run when it is created.
+	AdditionalInstructions *string `json:"additional_instructions,omitempty"`
+	// AssistantToolsVersion is the version of the assistant's tools when the run was created. The run is processed with
+	// its own tools, instead of the assistant's current tools, if this is set.
+	AssistantToolsVersion *int `json:"assistant_tools_version,omitempty"`
+	// MaxToolIterations overrides the maximum number of tool call round trips the run agent allows the run to make.
+	MaxToolIterations *int `json:"max_tool_iterations,omitempty"`
 }
 
 func (r *Run) IDPrefix() string {
@@ -117,12 +125,66 @@ func (r *Run) FromPublic(obj any) error {
 			nil,
 			nil,
 			0,
+			nil,
+			nil,
+			nil,
+		}
+	}
+
+	return nil
+}
+
+// BeforeCreate records the version of the assistant's tools that the run is created with. Unless the run overrides the
+// tools, it gets a copy of the assistant's tools so that changing the assistant doesn't change how the run is processed.
+func (r *Run) BeforeCreate(tx *gorm.DB) error {
+	assistant := new(Assistant)
+	if err := tx.Session(&gorm.Session{NewDB: true}).Model(assistant).Where("id = ?", r.AssistantID).First(assistant).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to get assistant %s: %w", r.AssistantID, err)
+	}
+
+	if len(r.Tools) == 0 {
+		tools := make([]openai.RunObject_Tools_Item, 0, len(assistant.Tools))
+		for _, t := range assistant.Tools {
+			b, err := t.MarshalJSON()
+			if err != nil {
+				return err
+			}
+
+			var tool openai.RunObject_Tools_Item
+			if err = tool.UnmarshalJSON(b); err != nil {
+				return err
+			}
+			tools = append(tools, tool)
 		}
+		r.Tools = tools
 	}
 
+	r.AssistantToolsVersion = z.Pointer(assistant.ToolsVersion)
 	return nil
 }
 
+// AssistantTools returns the tools of the run as assistant tools.
+func (r *Run) AssistantTools() ([]openai.AssistantObject_Tools_Item, error) {
+	tools := make([]openai.AssistantObject_Tools_Item, 0, len(r.Tools))
+	for _, t := range r.Tools {
+		b, err := t.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+
+		var tool openai.AssistantObject_Tools_Item
+		if err = tool.UnmarshalJSON(b); err != nil {
+			return nil, err
+		}
+		tools = append(tools, tool)
+	}
+
+	return tools, nil
+}
+
 func (r *Run) BeforeUpdate(tx *gorm.DB) error {
 	if !tx.Statement.Changed("status") {
 		return nil
@@ -206,23 +206,29 @@ func (r *RunStep) Merge(toolCalls *[]GenericToolCallInfo, chunk ChatCompletionRe
 			}
 
 			(*toolCalls)[chunkTC.Index] = tc
+		}
 
+		// The step details have every tool call generated so far, not just those in this chunk, so that all the
+		// parallel tool calls of the step are kept.
+		items := make([]openai.RunStepDetailsToolCallsObject_ToolCalls_Item, 0, len(*toolCalls))
+		for _, tc := range *toolCalls {
 			toolCall, err := runStepFromGenericToolCallInfo(tc)
 			if err != nil {
 				return nil, err
 			}
+			items = append(items, *toolCall)
+		}
 
-			stepDetails := r.StepDetails.Data()
-			//nolint:govet
-			if err = stepDetails.FromRunStepDetailsToolCallsObject(openai.RunStepDetailsToolCallsObject{
-				[]openai.RunStepDetailsToolCallsObject_ToolCalls_Item{*toolCall},
-				openai.RunStepDetailsToolCallsObjectTypeToolCalls,
-			}); err != nil {
-				return nil, err
-			}
-
-			r.StepDetails = datatypes.NewJSONType(stepDetails)
+		stepDetails := r.StepDetails.Data()
+		//nolint:govet
+		if err := stepDetails.FromRunStepDetailsToolCallsObject(openai.RunStepDetailsToolCallsObject{
+			items,
+			openai.RunStepDetailsToolCallsObjectTypeToolCalls,
+		}); err != nil {
+			return nil, err
 		}
+
+		r.StepDetails = datatypes.NewJSONType(stepDetails)
 	}
 
 	return runStepDelta, nil
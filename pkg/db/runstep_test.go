@@ -0,0 +1,150 @@
+package db
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/gptscript-ai/clicky-chats/pkg/generated/openai"
+	"gorm.io/datatypes"
+)
+
+func TestRunStepToolCallArgumentsKeepLargeIntegers(t *testing.T) {
+	db := newTestDB(t)
+
+	// The arguments are split across chunks like they are when streamed from the model provider.
+	var (
+		runStep   = &RunStep{RunID: "run_test", Type: string(openai.ToolCalls)}
+		toolCalls []GenericToolCallInfo
+	)
+	for _, delta := range []string{
+		`{"tool_calls": [{"index": 0, "id": "call_test", "type": "function", "function": {"name": "lookup", "arguments": "{\"id\": 92233720"}}]}`,
+		`{"tool_calls": [{"index": 0, "function": {"arguments": "36854775807}"}}]}`,
+	} {
+		var d openai.ChatCompletionStreamResponseDelta
+		if err := json.Unmarshal([]byte(delta), &d); err != nil {
+			t.Fatalf("failed to unmarshal delta: %v", err)
+		}
+
+		if _, err := runStep.Merge(&toolCalls, ChatCompletionResponseChunk{Choices: []ChunkChoice{{Delta: datatypes.NewJSONType(d)}}}); err != nil {
+			t.Fatalf("Merge() error = %v", err)
+		}
+	}
+
+	if err := Create(db, runStep); err != nil {
+		t.Fatalf("failed to create run step: %v", err)
+	}
+
+	stored := new(RunStep)
+	if err := Get(db, stored, runStep.ID); err != nil {
+		t.Fatalf("failed to get run step: %v", err)
+	}
+
+	functionCalls, err := stored.GetRunStepFunctionCalls()
+	if err != nil {
+		t.Fatalf("GetRunStepFunctionCalls() error = %v", err)
+	}
+	if len(functionCalls) != 1 {
+		t.Fatalf("got %d function calls, want 1", len(functionCalls))
+	}
+
+	// The arguments are passed to the tool as they were generated, so they are never decoded into a float64.
+	arguments := functionCalls[0].Function.Arguments
+	if want := `{"id": 9223372036854775807}`; arguments != want {
+		t.Errorf("arguments = %q, want %q", arguments, want)
+	}
+
+	var args struct {
+		ID json.Number `json:"id"`
+	}
+	d := json.NewDecoder(bytes.NewReader([]byte(arguments)))
+	d.UseNumber()
+	if err = d.Decode(&args); err != nil {
+		t.Fatalf("failed to decode arguments: %v", err)
+	}
+	if id, err := args.ID.Int64(); err != nil || id != math.MaxInt64 {
+		t.Errorf("id = %v (err %v), want %d", args.ID, err, int64(math.MaxInt64))
+	}
+}
+
+func TestRunStepToolCallDetailsArePersisted(t *testing.T) {
+	db := newTestDB(t)
+
+	// The model calls two tools in parallel, and the chunks of their arguments are interleaved.
+	var (
+		runStep   = &RunStep{RunID: "run_test", Type: string(openai.ToolCalls)}
+		toolCalls []GenericToolCallInfo
+	)
+	for _, delta := range []string{
+		`{"tool_calls": [{"index": 0, "id": "call_weather", "type": "function", "function": {"name": "weather", "arguments": "{\"city\": "}}]}`,
+		`{"tool_calls": [{"index": 1, "id": "call_time", "type": "function", "function": {"name": "time", "arguments": "{\"zone\": \"UTC\"}"}}]}`,
+		`{"tool_calls": [{"index": 0, "function": {"arguments": "\"Paris\"}"}}]}`,
+	} {
+		var d openai.ChatCompletionStreamResponseDelta
+		if err := json.Unmarshal([]byte(delta), &d); err != nil {
+			t.Fatalf("failed to unmarshal delta: %v", err)
+		}
+
+		if _, err := runStep.Merge(&toolCalls, ChatCompletionResponseChunk{Choices: []ChunkChoice{{Delta: datatypes.NewJSONType(d)}}}); err != nil {
+			t.Fatalf("Merge() error = %v", err)
+		}
+	}
+
+	if err := Create(db, runStep); err != nil {
+		t.Fatalf("failed to create run step: %v", err)
+	}
+
+	// Set the outputs of the tool calls the way the step runner does.
+	outputs := map[string]string{
+		"call_weather": `{"temperature": 21.5, "unit": "celsius"}`,
+		"call_time":    "12:00:00\nno daylight saving",
+	}
+	details, err := runStep.StepDetails.Data().AsRunStepDetailsToolCallsObject()
+	if err != nil {
+		t.Fatalf("failed to get tool calls: %v", err)
+	}
+	for i := range details.ToolCalls {
+		info, err := GetOutputForRunStepToolCall(&details.ToolCalls[i])
+		if err != nil {
+			t.Fatalf("GetOutputForRunStepToolCall() error = %v", err)
+		}
+		if err = SetOutputForRunStepToolCall(&details.ToolCalls[i], outputs[info.ID]); err != nil {
+			t.Fatalf("SetOutputForRunStepToolCall() error = %v", err)
+		}
+	}
+	stepDetails := runStep.StepDetails.Data()
+	if err = stepDetails.FromRunStepDetailsToolCallsObject(details); err != nil {
+		t.Fatalf("failed to set step details: %v", err)
+	}
+	if err = db.Model(runStep).Where("id = ?", runStep.ID).Update("step_details", datatypes.NewJSONType(stepDetails)).Error; err != nil {
+		t.Fatalf("failed to update run step: %v", err)
+	}
+
+	stored := new(RunStep)
+	if err = Get(db, stored, runStep.ID); err != nil {
+		t.Fatalf("failed to get run step: %v", err)
+	}
+
+	storedDetails, err := stored.StepDetails.Data().AsRunStepDetailsToolCallsObject()
+	if err != nil {
+		t.Fatalf("failed to get stored tool calls: %v", err)
+	}
+
+	want := []GenericToolCallInfo{
+		{ID: "call_weather", Name: "weather", Arguments: `{"city": "Paris"}`, Output: outputs["call_weather"]},
+		{ID: "call_time", Name: "time", Arguments: `{"zone": "UTC"}`, Output: outputs["call_time"]},
+	}
+	if len(storedDetails.ToolCalls) != len(want) {
+		t.Fatalf("got %d stored tool calls, want %d", len(storedDetails.ToolCalls), len(want))
+	}
+	for i := range storedDetails.ToolCalls {
+		got, err := GetOutputForRunStepToolCall(&storedDetails.ToolCalls[i])
+		if err != nil {
+			t.Fatalf("GetOutputForRunStepToolCall() error = %v", err)
+		}
+		if got != want[i] {
+			t.Errorf("tool call %d = %+v, want %+v", i, got, want[i])
+		}
+	}
+}
@@ -9,6 +9,7 @@ type Thread struct {
 	Metadata `json:",inline"`
 	// This is not part of the public API
 	LockedByRunID string `json:"locked_by_run_id"`
+	MessageCount  int    `json:"message_count" gorm:"not null;default:0"`
 }
 
 func (t *Thread) IDPrefix() string {
@@ -46,6 +47,7 @@ func (t *Thread) FromPublic(obj any) error {
 				z.Dereference(o.Metadata),
 			},
 			"",
+			0,
 		}
 	}
 
@@ -36,6 +36,29 @@ var (
 	}
 
 	extraRunFields = openapi3.Schemas{
+		"last_error": {
+			Value: &openapi3.Schema{
+				Nullable:    true,
+				Description: "The last error associated with this run. Will be `null` if there are no errors.",
+				Properties: map[string]*openapi3.SchemaRef{
+					"code": {
+						Value: &openapi3.Schema{
+							Description: "One of `server_error`, `rate_limit_exceeded`, `invalid_prompt`, or `max_tool_iterations_exceeded`.",
+							Enum:        []any{"server_error", "rate_limit_exceeded", "invalid_prompt", "max_tool_iterations_exceeded"},
+							Type:        "string",
+						},
+					},
+					"message": {
+						Value: &openapi3.Schema{
+							Description: "A human-readable description of the error.",
+							Type:        "string",
+						},
+					},
+				},
+				Required: []string{"code", "message"},
+				Type:     "object",
+			},
+		},
 		"required_action": {
 			Value: &openapi3.Schema{
 				Nullable:    true,
@@ -112,6 +135,17 @@ var (
 		},
 	}
 
+	extraCreateRunFields = openapi3.Schemas{
+		"max_tool_iterations": {
+			Value: &openapi3.Schema{
+				Description: "The maximum number of tool call round trips the run can make before it fails. If not set, then the limit configured for the server is used.",
+				Type:        "integer",
+				Min:         z.Pointer(float64(1)),
+				Nullable:    true,
+			},
+		},
+	}
+
 	extraToolCallFunctionFields = openapi3.Schemas{
 		"function": {
 			Value: &openapi3.Schema{
@@ -153,13 +187,258 @@ var (
 		},
 	}
 
+	extraChatCompletionMessageFields = openapi3.Schemas{
+		"refusal": {
+			Value: &openapi3.Schema{
+				Description: "The refusal message generated by the model, if the model declined to respond.",
+				Type:        "string",
+				Nullable:    true,
+			},
+		},
+	}
+
+	extraChatCompletionResponseMessageFields = openapi3.Schemas{
+		"refusal": extraChatCompletionMessageFields["refusal"],
+		"audio": {
+			Value: &openapi3.Schema{
+				Description: "If the audio output modality is requested, this object contains data about the audio response from the model.",
+				Nullable:    true,
+				Properties: map[string]*openapi3.SchemaRef{
+					"id": {
+						Value: &openapi3.Schema{
+							Description: "Unique identifier for this audio response.",
+							Type:        "string",
+						},
+					},
+					"expires_at": {
+						Value: &openapi3.Schema{
+							Description: "The Unix timestamp (in seconds) for when this audio response will no longer be accessible on the server for use in multi-turn conversations.",
+							Type:        "integer",
+						},
+					},
+					"data": {
+						Value: &openapi3.Schema{
+							Description: "Base64 encoded audio bytes generated by the model, in the format specified in the request.",
+							Type:        "string",
+						},
+					},
+					"transcript": {
+						Value: &openapi3.Schema{
+							Description: "Transcript of the audio generated by the model.",
+							Type:        "string",
+						},
+					},
+				},
+				Required: []string{"id", "expires_at", "data", "transcript"},
+				Type:     "object",
+			},
+		},
+	}
+
+	extraChatCompletionRequestFields = openapi3.Schemas{
+		"response_format": {
+			Value: &openapi3.Schema{
+				Description: "An object specifying the format that the model must output. Compatible with [GPT-4 Turbo](/docs/models/gpt-4-and-gpt-4-turbo) and all GPT-3.5 Turbo models newer than `gpt-3.5-turbo-1106`.\n\nSetting to `{ \"type\": \"json_schema\", \"json_schema\": {...} }` enables Structured Outputs which ensures the model will match your supplied JSON schema.\n\nSetting to `{ \"type\": \"json_object\" }` enables JSON mode, which guarantees the message the model generates is valid JSON.\n\n**Important:** when using JSON mode, you **must** also instruct the model to produce JSON yourself via a system or user message. Without this, the model may generate an unending stream of whitespace until the generation reaches the token limit, resulting in a long-running and seemingly \"stuck\" request. Also note that the message content may be partially cut off if `finish_reason=\"length\"`, which indicates the generation exceeded `max_tokens` or the conversation exceeded the max context length.\n",
+				Properties: map[string]*openapi3.SchemaRef{
+					"type": {
+						Value: &openapi3.Schema{
+							Default:     "text",
+							Description: "Must be one of `text`, `json_object`, or `json_schema`.",
+							Enum:        []any{"text", "json_object", "json_schema"},
+							Example:     "json_object",
+							Type:        "string",
+						},
+					},
+					"json_schema": {
+						Value: &openapi3.Schema{
+							Description: "The JSON schema the model must output when the type is `json_schema`.",
+							Properties: map[string]*openapi3.SchemaRef{
+								"name": {
+									Value: &openapi3.Schema{
+										Description: "The name of the response format. Must be a-z, A-Z, 0-9, or contain underscores and dashes, with a maximum length of 64.",
+										Type:        "string",
+									},
+								},
+								"description": {
+									Value: &openapi3.Schema{
+										Description: "A description of what the response format is for, used by the model to determine how to respond in the format.",
+										Type:        "string",
+									},
+								},
+								"schema": {
+									Value: &openapi3.Schema{
+										Description:          "The schema for the response format, described as a JSON Schema object.",
+										Type:                 "object",
+										AdditionalProperties: openapi3.AdditionalProperties{Has: z.Pointer(true)},
+									},
+								},
+								"strict": {
+									Value: &openapi3.Schema{
+										Description: "Whether to enable strict schema adherence when generating the output. If set to true, the model will always follow the exact schema defined in the `schema` field. Only a subset of JSON Schema is supported when `strict` is `true`.",
+										Type:        "boolean",
+										Default:     false,
+										Nullable:    true,
+									},
+								},
+							},
+							Required: []string{"name"},
+							Type:     "object",
+						},
+					},
+				},
+				Type: "object",
+			},
+		},
+		"include": {
+			Value: &openapi3.Schema{
+				Description: "Optional sections to include in the response, even if the server omits them by default. Including `choices.logprobs` also requests log probabilities from the model if `logprobs` is not set.",
+				Type:        "array",
+				Items: &openapi3.SchemaRef{
+					Value: &openapi3.Schema{
+						Enum: []any{"choices.logprobs", "system_fingerprint", "usage"},
+						Type: "string",
+					},
+				},
+			},
+		},
+		"store": {
+			Value: &openapi3.Schema{
+				Default:     false,
+				Description: "Whether or not to keep this chat completion when stored chat completions expire.",
+				Nullable:    true,
+				Type:        "boolean",
+			},
+		},
+		"modalities": {
+			Value: &openapi3.Schema{
+				Description: "Output types that you would like the model to generate for this request. Most models are capable of generating text, which is the default. The `gpt-4o-audio-preview` model can also be used to generate audio, which is requested with `[\"text\", \"audio\"]`.",
+				Nullable:    true,
+				Type:        "array",
+				Items: &openapi3.SchemaRef{
+					Value: &openapi3.Schema{
+						Enum: []any{"text", "audio"},
+						Type: "string",
+					},
+				},
+			},
+		},
+		"audio": {
+			Value: &openapi3.Schema{
+				Description: "Parameters for audio output. Required when audio output is requested with `modalities: [\"audio\"]`.",
+				Nullable:    true,
+				Properties: map[string]*openapi3.SchemaRef{
+					"voice": {
+						Value: &openapi3.Schema{
+							Description: "The voice the model uses to respond.",
+							Enum:        []any{"alloy", "ash", "ballad", "coral", "echo", "sage", "shimmer", "verse"},
+							Type:        "string",
+						},
+					},
+					"format": {
+						Value: &openapi3.Schema{
+							Description: "Specifies the output audio format. Must be one of `wav`, `mp3`, `flac`, `opus`, or `pcm16`.",
+							Enum:        []any{"wav", "mp3", "flac", "opus", "pcm16"},
+							Type:        "string",
+						},
+					},
+				},
+				Required: []string{"voice", "format"},
+				Type:     "object",
+			},
+		},
+		"prediction": {
+			Value: &openapi3.Schema{
+				Description: "Configuration for a [Predicted Output](/docs/guides/predicted-outputs), which can greatly improve response times when large parts of the model response are known ahead of time.",
+				Nullable:    true,
+				Properties: map[string]*openapi3.SchemaRef{
+					"type": {
+						Value: &openapi3.Schema{
+							Description: "The type of the predicted content you want to provide. This type is currently always `content`.",
+							Enum:        []any{"content"},
+							Type:        "string",
+						},
+					},
+					"content": {
+						Value: &openapi3.Schema{
+							Description: "The content that should be matched when generating a model response. If generated tokens would match this content, the entire model response can be returned much more quickly.",
+							OneOf: []*openapi3.SchemaRef{
+								{
+									Value: &openapi3.Schema{
+										Description: "The content used for a Predicted Output. This is often the text of a file you are regenerating with minor changes.",
+										Type:        "string",
+									},
+								},
+								{
+									Value: &openapi3.Schema{
+										Description: "An array of content parts with a defined type. Supported options differ based on the model being used to generate the response. Can contain text inputs.",
+										Type:        "array",
+										MinItems:    1,
+										Items: &openapi3.SchemaRef{
+											Ref: "#/components/schemas/ChatCompletionRequestMessageContentPartText",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"type", "content"},
+				Type:     "object",
+			},
+		},
+	}
+
+	extraCompletionUsageFields = openapi3.Schemas{
+		"completion_tokens_details": {
+			Value: &openapi3.Schema{
+				Description: "Breakdown of tokens used in a completion.",
+				Properties: map[string]*openapi3.SchemaRef{
+					"accepted_prediction_tokens": {
+						Value: &openapi3.Schema{
+							Description: "When using Predicted Outputs, the number of tokens in the prediction that appeared in the completion.",
+							Type:        "integer",
+						},
+					},
+					"rejected_prediction_tokens": {
+						Value: &openapi3.Schema{
+							Description: "When using Predicted Outputs, the number of tokens in the prediction that did not appear in the completion. However, like reasoning tokens, these tokens are still counted in the total completion tokens for purposes of billing, output, and context window limits.",
+							Type:        "integer",
+						},
+					},
+				},
+				Type: "object",
+			},
+		},
+	}
+
+	extraFunctionObjectFields = openapi3.Schemas{
+		"strict": {
+			Value: &openapi3.Schema{
+				Description: "Whether to enable strict schema adherence when generating the function call. If set to true, the model will follow the exact schema defined in the `parameters` field. Only a subset of JSON Schema is supported when `strict` is `true`.",
+				Type:        "boolean",
+				Default:     false,
+				Nullable:    true,
+			},
+		},
+	}
+
 	extendedAPIs = map[string]openapi3.Schemas{
 		"AssistantObject":        extraAssistantFields,
 		"CreateAssistantRequest": extraAssistantFields,
 		"ModifyAssistantRequest": extraAssistantFields,
 
 		"RunObject":                             extraRunFields,
+		"CreateRunRequest":                      extraCreateRunFields,
+		"CreateThreadAndRunRequest":             extraCreateRunFields,
 		"RunStepDetailsToolCallsFunctionObject": extraToolCallFunctionFields,
+
+		"ChatCompletionRequestAssistantMessage": extraChatCompletionMessageFields,
+		"ChatCompletionResponseMessage":         extraChatCompletionResponseMessageFields,
+		"ChatCompletionStreamResponseDelta":     extraChatCompletionMessageFields,
+
+		"CreateChatCompletionRequest": extraChatCompletionRequestFields,
+		"CompletionUsage":             extraCompletionUsageFields,
+		"FunctionObject":              extraFunctionObjectFields,
 	}
 )
 
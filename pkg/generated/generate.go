@@ -26,10 +26,36 @@ func main() {
 	s.Components.Schemas["CreateRunRequest"].Value.Required = []string{"assistant_id"}
 	s.Components.Schemas["CreateThreadAndRunRequest"].Value.Required = []string{"assistant_id"}
 
+	// Runs can be filtered by their metadata when they are listed, with metadata[key]=value query parameters.
+	explode := true
+	listRuns := s.Paths.Find("/threads/{thread_id}/runs").Get
+	listRuns.Parameters = append(listRuns.Parameters, &openapi3.ParameterRef{
+		Value: &openapi3.Parameter{
+			Description: "Only return runs with all of these metadata key-value pairs.",
+			In:          openapi3.ParameterInQuery,
+			Name:        "metadata",
+			Style:       "deepObject",
+			Explode:     &explode,
+			Schema: &openapi3.SchemaRef{
+				Value: &openapi3.Schema{
+					Type: "object",
+					AdditionalProperties: openapi3.AdditionalProperties{
+						Schema: &openapi3.SchemaRef{Value: openapi3.NewStringSchema()},
+					},
+				},
+			},
+		},
+	})
+
 	// Tools is nullable in the CreateChatCompletionRequest
 	s.Components.Schemas["CreateChatCompletionRequest"].Value.Properties["tools"].Value.Nullable = true
 	s.Components.Schemas["FunctionObject"].Value.Properties["parameters"].Value.Nullable = true
 
+	// The model can be required to call one or more tools, without naming the tool.
+	toolChoice := s.Components.Schemas["ChatCompletionToolChoiceOption"].Value
+	toolChoice.Description += "`required` means the model must call one or more tools.\n"
+	toolChoice.OneOf[0].Value.Enum = append(toolChoice.OneOf[0].Value.Enum, "required")
+
 	// Embeddings can be requested as an array of floats or a base64-encoded string, but the OpenAI API Spec doesn't support string as return type
 
 	s.Components.Schemas["Embedding"].Value.Properties["embedding"].Value = &openapi3.Schema{
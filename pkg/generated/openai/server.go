@@ -61,6 +61,15 @@ type ServerInterface interface {
 	// Creates a model response for the given chat conversation.
 	// (POST /chat/completions)
 	CreateChatCompletion(w http.ResponseWriter, r *http.Request)
+	// Creates a chat completion without waiting for it to complete. The completion is returned with the `status: "queued"` and can be polled until it is `completed` or `failed`.
+	// (POST /chat/x-completions)
+	XCreateAsyncChatCompletion(w http.ResponseWriter, r *http.Request)
+	// Retrieves a chat completion that was created without waiting for it to complete.
+	// (GET /chat/x-completions/{completion_id})
+	XGetAsyncChatCompletion(w http.ResponseWriter, r *http.Request, completionId string)
+	// Cancels a chat completion that is still `queued`, so that it is never sent to the model. A chat completion that is already `in_progress`, `completed`, or `failed` is not changed and is returned with its current status.
+	// (POST /chat/x-completions/{completion_id}/cancel)
+	XCancelAsyncChatCompletion(w http.ResponseWriter, r *http.Request, completionId string)
 	// Creates a completion for the provided prompt and parameters.
 	// (POST /completions)
 	CreateCompletion(w http.ResponseWriter, r *http.Request)
@@ -620,6 +629,79 @@ func (siw *ServerInterfaceWrapper) CreateChatCompletion(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// XCreateAsyncChatCompletion operation middleware
+func (siw *ServerInterfaceWrapper) XCreateAsyncChatCompletion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.XCreateAsyncChatCompletion(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// XGetAsyncChatCompletion operation middleware
+func (siw *ServerInterfaceWrapper) XGetAsyncChatCompletion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "completion_id" -------------
+	var completionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "completion_id", r.PathValue("completion_id"), &completionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "completion_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.XGetAsyncChatCompletion(w, r, completionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// XCancelAsyncChatCompletion operation middleware
+func (siw *ServerInterfaceWrapper) XCancelAsyncChatCompletion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "completion_id" -------------
+	var completionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "completion_id", r.PathValue("completion_id"), &completionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "completion_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, ApiKeyAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.XCancelAsyncChatCompletion(w, r, completionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // CreateCompletion operation middleware
 func (siw *ServerInterfaceWrapper) CreateCompletion(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -1537,6 +1619,14 @@ func (siw *ServerInterfaceWrapper) ListRuns(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	// ------------- Optional query parameter "metadata" -------------
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "metadata", r.URL.Query(), &params.Metadata)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "metadata", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ListRuns(w, r, threadId, params)
 	}))
@@ -2390,6 +2480,9 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	m.HandleFunc("POST "+options.BaseURL+"/audio/transcriptions", wrapper.CreateTranscription)
 	m.HandleFunc("POST "+options.BaseURL+"/audio/translations", wrapper.CreateTranslation)
 	m.HandleFunc("POST "+options.BaseURL+"/chat/completions", wrapper.CreateChatCompletion)
+	m.HandleFunc("POST "+options.BaseURL+"/chat/x-completions", wrapper.XCreateAsyncChatCompletion)
+	m.HandleFunc("GET "+options.BaseURL+"/chat/x-completions/{completion_id}", wrapper.XGetAsyncChatCompletion)
+	m.HandleFunc("POST "+options.BaseURL+"/chat/x-completions/{completion_id}/cancel", wrapper.XCancelAsyncChatCompletion)
 	m.HandleFunc("POST "+options.BaseURL+"/completions", wrapper.CreateCompletion)
 	m.HandleFunc("POST "+options.BaseURL+"/embeddings", wrapper.CreateEmbedding)
 	m.HandleFunc("GET "+options.BaseURL+"/files", wrapper.ListFiles)
@@ -2447,701 +2540,729 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9C3PbOLYu+lewdc6tTuZQsiTbsp1TqX0z3enp7OlHTuKenjlxyoJISGKHItgEaUfT",
-	"J1X3P9x/eH/JLawFgAAJUpQfeXR776pJWyTxXFgvfGut3wch32Q8ZWkhBk9+H4hwzTYU/vOZELEoaFp8",
-	"Gyfsp8WvLCzkzxETYR5nRczTwZPBM5LEoiB8Sd7I18TbRwcRD8UBzeJhzpYsZ2nIDpby0WNCi4KGaxaR",
-	"ghOakjnVPcxHg2CQ5TxjeREz6N08u4yjZrfna0bMG+TFN6RY04IUa0ZkVyQWdl+y8WKbscGTgSjyOF0N",
-	"PgSDMGe0YNElLfyt/5zG70kRb5go6CYjj+KUCBbyNBKPyZLn5HrNUuiwGgZ0fU0FUW1b/cZpwVYslx23",
-	"TSeOWFrEy5jlAblex+GahDQlC0bMMkYkTsmzly8IS6OMx2khvDPjLVslO8FnRH6je5FrlVzTrbD2YySn",
-	"ApvC0nIzePJm4D4avG30+yEY5Oy3Ms5ZJN+Po4EZibPYgbuzsqG4SGRLz5yFFNXUTDPvh5zGP7CCyskt",
-	"4N8iL1kwYO/pJoNGfr9ICbkYxNHF4Am5GMiWhnQRTqaHF4MAn2Fz+NydlnmlGq98bTI7OxsfHx/OjtRj",
-	"ewamneJS93ORfrhIB8EgpRvWoFUgEjUjuWhm1m0n7BXLcibk+aydGaR5SSQhTRKgxQ2PWEJoGpFSMFJw",
-	"nojmyboHyt9J9E4vvk6tXyQzcZofEfnGhr6PN+WGJCxdFUC2x5MpCdc0p2HBcjGCNd/Q99/DC4Mnx5Np",
-	"MEjLJKELSRpIKY3TIvfjMo4EDmtJy6QYPHnzNmjnc/KLTjb34huH/ZBiLc+YM5uc6dNNzcT4kkzHSPu1",
-	"z521+BZfyBnhecRyFpHFVr4T57gFcgUjWjDJLKgIWRrF6QrfxSWKC7aB6TbWYkPfv8CH07FZKprndPtR",
-	"GFeciiIvQ9m08HcltqJgG2K/WHH+ihxLwUQb0RxOT2anXWQDL/QgnA0raESRF7kjfc2AUCYz8o5th1c0",
-	"KRnJaJyL6sTKjXeFIbIEOepY6FdKwZZlAodOFFx2TGgUxbIbmpA4XfJ8gxtOF7zEVVAcXm4+wVUqJY3g",
-	"qyPyd7YVXtKbHVmLQhIu+0ojAqOvfYEfuKcPvsC1bFk5l4ufbzP2PV2wZPBksKEZLKhkXs3VfPGNZgjI",
-	"3Qou12VE/sVLGBZwujUjb76XBxTeadFC8NmBPMiPgRwLTgRjRHJPviRbXuaEXtEYRq9aCohcfMaIfPjm",
-	"BxgBv2L5VcyudS+qXf0zcklrEkJNYIPr06AklBM+epdPerPD6fGsi66nx7MeVH0HyoNfb/CoDMEAJFRv",
-	"zivfJiyV448ITz2r0sJWJ9NTFIYkY7nzCfyoPpE9bDMmyDzkEbuU0ivPclawfB6Qec6KPGZXNJF/LMsU",
-	"uM8cyGO+ygocMUzd8Feesp+Wgydvfh/895wtB08G/+2gUrYPlKZ9YBQAGMzXPGKDD8E+n7zSI9vzu2/V",
-	"JHZ+9k/3u7+9PH8Nsx18eOsIjcn0tC41+muFcAjcvdcsoSYZNNlYstvixj6F8k5USUfF61Il27XI07PT",
-	"o7OTY/VYzhg//YEWa3JeFjw331rrIN+R51Y9gTXB71ZZMTwyn9iLhM8li5SKApV0L0BobGRXhexqRH6R",
-	"ihwV71hEKPmtZEJ+GpDrPC4YMP+8TMnLbbHmKZFHAiWVuGY5HD39xciMAPZFdv1G/k3I7/gPPNpmarL1",
-	"wyX1ZfnOB/nPW9WS3lloTP+o91j++PuHTi3bp2BX5+vJ7zWVGKnDx/PkE8N7FkyK4Igt45RFTzx8wmJ8",
-	"9We7TSZ4apGvHCqxWoAxNEi5MUNzrBuzXFpPus67buEn08MN18ewSWtdzCD6rUfgfqCWRo+w55JUHPKu",
-	"dr6SBtbUzI/777UZYeuMvl7T4msuWZMco16Ar2mS/NRiVr3OWBgvt6A1kozmRRyWCc2JXlByFVMy/91m",
-	"RJvtpX56Mfgwl4pMyISrfCljkxamIVQ13HXtp9Msq32Edj1mQW3hoN23vddHKRdZzkLJijWTd8faaZw+",
-	"q5um18bTpAcfcSYCqYdqU8xarDXngqHJLDnqml9ba1i1Mbq5Xmiv4YJB0ywakR9KUYAiNPx3QJ4N/3dA",
-	"xsMzUFdCnhY0TkmZRiwXIc+lOZlGJKJiLSdyHRdrS39SCiaYCN5hZjSnG8mgRF/G8rL64ob7+wMTgq6Y",
-	"PN3yCHTzuub6VWumNxN3TC1e0xmZr8qNdpF6PJH6sXdvYUEDQgVZsZTlkg5dOolT8l+vf/rR2Gg/8oLV",
-	"RyZpjKS80Oq2bkoaaHEE3wewixu6JWuaJGUYp/J5tTvwuWJhcgBg75hB4h6NyD9ke7RAm6qaWJzi+6AH",
-	"LNiS50hqkrs4Dd0RJe/BDQJre3yU0+a3qAxLYPEtPfYSfqqNEfm6zHOWFsk2IDxNtpYIlKaSKLOM58pJ",
-	"tr9ABO3ZJxX3OistNGzWoI1MAyLKcC3J2OwTvO6YPF2nv/sEf2g6nNwPfqQbFsHrax6HrE3exZKb4Wyq",
-	"0yPWvEwi9Bv8DJ5RFG0eyUaJwHZCh6TbucsnlnufDXXuT5ivGJgQRldTJNFcVCmxWNrilVAPRcNPQjbY",
-	"3oi8UsMkZZowIchcLsclUO8cDHg9aPgNF0MRU9Tp07LcyHYLfqXDHfo35jmaWixLaIhHzh4eOnuAduRr",
-	"FUPmS0JrckxRuVECOmTOg4j7UkRctS9BOxPwd/4sJTxTzmIYxJKj5Y7GQJyBD+xlzq/iyNHybc9ywUkU",
-	"L8GFWsRy0RasuGYstRsxZ0/IXnKeMO8SyQf+JZJPjJcXT60gtCzWPA/kvhToFBfs5m5GPE+3klFNbRVm",
-	"5L3CVLMY9GWCWjW2eOAus2UvrmgITzPFPkztzmj6jvbeiKubSSgYQ2DWzTpPdbfCvrtn7Vo/p6+3lddw",
-	"u6Xb2uWV9Tbxs2D5rRpoCOMbtSJPzK0aqB+HD2+Vy/b5+4ymUUW1O3bka9zrlzQvbrk5zQbP2fviZrNr",
-	"tvVic0ezxIYaGlQsf74sc4+lHLGCxolzCTOgZcEHQat+XcCFvfyMJOyKJfr4Qi8j8j2jeUo2Un7GeEvz",
-	"5h+xkOdqVcaRuTuHP8TBFTw6SPj1kOfDdbxaD5dxxJK42A6hwSE6KgoKN9mPHbaP40z49SAYyE+97F9N",
-	"253N87hYs5xQ8vOr753xEyUkF1Sw2RFhqdQHIvUsogWVA0D5OHgyKPN4pwiX/d9cdVfsCuStPfdqS/uq",
-	"5u4XiucBwTid7Mv16kei6WNVv3rmyd4Xuu9b2N5tSwQd910d87JamHNrbPuti8vHb2fNKMSDJbV7Suk/",
-	"pPKHq+GIf/xp9y5XUr+utL12lrj3Ltsy7nZ7DM6Krh2+k7WTvTgrBzcNneqyH3qpHUXafouF7loazjkT",
-	"GUfMkRd5uUsnczq3j6O1SL33yFaHbrdHpWC52SNwCVS6RDdfE7X9gTXx8BjPxnvONDjHZIs2ZxLaZ69N",
-	"X4RoMFphsRS4gczl0NDpYcTBHO8nMmlEpStJNvBIVBgb+YhsyqSIs0SJSSHtaxrBRpsndpvOAEcE5Uyc",
-	"ZmUhyQT8T8bjhAMooXu5VHO42R5exaKkyTDL2VXMrueV6+IG/sZ2vfBDMNjEqcYwWMacd6kHdT9lh872",
-	"J+LM8nw43EX+cBuu/LN14Pqcd8l1BHPMZ/caLwTsnfnC8Ky+DrK92MU+VvaD6/DBdfjpbsf6nX489PhX",
-	"Je8/Fw9cpT/svnQ45+9Y+j1fZTlfNHWCxbbwYAIsDKLCtEs9R8Hytcz6+fzb4SmBBqqH1Aa0F7JruIBa",
-	"lkDXcSpXLGRCyj9JMhWcluasagUp0khZaAfv7BH3LTut9SnFNQIAQr5ZoFLAq3OBVlOeA55TKiHu1yPy",
-	"NaoNc8m95iSGCeSg4KXcP0ktxXCWHpy5FQ7QwhPNzV9S7U+TLhO+IvIpXcRJXGwNUULHgRxrDCqGZCzK",
-	"/1DwjEzHZMNFQZL4HUu2ahFH5Cc5setYsADeRLT2fHh2dnY2GsNVEAA7Ck5EvErj5bbiPdCEfOOK5VtS",
-	"ptiydS7TcrPACcOrbRevar08hya7VCvhocnvNSoWuGB9YhZ11NYrIFprx/FnXMS45y9SklPgXIKJQO24",
-	"5JgLRpYMYX8UFxRnJrvPUa9ikZQ91XjnJGdFmad4m2hI4eG0PZy2z/K01X1C0EK1NIGi1XY3Xgviua2h",
-	"2unuI7d48pEhnZ8rbqACgbRBH6V5l/NEqCiFR/GS0HT7uNKhwG6Riq6r2l6k85SnbE42jKa26XUdJwlo",
-	"iAojYhqSbEEyFkYjc94FoZarYE7LgjdbBLM6Dt8Zw019jXBNbZzw3OiR1MZb9sZ2VrjrCtgZOH89IR0Q",
-	"0H0woGbxYn1DANcJaNun3LyK7FZxsxFR61P7KF62vN/pe7nr3SP3snnWMZHjlSp6WXCf3ryPqlzHR3Ve",
-	"Jpmvfvaby/AzEVLYiCIOhZE3lgGtJL/PUtbvXCLfb7b/o9EflHhVoqOyAatG/BGlWc43WbF3B/iZv8mC",
-	"FzRpbfFcPrUUH9UuyCvVuFoR8gh7If/DmsVjX581VujOKfAsZG2QXl4JUSdO8L7yfYGtbuIHX1p7tqSJ",
-	"aOALVAyGTz+DWP8dMbDkETgl51mZZ1ywp1aEjLgYzB/7AjdrOD0d/IixW1Lg28h7OL3NGIwqyJKGIRMC",
-	"I2p3i3w93R5rerP1fIiB/gPEQD+EKD+EKMtjn26VAlJb9Mah+YOFL39m4coPAcR/rgBiPIDtItp75+cx",
-	"m0FTS8PtZcZSmhRbh4TGgV+Z1Mr+cDoaA+eZjsYj8hL8Z1dM8yFoMf43Iym71kriggpDcXFO2HupVacr",
-	"YsahNUjwDglOljQPSMSkMDOXojD3r1APSuI158CXc5YxJV3gmi+JU0auWL6gRbwBq+zNa8Y0GqvOjqsB",
-	"yPmgjRUynINcrFENrCXHN9TGDk8PzP3JEPFg4rE+x/LoDJ5M4W4V/3vYropUrpvbXIbFKVnSK7ymUBdh",
-	"YArNYRkefAJ3GO/5YOt/UlvfE/7bZe4vu6Nh+x8ogUepkqjVvlULtqHbyvUNV7eA+gAfQk373n/GYtCU",
-	"GC56o+ncjovLRYw57fzm2u+7MlYNfuAROqOZzX75sooTMvcEWcZornA0rscE1y4MWVZIwoOl0TlV5Pna",
-	"0EzoZh5VDRvTBj3gL74xwkL+Hf+b5Y+Vgk6F4GGMV+gxFcq9vsz5hgwn47F8azIej8gPVKpztIglyW7R",
-	"FQ8fSBstiiqTCxav9WY+y2MwzqXgySTpo6rH3tOwIGy5lBOD43hF8y1oTiqQcFEWWloamTqBAzrRLgAl",
-	"++Bgxan679rSs4QBTfxP3Rh4CGCmPJcz1Y3lTACfkQbHQhoyUgSHSSmk2DbNaM01Zwm7kjYa3hXcymBw",
-	"r++UfqG8Ay6F/bJmAEgGkV6UeVq7eYmZAZfwssjKQlMKzyW7G5EXSwJjU58LvYHNNgAXZjdi7uo0Zc3V",
-	"ffocTr7icXNl+SF4CcSlvhdA7IWxPZRqXaG4Yp56UFwti7rgPGE0VQe93R9nWRWVV+4Nvv720YF9Oiyb",
-	"tqJlfT5dXBAcUrwpKmhiRb8jdM26Daxa0i5GSYGbuH5OvhIID3pfqNZG5M1zzDJjZ1d5+2hdFJl4cnAQ",
-	"cv5uwfm7EZd6WDwK+eZApaURB2t+fVnwy5CXqfYUXl7HxfqyiN/Bn2i/wXMEYcLNWxcVW1xPo7y6LmUN",
-	"EkwuWh4b/TTk6RXLBaqXqMPexUxRZb1EGQJTX9NilRWXaLc+vhM8YBMEWBMjuy3/4Hcj6ZHux5Ppsab6",
-	"QaB+LMp8wRu/TibjWeNH99zon83j8eHE+mM2OTR/HE7f2f/tvgk/VG8fjo5xTPW/h5PZu8Zv48PxpPmj",
-	"pzWYUfPNyfTY1w820dSJejtTpIUDThT8WacZBAqlRYxX1zV/B/wz1K8OnVcfkwIYGXpCwLCRTA0tB/ye",
-	"XPP8HeJuZc+SuMizly/gDtSkkKqvcENMWCAwR0RM6jP/jl+TDU23DRgjmjjCwRvIYQOTR55lNNwKOrfl",
-	"JYrmBeIgVpJnWUaqxVEbbI6GORdCu52QhcIYRuTvjGVkns4JFWQ+mctBgfknzeGQC8z7aJZnYhmKWpFT",
-	"f/XhVdpa/dg2/LWW1Gu2Veqe13xXaku3+V7Q5J2yxbGvLA7Fl2e25wp/e6kDo3ygZ1R1RWWmAqwRPqgD",
-	"OgFOgyrKiHytjmbC8Ly9+dvL8+EROZeHqnaokcfRNBpa7PYx4l+ThMgPD0fH+Kk+yGkFbZo3mRhaPK9Z",
-	"oaQpmf/upDP7VfD0UueBIx/myr8oUL2XXehciauS5jQtmDawleVYTbqySmNhIVdhAH/5y4tNxvOCpsWT",
-	"v/zFxstb/chT/Ze/yLX7y18ITQQ31xAuz8xyHpWhMs62vMwFS5bgHqD6/oLnbsgD+SUu1ujAjxEb5rP2",
-	"aErKVN22iCJndIMZk+KCiYyGjEilJLFvevEimYZrtSqoj4IaFSi9XdlSFPz3w7xM01h5/gVjmzhdJVty",
-	"MRBFGb67GJhbafJMzj91wcJqyTWgX2HbwFciLSESllLDWZJ4SebLOI3F+lIeYZ4+vRig7nYxmJvcl2kU",
-	"h7Bdtfmw9yFj0oqaV/rrnJgLdEtLMm8WqMzWFUVPYq0Kt6ODNSFwrWGwKjwaTxla7ybswyLYeSNYLrDp",
-	"WUrkSpbZD3x3qQ2PrGDMm3knFmTJaFEiwC1OyV9ZQUcX6QvLmg7gwkLRIgiqDX3HpPnGBNiWPC+M5QnB",
-	"qCyXHEsYmxaS1cDOo4eURZo0RCW1wWM6lwPF22QLDm5MR7DFqigKIMnRRfqN6XKDOL2iOuARgs3lcTTN",
-	"LNG2A7sI53W5jNMVy7M8loaW5qDVGEB68jQupDq/pqlUuZWcWdDwHUujkcu1z6bTw8OT6fhwdnp8dHIy",
-	"G4/HNh/3Pt4hZlsTZcodFwXPPNCRTA78iAgUUQZuKcf97OUL3E35qe1IW5a5sn4ra6Vy/O26Bvq9133u",
-	"UaeK/xYmJFnWbltdUiorAs04DF+JWFJQYRQrwdIiQKdEnIKG+LeX5yNyjvqE/ZbUlyJa0CHA694Ill+x",
-	"fAhP2JU0YiqTKWJXLJEMYbTh/46ThI54vjpg6fDn1ygJf2GLg2cvXxy8rhq5xEYOfpYC41I0Hvy35/Kf",
-	"S5y+EuGP5ZhAxVmwkG9YZd4H1vlBHo8nQTuIKJnLuTwhb7756cfnb+eVDLm9MaiGWOm/4nGnaWv5Egq2",
-	"ySS5lTnrVrV/gYAY5dIi1mfK3AiMEqk1SPJdvJLUa7uhxqNTi3FZbhtQ6XKaRnwDkiRhJOHXja+n1tex",
-	"+mrJQ4AbQbY+m+WBivCLFkJSkuVy0zYM9J6C5ahtxeAtApx2NgcvnGRbC64ljVczt3XBcQ9V0Lp42c8i",
-	"b8A63fvd9ivduvMZomMaoFX3iqEKPaQ6X5hKDYbgZqkjXcWRFDk39nWTZyDT1f1xS/839ogDILgHurs7",
-	"iuBZqkH2daoe1zX1yiT0hBtUbktaoO3pRheoaFSMU3U81TWA+YjMqxgCjaoXDKT9XM5Q4eNjYUlKhRsf",
-	"OTbMuBfhOvi/7DLr5g3PUjxPKQVz0fJ9K6ZYcYtA3yamZZiwUpg3A0sgqismnoo4YrlQ/mepYggnjkHr",
-	"LHKEDhx/Q4UYkdecjEcTdXUF1G59WXPTSck7Gf9fjVaALPVIgC73YSnVvHszlsmejAUiSj2soEzj30q7",
-	"DIUbLQK4GJZGQ/m9XaFizZKM/JSx9NkLW9XSzDUsCF2Ad+lNldCkZlcLumTFdiiV0mGW07CIQyYOdGfD",
-	"ONLiqVKj4cFkeni0E5Cok58bn2x/2AOqkt21ZBqeJKOBmtuAxdbc2Ni+IcUaI+R1HvwvuoO62HaLF8tE",
-	"woC4A5NcGjDSHMNYgxVMV1nrk47QIsd6a4lvhGd2lsiCZxmLbL1Ux62A1aI1trl8UYfpqG/XcUEoSeUJ",
-	"oEq9RRekpKhqxeCB1oyDi3SOhl7VWONCQx3i6jqwhjW+pkIZ0JFsT5m2l8s4ATBsXIWvyzf5Ji4k041K",
-	"zOZOlgld4Q0hxq/iq/i1kA3aqRKdGSvuhrIz8KVRfFRdNT9u+dZ/Uw6GRaAs7oETPWpCPdUMB3XIyFtv",
-	"YZmIvW8pXiMfuX5MvcIVrSJtejHjHfF5tcAp24tn0PTQtO8urGfseeNWxmyhLTaS9qGMbqp8WFG0O5WQ",
-	"lqB/Hz/bVAH8+9zluNH/TWi3zQw0PVSdWdu4O8DL1K3Yv3oW+MKY99rxZnXjfMKvoi33XtMXYdpScufc",
-	"HFQwN/Zp8eb1Y2Tro6p1xzdVe+Y95E2nSpvzqXqj0hSE7VeRh2gZr0rlz6v5pvNSnSuElRkcNLDmkKe/",
-	"2pkNlMMHPEyaZTseniq5GdKGGYLy+KzpFSMLqZdvaKR8mZt4tS5IvMloWFiGYFt9obLXiaqFBDUOrRLq",
-	"FfkHmJBaqymVz7Cz1kprfRW5x+EmS4ZtBVZqRFAvs4I1Vk5OZsfT6empv1iKexVpWmiSjgqZyy6Pjk7G",
-	"Z9FsGS6q/nAloDSJqnBygSxF/jQO9E+Ku2CEnSmEkvOE+QvG4HPFHPGVi4v04iL9jiUJx5DgACoISKvz",
-	"hYIhg5ex4BHd/qdp54MZg+ZrTg0ZLLBisUTsTEpdLMbyQVdcKWsTuHBDlOSTM9NkI1oJdmRqntuRS/LR",
-	"dAJ96Touq5yX2eAJbLNb1qXOKq3iLkr93Y34lSr6JV92W3d/Mxcwc/X+3OpXEO05A79AGjlImwvo4mJA",
-	"HoGvJ2XV8V/Hq7U893UxnGmH5+MReaWMvpCmYDpp35o2xPC+h0XY6jydB84YFbTVNdNDmkaYrcSeBERN",
-	"pXOjUQpFUunWMuL/v//n/7Xa12a4o33P07m6mfqRF+zJX/5C/spCWmoXSsXkqmstvMCuxhKQGHE5v5Vx",
-	"+C7ZgvlZbhjabOhA+K3kBUXXTEhztiylKSrnwFJR5tZ1NjBKpGe4uxd4ZYehi85NDKwA6PA1B/r+LgMW",
-	"rvluf/HzcM2BsVshiHClpdCI+mLAYm79fJoPOPbP9UL8Dww7/dvL85tDT92wp1gowJ5sCgxJG7j3n1cx",
-	"u366yBh0ghenKoGGPDBqWOIBz7onnvUifQa1hJUqhrgBk+Zv/vvF4Hg8PZ5JGS07/zBHfzjcFaGsK8fj",
-	"w/D/sDTiS7kd/wd+0Jf3sOlYMMss9F2iaJ2buDRMyoi1YV0VDtVyKFueawdGCxnIrplKThauuZB2mvL+",
-	"fAsLrBYrXtoNxoIcB+7dpvaDV3cUa0aOvelQzu3vlCFk3TjrfuZWIr8s0Yc+kAzWSdJTwtWrGd3/mMwJ",
-	"S5hJUaacy2AqG5ir9jipAytlpTu7mow83ldE1jG8WvmaBfcF6PVheSVhAibWhEoqMZwlpXDVA6WCITbj",
-	"c4TxVt702d6bsS+MtbKYNJRoEAwiehWnYTwcj6eDYLCgiwVdMfjrFhjOL7ae712AOi393AvkVGkr/hj6",
-	"9gMA9I8HAEUCdYFpLWrCwMf48ftH4rFD//a5WPI8MNn44dIez1lQ5UTGH4T1ixbuPK/9hn/iQlew6DbF",
-	"Rgcs8hAyaRLB5AIW4Bd1fIOCMUGiEi9HcxqnMEDBpdZAjeWHcDFLh3ejFys4pYDYSKlPgUrLVjGCHyGD",
-	"qyQXPSK/fmWHTupNcS4jwR8aAwBCZfLpgFbduI26A912Ar6ZTCfTgBxOTgMyPT4JyOTwcCr/9213Truu",
-	"YA2n/fYOnB5u2NVORJkXA/llIR3/LFjHe0U0ErxxVhfrICaqSGVVkBURddYFcf9T3c5qq6PQIxe1dQ6s",
-	"I4R+6MFb7w3SPcArrVBIBTAE35lGW2Y5X+VMCAh5BBxm8YCo/BSISlEul3HLvTo+U4Ya3zBB6LKAeju2",
-	"I39J4lQwgOFJqlX2Wh3aVasVsFQZUzy2SV3BHGiRtDuRzAM69COhQx8wdg8Yu88OY6fMlw6E3d7oOg+w",
-	"zmjyz16+wGjMJ7CBFudX5zfl6dD8YL7HQUmNjSraBk1NrGnGyCNMiVwhNXRo62NfGFErRu/cRj55wkwb",
-	"0WoVPgSjTasMmw/QPBuaJ4/wnaLzujFzNZhcJyyuG9bWDU2TcvuSL5eCFTvsqCYw/R1LHWh6/WNLbPi+",
-	"9X7TanU2gPDmyx23c41RdKT+br6hat/tyj3qB6iZ4Qb1Wnb3jU67T2DaXWHS7guKdoFEbUONanGSlw9Y",
-	"tE+JRQPcmbk1rPBoWppr4XZzLNomS4blb++ukv+1/dffTxZ/+1f+6rv/NWb/TH6JT7zgtAbFeMBpx6dn",
-	"Ryenhye7wGlepBmiqCwgmezRRolpP5zkHYjLBjySBS1rYNQ6EGItGDEdBK1wZvKfPbBix91YsZNWqNhk",
-	"6kDFErai4VbLIxsp1gESe75ZMChXd8PszfGGpaI972+lFlRvWqYGeG3RxGN6IMb1Js/ViPzkmrlxilHb",
-	"Q/P+8BB9dwmAsPCWSrnFrHsTD0gpDbn83knOoD1Hy4TTwuuS15kZuO0atAYfV4VLGBbTnUNjEGb+Zo71",
-	"c+eVNyLbZjG4VrKcy705yLb4zoFT01cPCJ+5Mej6mUeVycrCBw+AG36FGIGxe+8QmvcDUrFUX1iFDzG2",
-	"DxMXx+kqMbpegNgJmjYuI9qvHsi50ZkBYFe/dKbv3ZxTWn4i5390Ojmb2o/qxEIjOhyPp/PHgQUqpClh",
-	"m6zYVncn0tRMt2qIGug3HR+d2nTMc5KAx+1T33gDYcLtJVnk/DolS/6e/FpupG3Ar/BOjiT031sS8dWg",
-	"9QbE468qlLFNC2NMmJxoCHEySzvadf+hShgq8txd1xOr5NXopvdQdl3QvPmqNsSvdnhy5e631MRELdNz",
-	"49IxIVPE6QaLe+ProfuaDJ5d7bJHvN0tpnfft1M3X4aOdKJ7gUj8XEkZNLZsG4oNTRLfg4TmK/anhJbY",
-	"juyW1epAn/xZnXmoDLT78ixNsHLl1bQ9b9UE2zdmKULtdVJ7RdaZ4fis+Q5r2M62b1nG9cJzDuu5SyNZ",
-	"rsTFwFbd5C9ee7j0Vxk6h8LaWBe6GRzZWl9oR+kfVxu3y/So7blFDSCTF7SzA2vke1b82VHdp/a1sWo1",
-	"5QPZ6uVuPwC3qwnkXxbZpqaYR1LblK8CjQKkB9CpCaeRxgJrW2SwiFOab320qSoHtQXuFiyVarx6yxRq",
-	"V71A/+AV+VkwNGbZsChTdjEACnvzrfohTldtlWzMC5hBzq1ghK2YygYtgqT6Att4o2JU2+SOevpY+bVp",
-	"kvBrSVxyDa/s4sPKOvPNGlLzqnKTcpDWRFyfsVmTQWBNbXfJPqCCan+6CC1l59Dxf/FFa2zWepuxvAKk",
-	"+Pe79pIbmWrNkPzKF02WsaBFuL4U8b9rudMgGXvQWjtMGy/S5AMcJrQzIs8I6CQ5/k1kuyZvPC10OIEZ",
-	"7EVKc7lHESY8gaJUCOCD9DSLstBx2njTm8fUoD8qC8ZUWm5NIF/dyh7Pup0Cbz8Eg0QK6ThdXUpRcamM",
-	"3FirDJ0r9DqkcB+7pKHUAPQ26BaJbFGuEigpLHcfGLQ6lg6SCvIVj6OLVGpFyxhQpPvP3QRA/KCnjd4h",
-	"+/qz5tCXi5BesoyHa9Fj0q5cwc8A5pRr8A7uO6b+SfENREPBezxlZFkmCQm3YcIu0mKd83KFXlmNFQTM",
-	"imDFLfb+eLxr6333FHvp9Dbiu44Gd1Pe9lDa/apMwc2hthR4jG3RSQ2LNbtI31QeM1ehVxqnxRoOrte0",
-	"GOJbw5CmwwUbmk6ihuK5R/LeNiTMM+NfWqrgjIld2Ms1GU2kElaMNwNTKyLXCOSZE41CyRw7hxiRi0FY",
-	"ioJvcJJDLPRBrsHJqJN+Uqs9VVNvWTxxJvsE/TdPGo09OcmOkp9fsWTeqNd0hGSn/5z0wdwoor9s1yrQ",
-	"opOmmyPgFKwIbHDhHh6VrpWRN/gJ2VGq7gBfQ0tsza/BaMQvaaVD/EtuiTqbxkuGItjkEPuv1z/9+D1+",
-	"Qp4ZlUoy+C0v8SPVsNrgxIoR1lrM3Oz73MwETFZbxAFpt9M5zgUwQQrdXSdt2feQLsLJ9NCneClFI+bp",
-	"bbemaqnanBdgP5sEawXegyWqJLR8za4DbWyZqqmLdMOKPA6hGlfMIwTCati1re2Q8zUTUjTj6ypiSFre",
-	"4JuRPNhVHjQuSG38uYZYwKiUt165UpXFLFUDxHCAGFAF6fSksfbkTSjoX583zew43C2WuXvi2/XGFxu6",
-	"Ys+juGjVGeNNq0UJj8DfH8XFiOhMuFSlVH75498UuYEiBrHsRz/8FV3h4reS5gyQpRsq3mm0swaJBKpx",
-	"2Bi4DS1ymoqMSoay1UayZuiIxlOYGSrejfqZPfJVb6I+u7AiDON6LbcfQ3SqgRSE5owK8oiNViOFg6NJ",
-	"toZj9W+W88cmdbF6Oofm5prAFwyWjkV7Lh4uiDky1fUBFbqLvkuwjzYS0SQZsmFr8JlW6sx7QSu0AB2G",
-	"cBRwhauQGXU/N9etuKXPCS1U5sh4w1wfr9Vt/dDcPHLM1UVhrE7kWLVzGo2q4pHH7Rn4x/vHX1UxP67W",
-	"AzdunnK2EROSJeCAH6GV66sNORmPx3ZxSGdBn5GwLBhZ0MWWCEYJLwqWk2sV/k7JguXMe0noTVKvqaPM",
-	"k65b0FhXf3CrVKuVl+adBvdXS69zb5d5gqm3F7Ojy18FT+cj8vOr71UBW0lVeLgk2c3GZBOnZWEA04Xh",
-	"aGsqEHxRRVVbtjyOX/fgXpvis536WNM8noynR+/l//jvzKTZq3a2viTNVZgez95Pj2fzgMyPJ9P3x5Op",
-	"Kn5pOnFSPqnXB8FAvT0IrOE407NHuXOSfzanuDqkgZKYO2Ruq7y9GUcO9H8e3jNz9nHcw8+F40L+AC04",
-	"DucqH/E8fTpxhciXyJoRelAJRTnlo45XDuc9mLmPef9W0iSuxfgOAKtG88hLNeoLPUGlFtoWd8VIyXwd",
-	"zRXMUejdBUVb6shVESCo+6WyIAGOXxQYhYs1cUw/yn0LLsC2EBZ3RQyM18xoHblsznr0INq+NNFWOyfN",
-	"NqpXAzKfnJxN9R9VOydn03mNdDQKrLfgDAambfP7ydn0FgJVFNuktrZX8VUctcBstskeCwsNIYEp/P58",
-	"RP4hfySQ+qBWqjZhNCUFv6Z5JOxQAbg7GOaMJiiXcwrJgky3P6rYAF+b2m0GprEahLJ+rGYTzt8BGES1",
-	"eMPTrxdO9ePuinn4oOJ4VZwdqs0/aB7TzhyBfXwKUgOhGqAt4gqVd6WbB9l5E6fDg2n8J1TUHgT3g036",
-	"p2PYu0xRhZG4GUSlNVc6BghgCJ2+a1Rx9O5V1uH0ZHZav81qbJpk55dx5N4cv2lcb1YZ2t98230T9Zi8",
-	"+EY0i9Uppyzs1zm4a9U1BjXWGV+SyRjvGggtCog4xABCkyjgZ7xsB2kFJXPw5i9nRR6zK5qoLE0hj9il",
-	"ZE95ljMIUTSp1mgYMoEWEAgCuNnwoHB9iOLJ2INsYwX1w+xeM1ivyYy8Y9shJqbLaKzvS/X07YnqeA+l",
-	"eYUmEEpPWhQc3YOWD72RVamoQG+I8YekAmWOOtuGFiPyd7YV3g2YHdkmb8JViUIVtu98gR8cT6b1L26X",
-	"JTHnbVd18okmeck7ii2uZKwi+0yGKk0tpnaSkoDyaHtEoGbzwhtgWjv0MLygM/m/Ov08UopFu6bmD/eo",
-	"Aip0yEeYUCHi5XbQIxnSC3KNWTLJuxjzQG5ulhGpZ0OeDCn7I6s3ZrGGCS3kYgWNBwKKGe/SAVubq63x",
-	"Na/qZ5q3hS6mKtUHA4x+ooJSGmNR3Mbf5dykbVSDk4TX9m7tyo2WBTeJYEmZrXK4mcbQEKl/In/AXHYC",
-	"7qFhxIhpxYKqUqpCsk4ahiUClgDPS9TFteR+bfMKyDXDwZj6YdEVTUMG18ZxKJXOJddgMCcz3Ig8g/7C",
-	"rSnY6Vs4BZ4SSbxaywOKmDEwKKooIO+aNvHkTRrpULzrMnwHyNo+xT0SJkB+tFV8xVI8u3iMpRHNJalh",
-	"edY1zTfLMmnC++KWcOf2IORq6h607r7ByHXItdM4AApGLU47+ayzqkvVkkr+0JFYIaQFW/E87i69BCHY",
-	"5k20QN2MhjmDxAMreXBySbfNBZdyS4iNV8/6WleggSQU7+UWC9lRnIZxwTBMQprsvICQYtmQPAgJTVcl",
-	"WtnowIGM9DRfMXtrrPRD1RgOijXQXCoXtjGe78x7VXEcOTRVIBkSCAtyFfMEcihCEEce8xIGt9ljOAW7",
-	"9WKAK1ylmcxpyAJJWJHU7lmxTuMwLrYByVkSr6CmXkpRl4GfBXtf0oTIbU0LikX3oljo/DOioEWJHYZU",
-	"SDv4O1qAfqRXhcYbNNdTng6znEtLgUUECjMoOEFAwrXUArOEblkuHssTWu1D+8Ls2iF3IDfZHkBRwvbo",
-	"IX+8lfROW7BkOZRD3EEUevcxMLXMpaUKbUcsi8NCEBpioiLToEr5R6U6FodxxAISlgCbxdOsNLooFjyP",
-	"1PV5x/gOdPYsf3CzS8FmiCRjuVSKQam+7QgDolNpShEgiD0iCKCNrqTs5KlG6IV8s4kL1UtY9Jhi0cmr",
-	"qmxRImP0Hcurs2osMuSMLF3RlQoZxhgEgBrJX6Fy2L3tliTJ9glsmFI5ac6lgqJImL2XbGYDhYj1MNRt",
-	"n30BqN6WZv4VnAC9HYY1qTcEy68gD9AjwFtjoXf2nrCoDJUlJcUJS5KUCfG4ay4HmzjlPrT/a+zKYQaG",
-	"D9AUwEtXcSTfuV5zwArKg00mp2TLaC4ITyJ/x5qJ7CByffAiRot1YFgP8ur1VkjtksTpr2W+7e7nYJXT",
-	"bB2Hd9efpDDVqLqT9I2gpqqBZPLwYVuEDlrlqc3JPEeqlZEYmq1vuLUPnqXyaZRKXdleipDn+2g3hIIh",
-	"rhGTcU6wBXkMspxFcVhYZS73U3PA2xhi4r3c7ndLvqq++8ranyqRUF/VpV8fdhtt/RVs39YL1t7WbUbt",
-	"fu3vo0N2djVuPtvR6g6J16sLp43d/RV701D967Y+/HKhu2X5TVd7rbx5d7PqU3/r7Qy4q2H9VXeb7cy2",
-	"T9v6a18ffzR2qoy75kLpxLvS1FG8dMESfu1w1Mo67CF6dFeBbZw2GfrbPrnVGhmgNKpc29E3Tve04VE+",
-	"/Kf8P5N6ycrNVHeVjMdV5UDVtT9Dk5q8fAieXKvIn1kMpzog1CKEzZU/4+2G/UySXNsTTWz+54ao2h5b",
-	"FNXet03I/rfq9LdjNIrqd79VHYRd86+P0Vl5e4iNhx+aG6QJtGOXJqPp9HQ6Ppmw4Xjm3a3xaDwZz85m",
-	"0+NZx56NR9Oz06Pp0fFJ+8ZNRsfTw9nZ9JgNx6fdG3g8Opkezaaz08arvo0cj8bj2Xh2MjucHe3cz6PR",
-	"0eHxeHLUmLBvW09H47PTo6MJG07GPXd3Ojo9OjudHR+z4WTSc5fHo9nh+Ph4Ojtu3evx6OxsPJmcnlaD",
-	"/mCnMdPJxax0Yg3vm5VO7FWZ3ux+snr1slsNeZZlLI2Ee2Vl2cXqnlBaoBriaD82aRTKVHm9MapK34ht",
-	"oLacdkEv2JpexTyXNjYlgGsqUwVxkeozLwvwoucx2Hwc5ITdX68s2ybI/LLNY1ulcHljXt4dWa/AKdIQ",
-	"f88AUAqIEzl1f7awrnX/CaepgGBv7Jd3jeQAEaQmKcBjPRnzyu22otciP1ys3vHFasclgEWukPCnK5uQ",
-	"yYOhrgwapEpeLBEwVkIKB5OZGAv/xgq3rE6hndvcKr5oggMtinuxJCkvgr4fOPFro34Q0KqwQ63OyVx+",
-	"Mg9MqVyqKxzwpSrEgLS3ppLbmdI5a0ZelSk4zRqVGwJTHQFSwuuUtfJ9lsKWU/1GAr5aFTLZWkWhZ7kD",
-	"wE20swuV+F2X4a2WU2eeQoas9/q2bMDcAVX32l1JhgxLOpcj/JpHDO6S+3/ySiNF9vzuW5WBtjujmJWn",
-	"rHUr/JaAI1LaryNfZ4yF65tJ7A60gcYZVCWbyijmmALCHz9xND6b1ULbnCj6s9ltQZ9FIYYTKfbkv8N1",
-	"1CcJw08mo4KV1uzN+fnrWlIFlb+sKMTjJ2QOPSCMUHc231USrxPwuMkOd6QixfWN0xF5beOpN7RA03S+",
-	"yQ7nAZnzrBTyX0pD+c8ywX+v6dUc3e7zLNw44D7sW343CAaUhgMwlOU/1/RqEAyycOPP9ZyZGk9dkFR4",
-	"rYlMhPmMyGtMbEHturnz8Wh6DLVX50ej8XxE5pPReG5qkWFvI7so0pGd7mQ0PfZ5S3jc5n6BR1qVArZq",
-	"Z9tfMzNWs/BXWBMA1p0mCd/KJWbhmsOSK0DEnKfb9/LflF9RvfhiHW82LJ+PyMucXcXs2pTisNqsKFHl",
-	"V3lzro6bgNPsjWkHa73gQ3zlAJob8kxVtrH2GwY8UCW8g8FS4R/kaKU44Fd0EAzUOHejm9zcc3qd2/nR",
-	"ubRfomdpdHM74kvSpW2S1cXONMDxQUV+UJEfVOQ/hooMXG1nen+LA2re96Bf316//iiKtLtt+4ksnd2w",
-	"6wL3zaZfgkSsDkhz5JxIeFgJo2/eVW+swYcHoPo9C4sP7aSV09Qs713nJ1WGWXeW0kKNYCGFSWrlmRPa",
-	"BhFPiLQKArLJDuX/HMn/YSv5vysakM0RDQhfrQJyTa8AwHHNFpt+GU89CwbT+RAMNDayJSWxRk4aN3BW",
-	"Fra2nhimh4/MB3FK3rx4/dNwdng2nFR5/Fk6uo7fxRmLYiyGKf86+D4WxSVfXr54/dMlfHAZ8kieRGWd",
-	"gUyMN1ImM4WdVvWpEwpR8i0lYfYybq/XsZC8enKbfOAYrmiampNHJrtxxq9ZjpgQXuaES4kreJmHjPyC",
-	"75N/TLE5AD+GJlLCWCt1qHU15E7DuDVlQ0rQfKFJ5W4oHe3mK6EDq7FIWJyWDEqbsSsASiLtC7YCkCY4",
-	"Jt5gd/WoLzCapPkkezrAdyA7mIpC2kC+U2MMGkpq2dpOY/9XrHXVau3r2oyGK6gCKs2jqcy7J2QOkYwB",
-	"ouDlvyKHf65YvuCCXarHPCfzq8KA4hVpqfFAoa1gIHIw46wP5Z+FP791W/XQsdcT4CkeWq8aOvkMqoaq",
-	"8rqS3sZBvUa5VLjeJHxll7jcyUD46tJ6/TH6c+yADVUxX+l6Vm3VMi3ihIQsV4WScybWPInQT7COC4f+",
-	"rIJtutLZ5SqnaZnQPJaS4s1bN2hvoI7GwJuctCqX5jQCyQF4VkrmVumehS3DRmReOwFzk/pPrqxLl8by",
-	"9vc3Is+xyg7PMeFgnfxhLUyA1hMyv+Z5pKhdTXCuq05iICFkt7M1DcWoURHBT6rhCMxUbDmFZAfWc7l9",
-	"ZS48DeL2VLWlNTPnkM3EWv0dMVL+PNQoQN721StwQ/7LW3zSKeHp7GVVhdNU8da4waBCmqv08miUgrBt",
-	"ggp1SUAPpRn1Q9VD3hlJ668KuAv3UpUOe7GhqzjF83YdJxETBYkjRlGB3fLyqysmbcqcrGlV6f2rnEnB",
-	"h7IFFNI1vwaOAsXgREgTrNvLN6xY67o6X8k1nYzHgfwnGI/HSDpkEa9WLK8sNkqyhIY6N+FWpf5dISeK",
-	"OLQ1uhjo+3rA+kPO5ijm7v29u4GNK3wvXfwDj2QP8lCHl/wKpUrvh1YiVffPTy/6qU/x84njm6uRvtbU",
-	"sfUiuPFJXYRrugY6Qjwu5KmXiwWwAp16tK8J5+yg6tVb+vM2Ry4APuWZ5vP3BRhFETBC0TqrikPebGK/",
-	"SDa5ixeavQ0qogluyh+oeKewb2Z5DORNd4QvsHSVxGJtnuq+EftzdDIej8fT2cl4eno6Pgvq7Occ/DA0",
-	"XEOVUarkaU5Exgv0y6x5QUS52bCcRHQ7Ii8ZzxJGpJJOxHW82WAJJlSGQkZTKaTiBAMTaBqFVBSJDnPL",
-	"EgqWEHZ5xZOEbRc0SUZm+Jqm/YA+xAva1RMFY+8avxU0V5Au+2eWwteHo8PJmfy/w8Pp0fTk7DTwlXQk",
-	"e6+MU+mxqpz4poJDHY8PZ0cBOToaB+Tk+PAoIIdnY1V26vDk6DAgR+PxaUAOp1P16/RwdhqQo+lsFpCT",
-	"01lAJocBOR4fH451q2+d0Rt9rTl7erXSxXflw+F4ND2djU9OZ+Pp+OT4+GRmrwNUU2dCxDy9BHJSQLvD",
-	"mfz/o7PD2en0dDaxvkj5Jdoul7qH8Wg8Pjs9Pjs5Ozo5Hp+Oz2YnCu2lPhuNRg7u65ZyJKGfyGuhOv/M",
-	"PBYPRv2XY9QvwBH0HDn5l2zJP9jlX4RdfgsrLqE+G85vX93EcurqrWYZfD6KuiK2ohoyeaQyWsyVfjZ/",
-	"fBcqfALXoZ+jBl+NbLfNvI+m/CEYfMMSZkF6sXZaW0YLfNncUMINstwPzUXcm0u1iCoz4KIsSMQZVhyI",
-	"oCG8Nt+ZN0pfBRWCJUuPEQltRdaZsK5s48ibu6mqC2jwMua2HApU6EZ3ImPcYu3Nz1pXuqM64x1P6N7m",
-	"UieW+5hGrZTGHY0coBr3NfS7Haq+kb7fZcYb5vsglar+Z6e/ySoiTK4Y1F2zvUvVQ5ZGGY9TJXvdtWDt",
-	"fZ3btUJVD3bZT3NDD0XYMS0DwSLtpqS6rioesYyhPFB+LpVjh0Wmlvw2w3x2GhrLl3pW+LHQn2o4DvSP",
-	"dfGBK1Zj9cEAq/KsIPkqEIeRSsa4qRWVt24P6nWh66YJwN8i9r4tE1nE3pu0a2a0avzNOrL+gqS3KNBq",
-	"mnartFY0sJuIYXYWHfu+7elUwteU16gamXK8WL8Yp4U04aeH49nR9FiHdQ3BrD+cnkzPppUdPyKPJseH",
-	"M02ZWKF1CXlDoNr0Y+vj6enp0XQ6xa/fqt5hnuA18ESBVVtnWf5OZUv/7kBZpktViepXvpjr/cptL3Kt",
-	"dKWGeqm0qhhPJLWEqlbgs5cvfEdbvXpJW4jl5zR+b90tPYpTIljI0whv8CuUWH1E11TocfhJlOU59+Qv",
-	"/dYtPSrbMki2K7k8NE5YNA/w4gysF1U3DC0gG/aieAEk6NZHSn5fYt7kOhKlnhE0Yj7I0YaGazk+ydgB",
-	"ZgwTIfJ1fzIwhAr5mlqXG5rWG7KyizZr29KctmQ3MHVDVbECKkicQjbegJSiBINs7lTSQgh+rWrbXN2o",
-	"LGOWRAawKFeKxM4CQg9Q5Up3PBQZC+NlHI72rvQFa10tlZ6oNwxdHQ8WXfasct2oiaizWC6YJDBNpCBW",
-	"EI3lnXaNvmNBRCHfy8s0VXWyd+I5l9IaX9/XcdOt3+NUrPN79/V3yR2VoGswuU9WrpXsqNZ6AYO4GJCI",
-	"hSZ2lGdFvHGKhathOHeAdspq3aDy8ZjQC9XChqYllpS8Nlf9kK1BPXczmh+PVX+je60lax9/sz++A98W",
-	"p6DNV5Ol0c5lvWDE2LtG+Xv28oVRc8W+iRvl4nv5R8Ve7rpUfk0TcPWx2kMvDILnK5rG/0bu3rqO1ks4",
-	"NX6dirYC2S3pKEF2iLbs2ZtMymynTCZ58c0jxdO8rEDX7lWpppmyB7ABA60HJ4eQG9tVq1W3MVTJwVC5",
-	"r3AlfQuc1r1LmNGvZdJ4GaCy/tVFkZpmjWIZInWMSFZyGiLSfitZCWrPXDFp8G6XYchYhL8bxUhK9ZCm",
-	"IUvk306hkFrDg2CA7Q6CgWp2EAxMqxDfJBuF3CuqQT/eRrI2Fl3iDWKLxw3064qpLWKUMAQ/IlnOQyYE",
-	"2qWqvGuNKD6GWOtRXljRryXM1DctZOsw/rsh3psV320MvPqqZehWUdo7PXx7qoeVkaLtBleX8qiFTQUl",
-	"cPP/GAO0ziVrPM2c8waZ14mluQvyrMQF8BbX9LuNGdwQC4Gbl2hZ/MoXio35MhNZldfN42qF4dJ8djad",
-	"zSbjyZF6bK219XxyNq6eO6uvB/LE6uvJZjvk+UqVB7/E+uNPTn473WTvN1szktpuYEs8Xw3t2dgb5OAV",
-	"LmwefjGwrXXcRWzPsDjTYm3n5GuSRjX2xN5nvQtWP+q1GsU5+X8ujJYD2XDgiw9284auIBHPyezU41So",
-	"s7g218LzK2/iuG9rn0PYFzEk2OUZaDLKFh9owq5QhdJCRxrkEA6dp+b0vu22k3v5r51DMIKp7OtfdfgK",
-	"Drwax9s7PKM4PM9Jhd8dcm2exZOT2WQ8G081kkqOE7+XS1udcBw3PvlaeYBqBFPhHzuIyqEKIC0VLPaT",
-	"2YW6q9wisqaXo5Y19lqXKlmqZuH6KkChBKLfwmiEa851XDkUi1aJfClWSDBteGUiznGne0APA4NIZdNO",
-	"DevhvwPybPi/AzIengUaViGNQcgfqzODphGJqFjLiaiYyFoSB4ihanfqGBu669pTb8TL6ouGKSXn7DOj",
-	"PN+2wo1QJnf4mISzcgKqvGSFCNReL+zS9OQ1jN5EsBkjvzUOv6oRdqC7GMptMda+OnoKnweN2T0ZFaSC",
-	"MPwqeDrEZQQEA+5dQeG6YWg9PcAeIh6WG53G2wqf03FyF+lF+tMmRlN7Xq3LnERMnifw0WrCQoJICdtk",
-	"xdZy1CUx1g/ojoj7EADeursQghxbmSdEZ6qsChbR1K28Vh0yVerp2csXTY+Jqb7VagvPjob6/gbW3l89",
-	"K5DKeTOcIRZ2CTG/WXkVCxZdtkGhzhEGvcmKyt/prapQDaMAZDgAI9MtgQ7UsS9MY96xlHmLT+DnV9/v",
-	"P2+oofZIuaEe+4EH+wmeMlfyYDQaWSqSvYDWc48EQAKxOD4QnGi/HFUiyq8Y6KjXXkgOJO1dMGXdn2r8",
-	"7Ydg8H0sCgde0THcvUbkNPpTS2JRaXDkQifR6O1BWFNxueEIKzMfKXBn85Y5oR09HEFFuS5NyXwi+cxO",
-	"fEt16SwXS7tHrHlW47Hm0diJO9+FfXeAClFc3usO6B7uewd2rPxt1FM5ngp8TwvahVy/sNfUAYzbTRpc",
-	"jPNGw648PTudnhzObBA23WillcN96XlZ8NxpxeK8jmGGTy2Lc5UVwyPn03qa0IvBv3T1Jih4uCwTC1oV",
-	"MRGvUpQigKvcQL1PKaxpQUIY33/UMPM8QRPUBrXrKn+NBzorAJiGH1xoecfCHx3P7mThJ6fehf9hS555",
-	"W/nTL/zJ6dldLPzs6NCz8LXlvMPFrn17F2tlu1I0Z2rjDheaYbUt5oXhYyYxcz2gIlyDVa60FCljKnIR",
-	"VaicpbTId+5SEUD9+FsVmlCXPk2XBDD5t/txeZ+lhvOoe3PualYeP9FHn53KnnKXm2U1+aCz9dPZ1JLd",
-	"8Q7su/obsbpfda27g4+lrek1h4Rld7XicJPx0U/vS7qKUynjHFZyL/zJNzmbJJokcDdT79Kz1Sq8KtPX",
-	"BcvuatqquX1PjyhYdr/HR/fwia2datXvcMX3Xe28TO93sVUHn5ll+SEYKOauCpCB08YStR7PpPLAisr/",
-	"uDsgRcF0bOeljYaspVeWjZq77mZkbCvepU9BdRNxtVH5ruzS6mp8u0OG9DDa69Q0LktU/FU1OQe/Yc15",
-	"J0ODp0H9E3UZDRtI1KX1js0+Z++LZ2nK0Rcu5Op9HeMfbdv/jITqDfB919YPSwQCCAvLzWvcKPmt5IVK",
-	"Y2z9KnvckVhTl+fVlcj/ZryxBjBZvVwKBbS7GJhC9hcDSBIJ6UcYzcN1Vam+FgqRRpcGvV+lTfYhSWD7",
-	"9ULsSaQVCbrLAOdDr20sYK28PmtYypaiVu5yx6mJJutP0roDH2lDJoO+i9QRoYcFnX1HD0koZSwS6tYu",
-	"Z5D9JeqomN521pxtmrsQO3sD+544lQnM/dhdlcAiIwciklS7e6OD+ZIW6/ZD+fOr7y3AXcJ0fp3VjtOC",
-	"V2zzkEfsUm5dnuWsYPncHJkqj70ho9udmowW6xufGDM1uOsxk7sdv/4SiVquYpOgYW1vQszwYX9CVq/3",
-	"IOKfOiCysGBuUVdBMprvUg/0FtSyn1fHxdET+2Xr3Vcu7krJu89x7qqDUVdeASLZElJOC5Wla0PfMUHK",
-	"TAXn9wmBxnYDZxX3120gNYNNlbUY6h4EaZHaORJoG5V1KalV9mCQ9W7GXTJXpDUf3V/MlOYAwLF2Bky1",
-	"cb6eCPge6HccTp/KAOrVndmWNdanh/LvbMDdQunnKgxXc4uGYu15fissmbWSFq3+YG232AUBXcC/CAhp",
-	"LUHpAyHaVxSeebVDPk8n45OZyo90YU1BVbtUf/+v7/mL4q+L3663z/7r+b+T8+3R9uzdTz/8YNpVUtQz",
-	"QF+tPPsEWL5815nYnVFPt6FMDUre4LT95IbPEIW8T2mMF0tCsyyJQ8l6MYHKDStlyDNBy2LNc9CsYmFL",
-	"sZ0hZFKOQFT43bEf4Dy62X4oeSWR2wI+jAFvdyP3Rooo+F1lAzngORrZN8me3+2U2F/63kDU3rko2CkF",
-	"9K2dm4u2ke/KSo2/3O3vEBWnrjR/lecJ0mT9XKWax2IKkIPImM+Aw6vbB1U6exqGTAhlUpNndl75yVhJ",
-	"X1/ae/tgGNpoii1TvWAybu7QvUvNONVn526pYEPzd4ijrHrodzitEamQSE99jBQ8c+ZN3XWgoygV6PF6",
-	"vXUP8a7huDw1Z7QVRYjPulvXAlqxlMtlnBQsx+pVVRhGXqaXVYAS/s3eZyCo8S8Vx7RTpqvx+rTah4IO",
-	"d1z9567UuQ5NzhtokPO2+CjJEYqtclDmPCpD5fswjkVV8W5eCpZj/Lzhl84w5POBVbnWP5AyvYGqkZep",
-	"n5vnZSoe+x2loG1ALorl/hpHV5ijG95oeIg3rDFOL7Ocr3ImIKKxOug6ZlGrIK7Pu/pqYLO2gaUK+WMS",
-	"gRLarwH6KIly3ZVkrBYNqtunK+E3U/obCdUArRAzD++u6Xx1iaMIutLJagWTDZ1ZuoPFzWxb2uLEZsi3",
-	"N1KqC/geNkqHeXJ2eng8PtQhU3rx7Ebq3ciF8WO1LvRq+YGPctJVGX8Xd6ay7TqF3YFr4gffxf9BvuPX",
-	"QPwvAOkGycgLHtHtf1otQXrbypGCICxviXHXrrLhWhfOTrejsZAA8Hl1h2lFDrl4r1YrzTbQ/JHy36j4",
-	"D7j3UwEGGMzDl0uW66TulsCz2JQ3EsGCmu+nWFVKFea5vKl7BT+/0zQDt8gJoGCATgnSWgpMq5/rlEWX",
-	"i+3egf/Q5A2Z28Dq13Z+qLDbbpCyptJ/PHuFkaRAtx6uodbBZRbIKU5nZ4fHYxMvpwejIkkzltLY74tA",
-	"OnVoPF5urcyCN8nS3BkcBy5ZNzyuUdTSVw7Y1cVQDbPqAR9Ppr2S0exrSX7bx5K09VwQm+5scuZVR6dj",
-	"jxe2thYYb05zSbqRTs2s0olKAoBYQopXmlSEOpecfFeVgDSOVp0POdk2OoTZOlk1xZpfkzKzc7BVVSMX",
-	"TGXdjPDi2h2zW8Gkw3Sd+kzXziKpoH5hTVT7RZ8lXwppd/tJ6XB6MjvtIiZ44aE66iesjtqaDL13lnOd",
-	"26FUyZjfAJ7aLdLtq6x6IGn9MUg0QEYwQhMpyqVKk1uVlvFtUOPlS/Ihlm2FoqpXMbuulQLXP6toy2oS",
-	"2paAXPK9Y3h3Mszp8ayLxqfHsx4UbpUa7cEt4XKcpbJFk7SpFyucTE+Vky1jufMJ/Kg+kT1sMyY89/IB",
-	"mRvPHGSGUYGoys5aZQWOeP5lVizd8dk/3e/+9vL8Ncy2Xup0Mj31RB02LxJBCajV+9y3gOkDZ7znUqC4",
-	"Szeuiv6wQx9ph25XB/hhk+55k6yQJ39y2m8xb6gnI63OmFBLRVtmCacRLjq27kk2sC3acsfZWQ4x332c",
-	"Enjfb8TfYTrbpOdlXM8sI354ZbvbAQbweXgd5g28RAtAIhhkZZ5xwdpSWxcslbSg3nLWBkoOQw1KfQSk",
-	"oTc32RVBkdF/DFUyMvljdbc+x3wg1i+XWORiXk+cCI2orGFOg7bz1P1DNeWdte0hz3IWosPKl0XlG/Mc",
-	"NdW2NIFJmxNdnyc5c5MxTyl2kFvJvYZQb+ORw5c7kzDhONxrw/4z+hZ0ebw+5CncHbqpqk0mPKBuvJSz",
-	"cswFYD0A2BTnotIQ87SZFntf7xQymZoL3pzfinLNblq+K4st9nVg7ULmOFAcGBs4r6bj8Xgc9MoDpceu",
-	"MprRhImflFU1yqKlaVxNrOYHF/DckwvKxeG8KtOv8a4h5unP/jzW8DNQMFQaEiRnqrAKOlTyMlVS1s3d",
-	"OJdya66zN+ZlipVllSjF2kU0gYYZeRSP2Khxh2SyYrIiHD3uk9Nbz6U1VeWPJkFl9bJOUQnuamm6qjiV",
-	"Mq+YmJylV0ZgApYe/el0NrfoC3JstmfhrGXgtHt6pHr/H9a0H/s6qR0yd3aBZ4Vro/JdrVehWLtqWbxn",
-	"YYnZKMsUCxreC9jr/MboLpNasxqqvnN1d81CdGnkwu21FrkqoLToJvuiue4MU2ZGsCee7K70NtN/Z+kB",
-	"wIaIO+pNsjNssd9cUezdTefYVs9++/n7z60yI/08/jc+I/axaHeSfwJE1y6/u9/hfus18FR0E8VlS6EM",
-	"rOkmClU2oon7UO2SX3zyNmegX6ccPxc3rYehATGC5Vcsx7GCA5IW7DKJN3Fxyd6bJNUcYCCg8KnEZI66",
-	"ajcyCAaeNgD9YH+/K5XojpIbnss36H23dlkrWfGAGPuYNyJtt/T3eBRvjVbLy9SHVMvL1A8OU7R2SUP/",
-	"3fE3laEFVwuhytKNn0GWVF3/1WjhTVaQcv2lnLv6eDczEOVCHsuC80QZxmLnCOFCRL0McW61ZbeH7Ano",
-	"kl2FNPGBWa1LF8CVJuyKpgV2CJ/0Liz5qkzPOU++pknSlhzgQ2uEkzR/U36tShPFwhjtntWaK8hbuozz",
-	"jcsEPUsL4C75ppdU3g/108aQvsYHBEDYsM3qnBb0HUs9anHYDlRQDZitvAZ4OADBpaVH3+3n4Kr0a7NP",
-	"u7muGh+02mS5vpCx1mDOu9Q2VYP99LD+eMq8TFvcQFU5iJpFrOYvFNtwflJUQvUDZSWoYhJVyQi7mISF",
-	"ylROJsRVOyRriki4YM3aWOxf7KFU1SWw/oSN5K7qTxisp1bfb4T6tIy6XvhPE0aM5hze5GJpfRWD2ik0",
-	"+twY2/o2XvvenRT78i53O2JvusEypdb4drD3ujvrhoDdGsTW4HfrYtvR4R3L02FDNS+AbSQ24L+6gIVj",
-	"pmha84N89fJYPs1nlQsF53UnWF8PutSD9c3LtG8YYj+Aay80sF0Awiyp/TR3xnE2Pjk8OpnpSpNm42ql",
-	"Iex9qz0ye1j/xNpPu7OzUzt7IpBM7cuWJJAdCSDt5I+/28BmK/XJh4A4j+qAkgt5LDtAyC5+WP1Y6mIE",
-	"Cih94boK0duts2JeNP2GUCXjeGZesJ2IWCHjDEpoeJzYQNiOD/t1wbK78GMTUbCsy5mN1frtt78SWqjH",
-	"wpXWn9pdjZP5iD7rjg6/XMe1JC1l6OiIUoVFbXNpG7PIDY81EFaVnt9esDoQAkOx9RfNPBf9I/mdpEyW",
-	"49QU4fLsW4tuXgt63y8rRH1Ojn7ZmHDfbBHeD2vR+ObZzv3VliFoRj13V75KXthBsdrYcTZZ12vlyRV4",
-	"KT1xEDWm7N/Yju6gMEWsi6XUkqmlWVm0uTqzstAssL15v8+kzTMAThPlCTCo6Y7Gm8+kPYQtQMlNXQIU",
-	"FN6AxGmYlID+hkDzR/OEr8T8MTHR5uQR5libPx6R5zRcq+0S6BU1wBY8B5RE8RJ07sJ29dxAwe6iJ5jM",
-	"93wlesav72wLAuKtmHavdrczxr1R21tSSrW1+1Ts7Gfxt3EKyAa0zZjB1iJlnCtfi/K2rTjsOuRP8mSs",
-	"MgZSsyUn2rh21vrlAlFMx/u1YjpAx7GPxvdlP40tbgiBWFeN2Sc54nLP5Ij3ngWxmQBxv9yHnauvCp8g",
-	"sucmG2Cd1+Z6StajeFQPJkeondiqXfpLVtaRK6t/hzdIKwZs1N4QGEzf/TAvt21Hwlf7b8au6mQa/b5f",
-	"JTC9QDX3aL4CkGPLBpjHsoWMCqHl5h2XKdshcLvkbb05xUD9mBwtoqHyfsoLhHS+QQ91waL2OPQDfEdu",
-	"Eh4U8ZhsWdEr3ty4s6l/235ZM3CCgre9VkpO5Syp6rgNmrl3fRXTAmtrzTLeUrbpC7t7FXEmtqOnaDOn",
-	"YS+R5nylk/xVBLm/COupPTtT2OM+yM40ZC6BuhVucg7qn77GrN2m81QdxJwxFXej3bVPdkfg8NzaqFpM",
-	"4O0Vx1upi8Zje7tmakx4nxRKO7IFmm12L0+r3e+dOdD9ROc8MOSxB/XWF62pet0NlzA01OOu0cMcTMnB",
-	"Rhc7b9rvjD9Vx6Ang6rmvBeHcj9Tm2v2qReP6pVsDnhHnLrwPlDX8Fx/HJShL8lLh6PmzjGGhoN+WqAh",
-	"DONTIg2rddgNN7zLLlWLI/JMEaQgIU9FjFHx6qnW4jIKngsFsNaffnSoIgx0H7zibpxf3bd8S9zfHaDt",
-	"1AXBx4fcgY7hA93tia97gNM9JGDbB9I2kgTfgmuDZ3tlPjvfK9VZlZnL8JfYgmZ4z/he4BsfU2nJZnYL",
-	"9IwLmrkV+gWK9bTmfMRsM46BZSsNN7FE/FdeNzQibuKrvie4TyugZ6devINsGvdcQBYtVk7j+iroNnz6",
-	"o2B8F+J7IGFq6BcbGGOSzSnF3CBjHNr0wmL2R8J04FteqX24m0TbVp2tHcAWYHrt6Jaz8exwejbpl5jt",
-	"DsEvFbqjTlQ98TEdOBcvnsWeZrW9PREyrQAYm4gccMnO+RHvoyd21r9GynMrcaGVkO8zQbiAvHNhLjXo",
-	"sgdH4TodRMNg7XaWGzdr111yfx+5hjkidp+9z+SQVLbE+3Kf79ZEG/7g215xoob54huyKUVRs0vAQpIz",
-	"Rn95Eycfp6QUmDaRkTev1Vv2GwUnnXqSzxWv7aDb+qatWwI7fkAqvxgN7nNRWa7Qu3VM1zfpdX3iN84P",
-	"I4qc0Y03U+9cSo55QHJWlHmKLiL5slwndlUR+ppmGUtJVOZ6N6WEooKgUTYU0kbHDwId/FzIV40RLd9n",
-	"Kej+jfBoMEIpmUtp+IS8+eanH5+/nZssv11WglWSsDua41kNpYwGvlRx7KsiaeEumBy3uSVycBLuuva/",
-	"r7JIDhyLpnVvoEsbFhs0p8t9vLMqu8a8hus1OVCs+nYV7LB2LGrrAafDy4Za7sdboBjOdvnoH5Pt9HJr",
-	"otKgzGWeFjROhanyInaUebnHCjlqXJ9DbZwH58Nn5Xzw+BxuWbLHlxD7zoDxfq28aUL0L8+zI2ezOjmW",
-	"gnie09Ss9Gu22qgCLjX17Wp1mfBVlvOFRwZcsZyuGFEvmBqV2BgkWZV/4yGIJZlcYx2QlAwngfFRYxpV",
-	"bENYPmEk28GTwTLh1MKAIPJXXyDkTAipRefyMPhC3swrBF7ZOcoVLLUa53R0VBuo1edeY2Wphyk9TyNg",
-	"fLVBkYoD9mvcx/B+TuPfSp9/XM/cyzpTfikyxsL1pX/PX+Z8QRdxEhdwn55ygq9r0di6rOt4tdarOhmN",
-	"gcGALLVIbI7yMeHXdQKJhVkbESdq9LvXRTD2zsej2TvCl0vBil5rAsEgnmbkz3eyfQXbZCynklv7gGPm",
-	"IcloTjcAjjIBXqqipVYjrYn06fd9G1KtVrWpuT62KuXH6T+rQBfvWAq5IXS9UbuOoy/dgx0L0Ik/hWgr",
-	"ucl6l/CgmVKVFXjfWuLAYWs+NtI4B16Fyuagv/A8arLPXof+mufR3iTTmyZv1Pq1ms2OCpxWF7staWjT",
-	"3SbfqrYmbG0sbk/LFPVt8FGw6Imd8NZSGcyPLSHVsiVPOhI/tkS9bqkOZhYwJB/q4J8qNlv5bW5mmHYj",
-	"5VQxNvMGJHEGM8QXX7CrpWuFu0ODC8LKId9bquJVcAI+xN2XaXz9GYz+xi2sRQRvO4gWKPam3pTbEG2T",
-	"tO5lm4AG8z33ynz0qTYMNgiU2VtxlMLvvVWVEh1wZh88L0uvLq9oLnxy8SrOeQoa1BXNY9mM2CsXlCgX",
-	"mlF3+39FuTDlykvBpGVglFNMuZmLoveUytzT5c+vvt9vaXxM55/fQDEJ3D+R8RSTqe5VcARviqvlsw5L",
-	"HHmXtTLGbYDlSDe1p13d/Mya34tUZCwsbk6g97Pl7vykRF/xofxxKN7F2ZBnOLohuIhYbi6g+1CCHECM",
-	"096pM8n2dq9bRRh17bPIt5g6pt0j5g5NlVQvsPxdviUwQ5/Wz95nPG+71lEPaweg6Tfpt6r97nS8W6eV",
-	"DcE6CGtHBQG5yK8ZrLVsz4MUYZYjPE7bp9xyueTukzVi79Z/H4tC3dk/B/nTTgDaRdkr2dE/7Ubb0h05",
-	"zMF4t5JY7KbliiFov5p3auhlu6tJOU5vz2zg1KvTUc3Hcc/5aGpNxeWGo7VuPlP8qclm4f66vY+j41l3",
-	"irFbLbQ1x2ok1gzaNwJTuNwNbcG12767II/B/e6B6uEz3AFVIOFPorjlrBLiKjWSmlXN+1iqW+bQM4kq",
-	"ylFK3IKTnKHV72HItsXwh1MaPYx8T+KhSQJk8r6tpru0x0P1xs0Fe7imRYU7e+HXRuVL1hlo7UvSU7rd",
-	"s3ebv91Py1/DRZ9H/96juaq8fvNU5rn3d5PDoSMq1AfEffFN2xNJUvi0S43UWCSvJha36XG2wxKMattL",
-	"GdGCDeHbFu3ularW4PcfS+2tXHztjw0817xDHm5/4Ff/fdIOyR1Fxc1S6gVXK/O25SzfQghI2nO4qq9m",
-	"wy+WJ09+0M0uI5quWM5LARU5PTGb+jkAuUzJsw2jOjkyTbd2HCYEG2M4sUogpG42alkBb3x87k1w9R/C",
-	"srWGDpRYQNCWP/m096z27/lTSLe+o/OkhvAfAkt7/NRa0J1hWap6nP2KC3VWjv2mGWnTZg7fpxrXz8tw",
-	"c9hm29e3QI9wnjRCif3BFF+4mthw0Dl4F7WCradP+z9aYptvmzTf3Au3bHDCQwBDq/ypbW6cNgK1bnF4",
-	"mYceazaJU3aZcr8KIXvX584TlZ3xZnvt9KwL9RhygRFpt5FsLcBUg/EVCIaXtFj7liSTv3t7kE/s9kxe",
-	"WuxKwcxUbgxAvgEMjURxzsKC51vAZuhkGjQsSprAsP1B6VexaL250U9rQ/A2xHkbS331vWSbOSom//j6",
-	"Nc5KwdKWvEwjX4NXoYfy5NfnqhVkCaIM14QKcjFYxcXFoM9loBebyXlyuaFZJr+5FYle8/xdnK4uo9in",
-	"2UPCccHCMo+L7etwzTbY7rMs/jvbPiuRKMDvAtoSoznLq0mtiyLDpOVxuuRaRFJkngovpgr1qwpsA8WD",
-	"4FPx5OBgzZJshIXTRyHfHPh9oqqRV89fn0Mlf/IyYVQwKOmlW8oSWkgt326tiSwF5gDp3FSkh2TXSRwy",
-	"ZbapUf/w4rwx1FVcrMsFtItdqH+G8E8WHywSvjjYUFGw/OD7F18///H1c8TF5Bvx0/I1y6/ikFkNWgPN",
-	"eBKHMRMH8PKQL4cl1Atzq4TJuQ+CwRXL8ZAMpqPxaAwyC4cweDI4hJ/wRMNeWsH98s8V+q454FeUnTz4",
-	"PhbFM7s2nYEGCQjaa6K1N3Ghc+9XuTNV5IeCUePNKItG5Ht4XR6xXOrzZMGKa8ZSMgHeMBmPAwPgUrYF",
-	"1FUeq3Qmss/fSpZvKxQiDGAQIGlSxyixEjhb+Tkb0BaeF1i+XefEnFcibG7pXIqxqqmNyJyKEPNN1MrA",
-	"Y31A2cvcFJ52ysS3TgYe+ycDo7YUCgp/wY++i7PmToVlLngOA5LqQ5ySjK7gdpmncjJLSF0Xiwo4TF58",
-	"g5YVursE1uPOElrJlSQWBcZgSLlL05AFJIbC3WRD3zFCEc6vUBuwMDkLmZRBk/FYr2VA1PJgFpzFr5dL",
-	"zgPsTpQLIb9OC+UdoqlKvMgIjPmpel8OCZe/4GTJChUykLL3hZypEYww5NYdgCadHbj90i7YkufsC1tb",
-	"HPSOxc2kIJY2ef8FxnY7V/itFP/o5QJGNR2PLaMLpGCWJTEqTwe/CtQSqva6bgxc/mbcdCC6agkQ/g4S",
-	"WZSbDc23mOdF4Tt0iErFT8G2oivJIwcW+3y7G8QNM8wrN0yIokb+Qy6MgKBZbEuzq4nFy/8TNuapHP1F",
-	"OR5PZ8ASn07HFwNycXGREjL8jlxoy3R4vs3YE1JfQfddKe95Hv8bnj8hfwVpT/77Ty+f//jsxeWzly8u",
-	"//78X+4nKJeGf2UFfWItzNOrycUAMSc8YqNfhWTGG6kAaFEO3vULlFvxxeB/XqQXachTucLwE3lKUnat",
-	"3n70GJ5TsU3DKlBwQ+P00WOMkMRPN9tqF8hTQq9prNsbyU0YWVsnd/ORiq6EpXxCLoAWTEwnLKj8Va4p",
-	"/PYBx4Hd8YSNEr56ZHc6imhB5Usf5Hs4wP8pxem2WAN5wbTVDJ0FuUjDJJZH8qmZMzSxvaT2lPAl/2Ss",
-	"uTz1TeWpmcnjizTL47R45DSPg79IURHXnmwdbGCHE8juTDCBjhR4g11ZMa/tgcX43G7SDMN5oxmocHY6",
-	"PTmcWa9UZUq/5sDxzsuC504r1gl3Qn5VZK4/772aQi33/cXgX7yEQDRKpOq6LBPL9xIxEa9SDMQBZr0B",
-	"XaeQykFBQhjffzjtVwn031q/ejLh6yhiNzID4oaDnQt/dDy7k4WfnHoX/octeeZt5U+/8CenZ3ex8LOj",
-	"Q8/C15bzDhe79u1drJX8pyr0gBfY7WkH1L1222JemOtu+Qb4ajFK6UMwWOW8zKRKZ5szSguRagBxHij0",
-	"phOC2j9Z2gHu52NjHYDukHHhMbEQO2nOiSrnwkTxVx5t70zRqfWiL3o+uD475c2/N3XL9K9BGj30LBw5",
-	"FBMzx1phWjGGX2q6NqHeSvl6c0vt67NRsvR7EfnKpIro4p0ZywXEfG5osSaFlJUj8suayWV/xyJCCawK",
-	"FEy/zmPYkQjuYV+CDiOZKcNAU3Gtgtj0FyMrHYYlHWRHrlBuLRzTWh3Gz8JAJ/vqk+qZu9RM5Oda0bR3",
-	"5knFMT/29sjNadkalXb2ze/g0PTvCTGbAltSlym7tOT70o/b1WO1Cc09ePpp1v5p+9I/7X0gYO2f2kvv",
-	"VetbFfou+dulp/h1lKOzk2P1uOPot2spe9Sf+th7ZnOrhsbXtVVe1WdnjSsdb+1ksreS7EMGtBbh1Ud0",
-	"fZmCKyXfvSILXqCneMtLTBxPw5BhLh8oIWHtJNtkCd+yajuFSm0g9RWabol2uY92iyW7nlmXPDKPnG3G",
-	"P4f6iL39w0mtj7E3WmR994p8x5KMdUksa7t2iCpC9E559ulLFmYfa0uetu7I091HqCnB7B156tuQTybi",
-	"zsbjs6PxYUPE1Wd/1xLu/jeyp3izNnCXXLO54NBOctdP4H0rZwSZOrtseW0vOga1MebTm1vxIzRX7Rd+",
-	"t5MlfqgC4ZpWPkbY2VZ+502qG5xcHf6CE+xhpO9TMgRu6PsqN0ema9l/qkuW2tz3umXBbx3r/34uV/po",
-	"SAcWv/jMtKV/km+ef//8/PnH1x402exSHSKWPKpxXJ8I1c0p+XkH0tMaYIvkxCPVGJ0WKWZIdyZOdJCr",
-	"JRvU30+IpNheTkt9NLyMDh5CcSJMhStPlRfh8TdW3AVXUlLgi+JLN/FGquoeTDywpM/yencXF9J0+kjr",
-	"Is6ZlT9+dnp9NeQW/vQpVN6T8dmDyntfKu8Oxq95UAvrP9+rrk9NySUbWoRrk+YrY2G8jFlEXnzTdYeF",
-	"YaR3IUc20NK9SJG7v1SrTfsLulSDkccPUmwfN+Sn405EFaYzmizcf8bpkqM8VXUedNZx2c+oBdSw0/ey",
-	"ExPQ5cIMLE4H2JK3ij9+Eq/mz1kkBVdv3aCE9/2aQR3S4XV9ki+DHtpdpr2dpq1uU9dxaq2LSye+Jy4Y",
-	"Sff0oV0nq+/vHatmSA5RHxXNohwf3XwCZ+wtSKTFfdvPeetz3bY6bpvsAj25lmLb2IQHBfdj08NHUoqD",
-	"+q9AEbdUlVFD61CUN6gIRffoFsYi9v1CbNDFfVP1WQd6L1jC05UklLtWpIOHkJ+HkJ+HkJ+HkJ8/SMgP",
-	"8Nu7CvtRYvOzsKJR6NzSPt7H/L5Dj/CtTT/qbO8usw93zYqUaXEKu+aH20fd9MCy3Dc1PirxvFQTaLE7",
-	"akO3xfrTxiyMv7jW/H1E9vitvbbbMPl2d7DD2Xg2PppMrVd2lCncGYnhtzo//gjb4x+aa1iLf2hO4W7i",
-	"H5CP7QyCgNd2KsswyJuHQ3yLCSFupA9bxcK4ynpDKJEtWsLphopxlarR2iZPVoe3HyWcQ87pU3uf5Rhu",
-	"GdaBxstW1a2CWlTkzbetVIbcSxW36m+/Pf4MJTQI0a96iuivnI+6hbT7bruQtt5zPd7KcPewpBu6du/y",
-	"tlfSRj/x7oAjd/h21ZTbJuzXB2qjuk+FYJc+YM21SyOwfXNPG1Nt0RZ2ut98UmunTPXK0+Pjw9lRv6LE",
-	"vYRcHRiokw21oANvLN56OoQOfldrvw9u8Dbi0JS+/dg+IndAOhVhJ45RLc3nCmFEeXs7GCMsxOckig6s",
-	"o/uZGI63RDfeWtQoWN4N5A2gHTuEjUe0NGWKr/u7FSyqh8v9BIzGS8JMdoqYPkLGP44WYeMRzdARst+m",
-	"kKmhLdVft0BaNiXHjeCWt2Hm12v+ufDya/ZVzsiKFUWcrr4Qfn5Tq8WBfzqNfP6cfF/zor9xscO0+CIM",
-	"hG5g6D5c+zOyBJxJPdgCXRDKJk93cZQ3Nge6EZVgKJRRzA+wDijkeO1wjL3Gt+7Tq4Rd3Jk7iYcFK4ZV",
-	"wbxqKCb1/iJOKdwQNbKQehhyMFgzGjFMLQ3FUZcsHz5PMZlPMxdruC7TdyzqvG/64HL5v2GxW8nl5dZU",
-	"FT8gTzoUJ3XYvXypwelvx90tkvhIurgdb22BV4pCDCcWA4QlwEfnEBMfh+/IIufXKVny9+TXcpOxiPAr",
-	"XXCb/ntLIr6yg6mveBwq0AhNEr7V+Tr0SIaqkCZOf7TJDo0EqcTHUmjRsRQgNtTvkJZYPZH/bT+7BdwQ",
-	"n+OIlFCRrY9yJngC2PzRgTXeQV9RlR3WxRNs/Ui15cZbG8yduymwntZqBjoEOCtBGz7nEd3C3TO55mnE",
-	"8mWZEPlTwcmijJOICL5hBfCojPEsYSThV+w/7LQdroir1qF6VpBFuVyynDwlf4X/GMl1foRz22SHI8jf",
-	"jo8ePcbv8OFSjLKcb2LBxAhyMciGrT4C1bIbEuaRo3JHknihBelLuf1679VupxepKrgM0g6o5Sm8+egS",
-	"f7p8PMpoLiXvAbkY2HvqhJJ17JaNg7N3CvbpqbtNsElP9z5LIJP1aEbIXC8LDjN4VJ8gyGlbIAK/qvvF",
-	"RCVZbAmoOKAkeVNJ2xZbhV2eWuwSX04x604ptimTIs5oXhxIMTHUdcD2EWROZ/d4PcJT9tMSbLe9x4S9",
-	"/pdsUtpaN/z+HyxfcN3M2z52jG5mYWRcnBbcknEJTVclXbF95NybGws6l4juVOB56Kh6/Vsg7KcXg//7",
-	"QB6Ug4KDBoejwkNfvaqP9PU6FhnLhzawYbdcuk+ou7N8fnnirnBNrsg5P5FsGH9+xWj0GljKo4uBtRSP",
-	"6xkzrJVoz4nh9DySutNOPr6PPSSHp20h+d0jl2cH5GKQLyBYrhpIZTZ1LY7NxuszBbKp+gZ27LeF5IRR",
-	"13mxoStdJu46TiImChJHjKJjfsvLr66gLFVO1jQyEOCvckbCMgeMFWJ71/yaSJEar9YFESFFd3olwmVz",
-	"X0lhj2BKMgnG4zGiGMkiXq1YrmozgEaAgDMsfLDlJSDAVgwzDXBoa6RtqioTwzcKk3izjENfzpG/GBjw",
-	"5+Uqp2mZ0DwuYibevH16zfNoB3uoHmq6uESb5+nF4Ap59iUq4Q+MxDlepL5g8iV3xXRKGf/+QGgS7tDb",
-	"PyZnqnGgoItb7aI+jO/wr+RTeyGt2IxqZCP5uB1FVlDxTpmSRumw8EyoZuALLF0lsVhXOLMSFUj59HR0",
-	"dDIej8fT2cl4enpqojMq/iq11QWj4RqqXFGS8UzOgoiMAz6dkjUviNSBWC7NnxF5icbOteR94jrebCT7",
-	"VNhbHjKaBmgfyZ8FTaOQiiJhAnlzltCtfIBdXvEkYdsFTZIqbALWxY+TwxVVo3aAZaKgOUxoPBpbP7M0",
-	"wh+nh2fwf0ezw+Pj08nZiYt0G41GHZ1Vo/T3eTI6GsP/nR0fzk6ODqfNEZyMztxXbBxbXU78wvOoIizx",
-	"p5YXgq02LC0eRMbnLDLMJj1IjVtLDXstHwTHPoJDrZzowljbwkEw9q7xW6ccORwdTkCMHB5Oj6YnZ3b+",
-	"/mphyN4rU4s6f8dSexLy/47Hh7OjgBwdjQNycnx4FJDDs3FApscnATk8OToMyNF4fBqQw+lU/To9nJ0G",
-	"5Gg6mwXk5HQWkMlhQI7Hx4fjeqwwjn4DfqcSMdDu7OnV6jLhqyznC/lwOB5NT2fjk9PZeDo+OT4+mdnr",
-	"EPJNljMhYp5eAjnBbdRoejiT/390djg7nZ7OJnYCfn6pfG+6h/FoPD47PT47OTs6OR6fjs9mfnndkJyv",
-	"kQQc4fl2lwuvaHjXnLssl1Xj7VTLjRaIXHnMq8usnFDyRnEAsm9T6ruh3aTHj5jQ/l7EhH40HyJ29Tl5",
-	"EPWIbuY/dL++ofcwsS7IwHn4HJnwR7kZs6nl0+uCK5ZvaDraHNHP3V/oaG24fh06m1rgmsa2S2tzrsGs",
-	"TA8dqptRtDyqFg7iM1a0aqt0127D71iS8IBstlj+NxbkF54sVzRdgTbxgoR8w5BO/gZ0uIVE5zmDnAKQ",
-	"RIBR0EQKHtHtf/oQEu3SxOKytizRz1ikbsORlYdrWgDvQTTcTkb+9ZoWX5vX7xXV4Hb1iYJl/EPZA0eM",
-	"DQhT+8RcSOrSxqv4iqVE7oM8SVf/P3tfuty2sTT6KnN0f5y4iqTAnVSVKtdJbB/nxHFi+0ucz1TJIDEi",
-	"YYMAg0XL0aeq+xr39e6T3JoNs2AGCwmKpMz8iEUAs/X0Nt093TCMCPkITBkNX7MXR933R8rhZAhZ+OP5",
-	"u0v8EwcI8bTsMIrsOZQV0nsxE00YePRAEd1FMVwqiWooChRWnWqxqyJczTMOlERS+p3MMJj6/yF0SP7Y",
-	"Wa54vsmq3EA40BJwQI1doNDHuYXQ+iUwM99yMWQ1ids1+609ufPJtWaLwJ3B6JN1UWfSIAk4VFCYwCKK",
-	"Cc0CGLjO0/OfDjurISW/DZtFQBPeMbuecIDXgrFFJ1wYE4jgMVuuvKYpKFABmBoVSEICh8NBv9MZjfTJ",
-	"drqtfjNOwmnQtNqdPj9WY7BdXrn+HIZ4LTRMcXXZ6w2tsTO4mk35eGRtNGtaGv3kwFvxqJ2yFZyUhh8F",
-	"OYAN5dxEYE8m/mTiY5AjJh7CBnbyLe078JruIBbkTIA35DPk5ISeadUabZOTK9d3o8VlCO2IWEMmJ1Ec",
-	"rGjEFbt3nCgLmJyswmC5ii/5CX6cdsm3RnidXnxGp/4YF9FnrzptPFatLsT9kjc4v1OTlKBv4oQY8GZN",
-	"uZMvDgQzitSDmoqJKI+NzAepTvnnwo7/3//5vxGxWbkRcJf2HH7PxYwsuwqGw40vk9DTjCm8O1P7wKgX",
-	"UiCyzU5WXmA7rRv3q7uEjmu3gnB+in6t0C+06cvAj07jRbKcnjqnjnP66mrVvHEjxOldv7m0HTcK/Ga8",
-	"gE0fm4Ga08AOnRvb+9r6spqfdvoDa3XbrNZKhkwqhjM/LlQ5zbHAvhWIomtZu5LgpnztRfJbyvdnwnZB",
-	"ymswnYn9DJan0l/G8DQHIUVofNbIxd98pGXdmRE2fXOWRdV9x9CGiXi5eZQ9vTAFdqYhhRkFqZp6VDoV",
-	"f556pGQTLMK5cwF5Mtwqh8Xms1nWX5a9luOoDw1db5lH5XmqgbceGH7qRIyIqRkOyvnnedey5DyROqw9",
-	"6qFHPbSMHvoaEREJen0Kuui3YPtIV0Xi3nnRlEMzieQYMAyqVH1GgDXMABz0BPAE7LK9BSfDxDD4jkJn",
-	"kfhfQXAlgEnyRaTGGfSdaFBwoBfbLToboqk8HE01haYa3JDsz/kHTBV4vWhfyFa4vrAVWM2lZh3tBujk",
-	"KJGhWRHKxWdGerZw7/gjLj/bg3GvMxi1x1aD8zCD5KwgNiWZ+emeC0s0DF7U5OSMA1aRjAJsJyd4I0Sp",
-	"RoRaRpyhxw8XGDefDHhEOGAUWwMYLRze8GSAUm79TLXBMBBDOjBR4guntekZ5bWMyjpGqmGY1dpUR9Wo",
-	"F1odVJH4CiNDZyjgRuSCBLRxQnLP/YrT4f4QRHHgf69Nm1gqPTkT4HIti/Thmayk8JzvcxhfzpIwhH58",
-	"SSel6CxKDvjJyStI8izRZulaXB/Y1EHnBTNbmQ1Wd9NUIBlzmbgWRjMN+YNVGKxgGLsw0hjbEObObM1i",
-	"s92Ta9GaA5tmrR/QOt34Dvuio9iOYQPA1rwF3ts+eBna/gydEBvgx+cZE1rmCJ74brzJ5KCfLGlVkhn0",
-	"IjeJaIkBexFCfwHdOC1IorfjKfBkfmHaJ4ffReaUmv6RQcxLwlfoGSyJA+x/30U9FEqj4BxXgSlUK/4k",
-	"14jMxJgeAx8uhEvAmBjRGFrlP5cecyiyGk3WSpUFdFmCMgtps5A6S5LAxhSa6fFBQ2acTHVzKkuHas9Z",
-	"dmAmP6OlU6bGC8EHXI/dW5V84imN/SVXH8f/CI8oO+DMwOyuViqh1nLskagztR/kUKWBIstTY22UmEOF",
-	"BRSYS325lFeC6uqkOFUA1U9pDxJYSlDYg1iG6WHiX0z8bQqS7RzMJdIkdYw4XQpUec4ltDbeobxROSfp",
-	"USm78ng8Gg/G7UElu7JoKc7eGlAtxiabcbHVWFHcBUMvrzZ3ObM9Lyp2WqeQsz3vUlMerJTaUKA6VFcf",
-	"6G2BcJ6k9zAmJ/fYPC6QyQQ/n0xOCBo3wJvn6NcEsevK/mJhVwxWdIMdXYS2RgctYVMfdQqM6kOjUX08",
-	"1hrVX9KtiI4m9Xos3SJKpEZXsiGrS/Fl52kEBjJRIoQFMhiVCwAEgEFFApgIrjPQ+QZiBcsbjRlcsNmY",
-	"ikYOrfNOpSDAvK9Yl4/jox1ancGoPxyODkGWso0B/wpucCoOrd+1SGjcrxc/hri6MAmNiJXvznXbw06/",
-	"a/Uzn03vYgq6YacB2lYb/W/E/tduX2QFvMLGMiEY+iNx0YwrzLrkzIsPyIUzdUtMs93qtsdWz+qWmmU/",
-	"Oy0lrqJKXB+f6j8KUcDqdEfWeDTIQQF1at2uOeajJmT4RylEMMxdnX+3W8Omk3CKEtPqtoaj4aDTLpoU",
-	"2vf2AG1+j+Fpm/y1JVxAHKkYHSzL6vcGg/FgNMxBCTR7jLltPO/xFlBAO92KUy6c9uZ4MUksqzv7H+g7",
-	"/4P/LIMibas17nfH3YLpopPDllBhZvvFqNDuj6z2wGoX4MF43ADjIYKntQ000E21ynSLplwDa1jadyWm",
-	"2Gu1B22r0y3DGCw2wc7WuMHrAgTotoaD8bDT6cNmJeHQyaxvuH15oVlNpRVpGUUtYoMof2WYQrfVHw8G",
-	"/TI8jOBun/3PSv9qD7aFLoZ1ZKiw1x+2251+Ec/IWcAWsKP0JhgXsPEuVMecBfRWpbC6bY3GVn9Qiq/0",
-	"JJ243dkWutwFSQGu9Fu97qg/7A7z+QuedqedyuzhNvBDN9tKMy6edR0aKDo8luEkndbIGg7G/dIqKJ6k",
-	"ZW1d5uhXkFXoepY1bA/63SK80E9+CwhSFvQ5k98E+pVx5ftS6Nzv9IbWuEjgDLpbQofvy5xGRm1r1B52",
-	"cjBh0N3Cjn9f9uihn18ZGK6xqZMyqvCw1R71+oN24ZQQ1lXb2gK3R+4dgepejYKbAmOjT6M9wlbh3Msa",
-	"5HAlOz1+oRgjJWqaLew4k1mDpmcQ8l7gakln1G4pZdvg9cY/Kc30+Zaw70SuQNIgyZtIUDB0AKn4PsPl",
-	"2tVOSZBwTtcRi2JMq/kClxSDYmXo3SgdqoUzz+PMIBWSgjxSQpA9SQayaSIQYe9YEpBVGFy7DnQAIQqS",
-	"dS4NnpBygQjbUnNKkD133xHQkE/e23f00h4CaAwFZV+9uCu4QpVEc3voeFvz5gkBjR4wPMMfhwuHigAT",
-	"5hwp8K6tdbtU71CjPrTK7jOy3PMcNBDuHpKVCus8tyYl4kKWK6+Z/P312vv97q9/D6ev/grf/et3C370",
-	"/nSHWs9WDG/jywLPVn807g1HXZ1nS7PMTe4dZuOq04uv5M4gyyfv+g6EjkpERp9ZtUgHD/rzeLGuPtDP",
-	"1wfMMQ7tjjbG4dcARBtG9H9rLHLPLu6RWTwu11zn5hxpU+7WHE6Tx/G1Br4q3xzbFZPVXGvLu7tGwVCC",
-	"Kw/d50P35y9fRn90/vP264+vrv982Vk8//rTnz/8/t9wbdY8GFvD/nhodaoxU8RG6+Wa3Ask8UtjEITr",
-	"R3GYoKVWlRnGy07iaUhQNxsnHpzbsztWDVU5IsmHAN1pqOggxMcynIeEY5CgRFU51cDlFDqO688LDzUv",
-	"2JdbPdOko+z0SCPMYp0TjQ9SsIJrOIuDEIRwFcII+jEro6kvxPiCb0etOWf5Nu+gFqNScPEqCBycjduB",
-	"njsjZYF8h0RX224Mw1ZLrLUoVnKEt3EzXUrTduymZXWEbyGtoUkTvlNC9wI7ZhUaH19Gc1RQxDTfE2OR",
-	"xPz18vKIFUrvpa0VWAmQMp960rnUGkdIJHIWHFIVwjxQiCUIK2CXAoFzAVWMklcUox73qU1OSJ5lnXAU",
-	"m6QrkGSk8FQy1Voty+p0rUGv0xd9GdjwOu52hp2xaHdttVot8F273x0AvI4I4HMAUcsIvJ4pnXRGo16n",
-	"0+G9XGgld774zd2acuHbxpPLSDi4COl+Bamlil3pFRe7zwHaLWwvTL/QS13egSJ0I5YjGFemRrxXWx//",
-	"FzfCVbOjosL4b33vDpAZ4rTKEbhx44WQA3eVhKsggmlB+r8TiGsM0wXT1ye7qkCfLrSSkOT6D9sQsnZc",
-	"Qm4KvQCnecZQSCIY/jMCQTi3fSqkRFlJgFyrmCRTqS4hH1+qYOApAoVUTEdvvjMeyXC68OAKt9eex67S",
-	"krgPtbN4cYImBmvmo+aa7Fk+K1RjV/w+7WFfvL2vFGpvdwfDYXfUlw4kHuQ3byLbg9Hbaxheu/CmtXKu",
-	"5Pt9hCSVYOkok2eq/lX1rNxVDYfjdqdtXNUqWa3uWoj8PfN6rlwfNuPE51OQJEJWMmbY9hVli5SBIQYC",
-	"2DMtq35prFiPm+kYdCP3EPOSlcjfYsENNMaOTi+E5vAiy/Di/8J59oBNuALmwDPbB1PMeh1gz8IgisC1",
-	"TWp3Qt9ZBa4fRy1cVSdy/4M5ie15mFsT3klS90EHTO9A4EOJeaedrxCHb1sWePUDTq4iduf6jnvtOglS",
-	"XHCPtJENlvatu0yW6KN+uwPe/IAOwR2wdD3PxVcwkdKAOd7zlPJa4D0k9Uo/8YfgA75DPE9ch2NX+vYU",
-	"X6x8hqboQTv0wTIIIS1cijpCIjbicitKVoj/QYdA5SUlEqTvP//tNQiQkKffROAzobHPpC1e+28etCMI",
-	"PqH9t2cxSKKL75iAWkBvJUqoZ+hIfxckwIfQQRN0fUTqEV5hBEEUB6E9h8Bzl26Mut9PackLjFD+ci4x",
-	"l2ytkuUdokPGn/TCdheV42jtDY0QLl8hTl4bqzZCAaNju9qDGZPaWxHYavU1WmtEnnlabYQYS3UbW8LN",
-	"lJWCRgkoSr+OZVmWbMRMhd9wOGhbg9SOKQs+ZQ3kkxyply/QKD+9YkJGrDeSMsaKQk06dJze45L4rvOA",
-	"qNSBHoxhVtT9hJ9TUZd7BEETe/0TYmaMgyOukqTVONyIWQ/TQwiO80hXTKdzogq5XZ1J+NIrHUpIMyoI",
-	"H+OMcSogOuN3H8FPL3558eHFQZw/zKzPgd53CiE/OscilJGZRq3ch4zhcBdgPm+gKJbhDfg5gnEU23FC",
-	"VVitYeEdjEMXXn+bhF1Rs2VWBtcntj0EYKLC2SBawZl75c52SuwHStwhxcGdU7hxIk9bw2A8QK9jVFQt",
-	"wNKOZwvmkKJkAR3w+ieD0nEqkLKWRf0U3PhIzXmyLErtrzwnwumiyDARWzQH+S5YEdvNtU5w+KonmTZB",
-	"7T1kUtRXuS6v2qw6IwNumhpDntvlzDA57JkvR/8MnzJ8QHzJSdmHl8QwcfolmOb7L36z566PeNxL14cf",
-	"cKOfUZsCkn7tQD9GCB2mgbyeHcXgSzAlOEBCe+E1tietyCBod1VCVzwd9lUMw1w/R0Odyq/JcgpDYqbh",
-	"Fhm0cMRl2C6YBsQGFGlAhxZ7OutYDTa668dwDsNHcLMY9qPSGecXmoMjlGxy/4wyAFLMRunLutmRjI/f",
-	"Y5ifdw7Y+8K2poXWU+iHwV8X+WLIR9vzx6R7IM55S75vZbQWvIZKKY9UR4ub+GXzw5ePlvfm6q3v/vjf",
-	"Hwe9ePzbf/3+ob+Qkyqq6thoPGp3e6OxGG8Gr5m3+sYO5eZC1psJRndAaWEVBjMYRSCKg9UKPXASrKIg",
-	"bjaz/Rn0vGyGRwYKJaqNp39Lh1M8QqDVaqm/iHsFTE4WdnS5DEgMquGwyclU9a/I1G1wtawYhwGflBYm",
-	"fTL9aB0vjMDFthpOJo20I6eMvNpqV2OUvQA3C3e2AFM4d6lKyZA0uAKYDtCHNuZopLwu5gwsJylCzgjG",
-	"2O/AZAdw/ZmXODACDoxt10uVU+j/ncAEOnhc8hGbBTFVpHE1uKpzqsfTw4xDJhCBwJ+lwZAQD/3pF9Wv",
-	"IiyToRv2zkQinj1bQzB9qkEy7SCyPQ5t18eRSa4HhXPrD/8eTv/z+5fuy6v/fvkxHP40/WVw+/PNVaAP",
-	"l1Py/e4qAC4VdQUCU/aZSCDIHNxzHCFcZNaozBvkpeAZkeZ7rrMziKXgpG0pJXCVsVPZy2Xml2CqGjZK",
-	"ZopTwwV6I2vY7XN7BhkZOpdpf6l4m5yI2uQlm00QzqWUdyGMEi/GsCEh5CxqgLAS0ojwm7TNte25DumW",
-	"kYEwrIlEBAjUWK51j3mCEjNSWOsCF4K8W8HQkIx6cuJfwlUwW/BsnCx58hNhHo1SedEVGJ2Be8AAcwY6",
-	"FCJPgwXhd8p6z1PEE9CB3SM7cqztcCwjbco0+ZBhbi/wy6fP2zQQrs4GnyAvU+DyJPQlZU3sGwde9fqD",
-	"o05VF4fSc6HK6tUfac/ENyVemtNaJ2i8vnLCVcwTojGitYYxwmT9Pr0Xnlx+CaYspqbA8y7bLSr5t6Rl",
-	"ktg8rVNLnVauf4uedFHDuPn8ZfvP4N3fTtf++fm/or9n41//Grq/jF6eNB7VVV/d3vEKxthTn7ros9B6",
-	"VKtBDUL0NGc/DiQGoJywEh3xErvcvbQxT+0xhINjX7v+zJXuQqlSYdwZDNpWu8elghst1Pe4UqRRaqCJ",
-	"nAljnS3vmkE4P5slURwsL6Pk6sq9PRv+PVqubpd3PORhLQkj3x+QtAud8ImS2QxC51E0ZO3plQD2Qewe",
-	"OmJGjeFgVM6WLjhezfIKx2BouFJZaaVeABMDMUrIr1Pilci5yI3f1yfFQBxQT8hRnony7PVyCR3XjqF3",
-	"R+EjyDTI5X9NUqn5Efz29v2HatKJMy+KNk9KKpElrSOTtuhdNU1qz44qo3F3OBinOZm2elQxs3KZkQuV",
-	"R4XEhoKooQ7ZbRx1ygkIwluB/E4WDekcNxIS1UQC9qMXXVZmtPOCfLypSJjDGJBxwVUQ7lo0NMpGKeEp",
-	"7y5OiULsAKOTJAFJcKhSZBI6/lGXcrJysOcbx8voD827OMoJwpJu0xOIUkKvL8lyvnOd84wMATQi6wBj",
-	"mNiyyJU1lc2ca8UlXe32cn+sEf/kOB9+vrpJ3vyxuvrlYwTfWs+X1qu/vyxz45/GnZ417FltffyT618F",
-	"5eKfcKQHOsFF0VXiIVWWBnE49UQ81Qal+M59lfww7MDr3/3Z6l+j4S3sW/3312WgZK0DpV/hTSbQBdAB",
-	"zsBVfCZpW2cEqc/Ohque91/voLcZ+MTDdk1xYZDJfV1kWOZDNR2Ku7TnMDqFjhsXJhF7jb594bjxti/h",
-	"pwPtKOgLjx+tnT7McWPogCAE8DaGvgMdgKFM7QK2D4LQRVqJR5/bvgNsmqJQvEdAplGvfBT3e6Pb37ij",
-	"88nJ/w7iGIatlT8X3y7t6Cu+/G1HX9V3aS7G52CWxBBM7ekdiKANcE/gBtohCYSbwhDGYkufRxi/xDkH",
-	"zicnbavTu0X/26e75WRfFelNQN9CoGfuQfzIdLlcAOyzNOlx9NV4Fz0F9bNMStCSkDZfUccTbSFarv2k",
-	"LYIF5wPDiEWvqQswkO+oYwRjd9nTlSv32CsiGm7knxM3nw69jMpFXlpks36RhFRgMXLF2c2Mgjb3cyxY",
-	"MhKEwDbjtiPoCRknz2a3THO44C/1h1zKSQxptujbOfSpHCknXbYaT4xHOEiRIsmPx5UUwg7uNku0Y3te",
-	"Eza7hgzRWhoXvsXpaNs8BbT7H6qiSRS+m9iSPHFB4Q+/u+cxbwIoipj85GRXDD2duBjqoWxiPodOOXL7",
-	"2+DI22bG13boVuDFf7DPH0XdT0c7QAYNUsjinFqUURMSexwuzbd2i0r9k1C/CWNIsW09TfzRWCpDd34T",
-	"WVrGZbrvWdUZ/7hESt4lO2/qlORvR9+9lvjZNvgsuTSV6695Qz7ZslGfjFL5hjFNdJCEIfRj7w7Y17br",
-	"2VMP0utgDVLKiZR3isDUjtyZJksLtGcLnD8wSmYLYJNegxsfhsTUQXp1PTe+E9kjBU2t7JFeYztUgz+Z",
-	"fsFtZGLBzDPj4y9EG359yp40wxpt78xOjPtvuk7TMiZWpWeErLmYesQH427fsjpi6xsfOpfTu9TfnTrB",
-	"mxhNc5hSZl7tR51Xo/zEOtubGMV7cS4VEskuGQsULdpLzhc1qWTxWz1HJg3zOfLpPf63RN49zIPK+NAJ",
-	"0cUBoP1pneRL2ls5v7jieLBncAlnwRkNAiTurkeOnhKAsm5KPtnR0gJ/BQlYJlEMFvY1Se76FkuGMPAg",
-	"cP1skgsOZGDTTh5FaJyW25GDTABIsFcvbGgKwFKL1wdlpeJmG5KGZwcsO8PCpGIlO9JwOJGTFicVVBmf",
-	"kUo2zDFYmonxQKCUnelSeG3O3CT4PjIPI9Aome0Lwy9ijAa4fhTb/gw2qNLr+nOj1svBqFd7VzBculHk",
-	"Btg7/jgsTKyEdvCMSbgRoNwYK2JCW2BDwmTkcnOF7EZbG9PMVMyqmVktK+A7aTh8ltngIPiq2lZxKkLU",
-	"rKQb6E366VZ9QXyYndYqE6dRxfLo2VGEgEzqxMFbXCBuFaBpubbn3YGFHS6vkoyqxDahdmazOxeRUKDs",
-	"Nbix/RiJsa8uKWywbO3Oq8PBomNoFGDpfWFeEEy/Cr3Nkfck61ub3cmSZi7wPWXOrHKXfsLPJj6pjinM",
-	"sYg3LgMnbH5E/+nC4HGtKt5b07L6SpC6ocLllWfP51wxEw++dgznQehC+SIS9hDC28TGI1/ZXgQb4ruF",
-	"HUPTm9COoiUkhSqz7yPoXTURcZpeo0FPl64fkIB6/din8QJvgU/LjmW/unYDD3PseWivFu6sYDanLqbV",
-	"4q9IeU6EBUXrV+coQV6cYublQ3aD7i6jWRDm7lK71emMOtawDZvWQLtbVstqW4PxoNMf5OyZ1eqMR71O",
-	"rz80b1y71e90B+NOHzatUf4G9lvDTm/QGYwyn+o20mpZ1sAaDAfdQa9wP3utXrdvtXuZBeu2ddSyxqNe",
-	"rw2bbavk7nZao954NOj3YbPdLrnLVmvQtfr9zqBv3GurNR5b7fZoxCf9kGvVF7UH1bS/lNUF4fI5f2NW",
-	"ZWivhksaeGlOocbyAX+2VW2FDCFoKtvUTMhgbzEoKvhBgQ0IwESdg9ftyagcU/wvOTNulvON7tMj6R6o",
-	"CRGWzR9gbJ8BXn3o/Lot6Sg7KVi6iu/IDqpaBwJ4i8KKiXB9ndC0izrPT7jby5hNjaoV2kkxzUFsUqg7",
-	"kM8uc6w15Avzfe6x1e6Me2OmeMDYZv6J+0z5/Rdoauul7BHRtTyyVkbVcogqR1uRaHWiRQn6Uxiwu3VJ",
-	"JHghMBCDVMJMTv4FPS9ogJuFjc8jz19/L31Lc75TJU2+p3fBnAlgnXGDG+AEEI0IboLw6/fgxe3Ks10f",
-	"uDFwfRC5iLuAGIbLiLuQL3Z2MCBgLk+lrLQw3R7hLr+gCyFgaUAFWC7xwg0iBaLQBmm2R6OcVR272iZl",
-	"BrwwR15IAK2TZ9GOS3EtHOZGd+g8ewZ5DBoyewe3S0kNqrdhmNFDnwQ5A/N2nTPwT4lv/xN3RZh2+o48",
-	"5OyaMeueNeqSYHDKqnWM+g3dEimnEdPsVG0y5qqcoEmSp3otkvZkUB1Pw8QvqT8+9513if8IWiQZaEdW",
-	"r3eJv75iic3oYcJwMfCheKd3Fyon3t9HKspfQe8UCD/9KL3eb0dRfKmpVcu0I+WALekE/AXiLlmuorIT",
-	"xjwcCFekIKdLKkTboA/uoB2CwHNak5MH3vGFeibcgYBGOFYslgkhMeEsAtoEZtJeALBGogNwr4pTUYqW",
-	"haggp2WxoBWgYeLXm9WJQNAsLS9t37kMExK2KILuXAc50vZcr6dydaR2fLzgGVOZXEOQKjqJhIlffAxp",
-	"hYmfdxQZDoZj5ucpQ8TpASj/PJSTXjCK7ZBPQsgSAm9XbggjaXbDbjq7NDNGtuWV7Wqfp5eRs688O4ov",
-	"YRgGofJCyYfS41lUFLPV5OSvIAF2iATHAnqrq8TjKNbi4AoCT85nIulWF9pjIH2YsOvEaH615qo+CMFi",
-	"xEg5iatGohjlSRnqxaqxICwuZHUXYXAI7SWPv9iN9CCzqCxADCJEFtMZCWKQIQVShEJSEBJcTIhHPLIU",
-	"AZzGIFR6ufyKNtGGoeJvtKkkNhM2KcA3kDdbEDYyul7w5EdkvucfMFDxChA4CQTRGYsAndyPwmYwDLeM",
-	"1MGPz5jRlcUJ+PQgRMVRKgfoArkkEu1hsgBqD9tWtzeyhv2GxP/uH/CeyeOGiW8eG0lC48BMAuYMrrAZ",
-	"ea8kgZdZZyroRDknyzgiXGTxRocf4OEVyUa/F4UafaTIM/qUHasu7RkpNcReSDKOPmPijUo3nOWridMY",
-	"wRs8dUXM0WZMiiF5JQow/FvauwYXW6itYSsprI47efA76fqXqzCYhzCK9nU7xSlm9lQa77izws5GMVyZ",
-	"eS56e2lZbfPe4g5yNnjQIAiiwZUN9p0mxUkF6iUpeUVKsOVhhX6H9dtpxhMNRui2GEOPFtNCW1I07+xD",
-	"1IY9pZBYRnOyIw9VdjiXgI+7fNi7TNuayTjtTbu/aV6p3O3dYB8NmJGzga7PNkuALIW38K4ESyaKtTB9",
-	"ssxUty7mozkAz6WqI9C3A3QHerG9JrhpY/QN/QvRnjAx1J/vwNvJyZklcqAY3pJFkD9Qq2vbS8hLejhD",
-	"++X7QWwzkf3p4uHhgiyl1Wod0opAHDj2HeI+F4e1Fd8XzpnnLjw8ipXyLtZAr+nMh6Wo9r4SQfwD/Cu4",
-	"ATPbB6+plQTcBQnBrO9N1LIGX+BarHlnD17DkXe+lH4jbe4haTn3LBkTr8/Qsfj63MDnL9ptfCaKbY8/",
-	"67aNtiUzhuzHIVbe5pJHWLb9ax5eZSawr0fYmpHCCXzIkODTT29/fXEhuV1IthZ8n/Dbc7xkCujV7Xv5",
-	"k8YjxQsIbqAdL2AIPPcrvrL93vbBy9D2Z240C77Pc9Bwn5smiEzMm8vcK1IwmfhYcoHg/G72kradw/iS",
-	"5jC5pFOVuiFXddPAE9LoFYzF5CfpGl0/zefkBTM7Myechk5fzSa7KsakGuonqzBYwTDOXkNJsxunY2te",
-	"y4OQS7WZQQzrxqUN3PgOx9YgrgYbALbmLXlTG+DH5yzai//30MhONPHdeNNJQj9Z0vi2GfQiFzHaBvYm",
-	"L0LoLyAa4SIzGfnBQwbGjE3SnjlEpa6Ebh6USJSLx/UzkveYYsC55lJTLrEYSaUKodRIJrlEUkgiBQRS",
-	"QB6l8G5D0mgUYR+nC91syiK93O+DAiQzhgsfPmgu3Vxs1bFd6NauISyqingyhkYBQm1n5B/66DBc4BKb",
-	"4JV5zSzCwCDKs4famEMOayhgDLlsIZcplGAJdTIElVDrZwYPElhKMALW4IGi4sU6gRRyqMTONEyyluIo",
-	"QkQj55y2DyIMo98etUe7CsNgg+/Ied/v9PDwh+TiFY0sItMV2e19ymWNTFZhPpV5q8xTxUlxPipzz3uJ",
-	"YYotOIPMzKoKR8SWAcL4DL1TricxPZXnPTQk9iZzt4cS1sjdhMEcKelISd8mJW0lDKlecioOQ2LjHSnr",
-	"SFl7Q1nbDANDCD/ervsMoePlzPa8aLuhQYxCN3eaKTMWf559utiT0K7jzm115wzhEyX3TB9Ase7ElWgL",
-	"OhX0+vLjx19Xo79e2S/DL+H7L/O/b+MfRz//3P5B3shNmL8dzpMl9GOy8WTdSUxSsWEgPiBWd5iQLAMg",
-	"ef33kwkCwre1aC7V+Lq1QVNPc/mCzP+29h3h+kP+oqn6EzF9dk81f3Wae6P9S9pnMl268SXeRMJiqdzV",
-	"PcctM9u9Q8mAOWPKKSbo2WRyktW9J6jthKrf7DNBrxZw7ngsOh6LFDWtbGwQuHHjBXhJN7RKUhiWfERN",
-	"DhMmhvyCYVKUWPD0PuVTJUpTpGkGK6R1p1NPKyi09Knc02nkpnN//MITLO3hOpUnashFuEEUmZR8Yc8S",
-	"E7JKFTvIq8KrmZlDCEj9CSV7hTZpCe1tm9XW1JmR0hPq5NLkIGxGdeUqbKUlJUqWmMjwMEoPmsRWSl0J",
-	"c1mJVzDejPewXPkHw30qZ0AVK0ccGY/KeHaQYbFMClRewkGKmU2pEj3WZhvcQnLUZUFmVKHchIn5LB83",
-	"U2qafE+fKTWPJ6X1JzRcCRegKJFwr1IJioYh/96bwHGv7jZjbkvcRwu89b07/OozA8dnfJFmCsknLnTq",
-	"53/1ZwoUQbKjHIGVue8bAt8j8y2fFlAiWSndH8VVygeQjiGH3JHoLRzHKfDJHSfsS1YOYlAlmD750sTy",
-	"1cSpQmLRlIoFuAAEDBEUclidTnhIM61ZgtC+8yWJAAD98tmaz8Ui/CacMOEDSZqXCiZ5ZrsVUJutqki2",
-	"Ef5pkmxszPpFnMGscMoCMvOLErOPKsnAcnlxcVk02j+YQi/ACRdrFYUNdZ7PgecuEQPw8fB+spzCEE2b",
-	"VgdFcnsKAdkb6LTAL/hzJK5D259DMIXxDYQ+aGOrT9uySOVj1JlDsvsBNwIdq4VZHV7I3wkM7/hK8ARO",
-	"xFnThvgOHFuC68dwDkPdGt4jig9CB4ZgShULjuWfQewuYRTbyxXbDVb4FHy2o9lnEp0ezaCPa9aRftAS",
-	"PqNRyGv0l/jevBj8Wr8YPOuTBjYAInFr41/44UWjzE7NkjAKQjyhJMLBvit77voYQdFirmIYfkbQtn1G",
-	"CK9/AvHCjtFWuD6MSMnQlWfPcHMEDM+N4hZ4GYRCBT/3Ct9lXtpfISv2TQU9Me3BGXSvIdpsBssGoODB",
-	"RsNg+uXyKggaZLgomUaotY/QxvMw7rj+zEscCPCcz+n3OFMxBn8cgCsYzxYEJ+FtjFYK2f7hKRt3AHd5",
-	"UpEICkA7hVdBCA8MtmTSBcDFRv8giSoAmPR7siuLg8iFK9k7s+XrU2aLWQB1MOyRXpyKpG/WOkHAwbY7",
-	"V11lsCIF1isaKuRxWkgDqlPjpLNY8nXo9E1lBUbzhdIbme02CspHc132c43tVUgeohZKlxTNQZcqmtLm",
-	"5OmyVWoySLdoDJcmWWIP+TVJ8pG9+sRyfmxQkyPNLi9lAwGfCq/SXphKWYgv1DvuaRJoCjcW2aa+UO1Q",
-	"RZXyCSb0+oMjJhRVhql7u6VL/WINE13LWvEBlypJE36HEU+kkOEMNMzAiC+Tk4UdXS6DkNeCLD4gIkmf",
-	"ymjFmcxE+Cf63lC4jjZ+lur8OSZOWmaWNNnK+S6glVmAzZaFNI9DsHVKsNmRsZOOvk5RFJYd66jUlbV6",
-	"brcK0j8PQ5MUylXlWEBzs8dXA4/ZGCpPf3u6aZFqKoBEDxAEjHMJayg4ztfRoQw6b3F15KyAKlRW9IrK",
-	"cNDuVakaoiUcnXKizU+iKCVahaQmtTRHR9ErAJqKH0Z1Q6tqVHd/ssq1qUyWy9aWEf3l48p4k3ueyO3B",
-	"aA1+BePt6go3Cxcbadwo1RaIUTjarklYni4bujg4hQNtb6JTqqsMqcN9T5WGU87Zvt2QlVRUlZDhRaEr",
-	"qR9LFBnGeBYqfuqvm1kkd6VlcEo714i6lA2c6xb7TCk7eRSl34YoTRmbTpjiUKJcccq4kkGsbhJUtJYU",
-	"5VFFeycmaZhT/UJyWyFMh3asF4KYjjL6GNm0llpQKrhJ6wLRRTwJJeayoU/8pRoDZUgx9s9H0CeE9eu1",
-	"iVLKRA0hUA2WluyomDxBxeRRIshMGg0PIdtEtalsMThFYCwVRfYSf7iW3rOwY0nvsH0H4HEfK3DMoP6w",
-	"eYlzicyTWVMdOoaxHcPYjmFsxzC2pxHGhsVAPaFshO/u7XGIiMY9qRlR8YRS1/kE73a5QwrZzLx4tlzr",
-	"pdZ2iYdXDZibZdRmQvyKriz34KGsqfh8YTB1Zg8MZPxtBMJJYTel4p/wMouCoAbt4XDQyGSE1kbZFIZo",
-	"7c8czWFD2TkqcUO6DzYMHCIcsSB6CH9U4EfEc5OPBtGaZ4PTe3rSKuNdRAS7qW1UPiegHqlqvtEZgcoM",
-	"/j3ZuZPG+qcHshO1nRv4DDmeVp8enRLSXZgbxnRBle5ryUkJ6K6Z1SM4RhEmrHl3X6ScPdc3TgU4H3WP",
-	"KqrHWs5TXjZDjVbNVUp2rpMoiy3STIrcsABQZnCegURFzSVPOpYT7wWivUisV/Ut4pUbHYxrCts8WRsm",
-	"fr7B7R36YD1DGwRh4hdLpON9zKMh62jIOhqyvklDFmKvGxqwcDFRwmVxGEmwXylK9qnY6Q6y0aHF5yaI",
-	"Svz1Ll6ihvVqfnSu2tRQ0iw1c8Qd0AR1aGJbsCWFiV/yUiXN7JtnnRn2rWEn5/qXvuRtpQt3aQpgoNRv",
-	"Fr8IC+YlpQNW754pGYHV12Jq4ExTOUcwH1y8WyglwM1cfKOZcAFJhdtt9ZtxEk4DaYVKNly1j2yp3pxr",
-	"h7PAgZdIeQpXIdJExVqxG1wGbOje4Pt3uj7l4EHhBUsaK8ciqKWpQbvTlQbUlakGvf5A+kgpWQ36w7Ea",
-	"jNAoIpsSN1BLkM2g2xlbe0g26rwelWzQ4O0j2Rwi2Zgt7hlpoxjcM2S1vr09JEdsrZm9SubnEnd03+Hc",
-	"0uslWE38w7lv+y7xdxSU+y7x17lnS6G7trb+6Smq69ng20KJs6U66WX0/GI1v+StWG0ta579L+dAUPt5",
-	"IO84IKymyOKbVzZXPTsUGnM1nDlXmSlQZMopMSXjW0XlhRfQ9Au1FqPGkqOtmDSVQi3FqKFktJNeOnuj",
-	"RpLVRrShuyYtxBxFq/WFZDwkqcZxob3dQx+mWgaaNpHKvG7DT9Ss+dDYnIceLgOVwUvqUvMM8Lthqmmp",
-	"8LX4agmmSj6Ryu/L/HWv6u/nVk4vwZLz+TF/u5Wa5VupHd61Bj1rdxWPu+0OHv6Q6rLuae3q407uaie3",
-	"Uju53u0srp2Mxmsfd/bxavcygG+xAiyLrMCDC4XztlMHluHJ5nVgtfPOPkRtpOAREjuCd+RhT+r8Hnd5",
-	"17vMQpKMZJz2pt1f4Q5nzvZusI8GzMjZQNdnmyVAlsJbeFeCJZO7pML0yTLTu6TFfDQH4LlUdQT6doBu",
-	"qGBbCtz6+rXCxEwladmtYnab+J5fIaYpSwmzk+4Df7rAVUKN1Yj3d0UgDhz7jlY5PaSJf184Z+4uPDyK",
-	"lVydNdBrOvNOKaq9r0QQ/wD/Cm5wiNZrakvAoWAYs743UcsafIFrseadPXgNR975UvqNtLmHpOXcZ327",
-	"Hauh9+e2242MD7fbNqFJDobsxyFW3uaSR1i2/WseXmUmsK9H2JqRomyZ5loM/k/CaZqa/bOBJVJYBnfn",
-	"iKXLxTgQXsRcDUihFc2BsaS59LVcSBxUrm8udSbVOs8mqOer4rXPlU+kSuhqD9ihxkujZ1/Lg/CC5prP",
-	"MuuuUkFd7fChkZ0orbC+0SRpHXYgFWIHSiX2zGTkBw8ZGAtV24Fctr2oAAD94+JxvVc0OTaiGHCe6/vU",
-	"EIuRVKoQSo1kkkskhSRSQCAF5FEK7zYkjUYR9nG60M2mLNLL/T4oQDJjuPAhb8Rj1C4ew11qStaWG42S",
-	"ThbTwRn5J30o+lU1JSv3yrkqEXIqOHOI2EDC5Qm4NvLNId4C0s0l3FyyLUG0dZKsSkr1k+uDBJYSpCpn",
-	"Hpz4F3W46EtHTZEUgwhnzznNHY7jvjeyhv3duXt7owEe/ui4P+7k0XG/ve0sdtyz8Y47+0iOewTwwVNy",
-	"6TI8OTruj7v8rTju2fYefciP6Lg/Av3ouD867g/Jcf8oFLsVxz2a+fDouN9vDWddxz3b3EPScg7KcV/v",
-	"IbbIca89wtbhuE+ZwNFxLznuSfqol9T6Hp08XBQXwgzxxXepCGaVq/VFKfRO7wkfyk1LW/nyfcmClws7",
-	"Bjd2VPsN/YLkrmHil6htSeCyN3Utq13PF9O2bnpDv9ZYk1N+CfpJFagsdY2+dG5V8ab4vtyalyZf5AEi",
-	"xHOurmQXF+Z5YqqtXZhXs/0UJMh6hDvzPCFW+TvzakafJ3N3PnWK52TnKczMY8zKU6UQpyrMcY7cKuJ8",
-	"k6KbT1OK55beXFeGb6vs5qFk9xHKbT5R7WGbQavaIpuk5l0qVPAPTRWNvU0BVLJ6pibXZX71TAqVDEz0",
-	"4Sr7oAgJkFhLDVKLaOYgBi2SedSZjjrTdnUmsS6nmUftn2ZFy4Hq9CpeCrQ+BauUJeWUICSSd4aMhvj9",
-	"BhkNhfrnQqGCHShfZKVP0YBC9ogqQETHdSPwWfByft5LtYgi3yMUFv8Ifnv7/sO+JizEUDhIO4sw9UOy",
-	"sgzancGWNQYi53nEtl5lECYiqwz09TB9XYPiILzaPDXh5OSvIAGEB7n/gWAaBF/T6t4l1QdqpbO9Yr2h",
-	"auLBPDlM2CXhlnskiaMYrgqrBL3HH21SKQhXDUl8gIfbTTVuIqVghWmsIZ6PpYuOpYuOpYuOpYsOv3QR",
-	"5vmbly+SWG1aw2hfTaZEHH6j5TBDsunFRwcMpHIVuHXHh8zhAY1a+wHikmxlzjEis4zi4paljhNk5G2U",
-	"ScJBYaXrJKUhdkVVX8QCJ2nMnbkq0xYKw3DtXBfcVqF+TEH9l1I1XsiZaI0KMrnFYZSAPtNN3pz1A+3r",
-	"zM3e4mLkcoaFQ6jYkkV8pWQL+6Cmmi1EauUUbsEf5BzU0OsqddE1h7LTe7yo4sAzxD43r4WuntJ2aDOV",
-	"J1ViMnUc1LIzwQMXR8HRXdonKy7CiPVD4fDC91g9OxW4wVFVK6OqrRVVJ6TcEZjvDpS4Yh2ucpFys9cZ",
-	"AErP55mFa7S8QsuxTnAVa2sFmlqBllareblQMynyWeeYkAtr2Rg0MbPx2WhhNmhfpTSvAq2rjMb1sJ++",
-	"YTHqDuO9NvRuDV2nNss0V4JOb5v4LoHZWP1RsFy8IJ9mtKI6NZnaFJGalArWk2JOIqlhdOakaRB40PbN",
-	"TfF9QF1LbizepiaT3VDRHiXrMJLmDiimlMW0ZLp0EfkF3mWQxKuEIJk+NOE9/vhDEHhvE/Tlh2BbUaN7",
-	"E8WwsIkN1Q1hRM4PQeABAimAgRdFbuDvfYSpuHV4lw8l2PTPBfSpbr6wyRZ8JlL3jCe0itI7ZJ+Je0W5",
-	"W9ZCUMYm9s8ahP/cIHgGfWcVuD7xQE0hSCKID4qkCXHvkBZEr03RYWZ7XgQCf4aOl/DunyEE2GDOZHwL",
-	"PPe8tO0yiWLUPek2hg7Jgxa5/tyDzGBPTOS7rJspnUHwASQLuT0OsxWnmZP6FX2Fti9VYPAPen1X+JD0",
-	"RD4ZWsCB8xCiQ6PvgCjx/bsWNzCxvJ17HbAbqfwgr8ycdGVVNtCKYDYXbhbBbAQyoBSSA2JtYruLfQsB",
-	"1hBKce066Vgm58JjnZxrQjvK4G8F7CV2yLWChDaNKe6PC2KKi89v65csFYfXxgW109f7FhdUNYT4mLZ3",
-	"52l7y2ftXW9ya2Syflgvw685bXV9kWXbLWl7VG/WVG8OtKjuU1d8Dqy078HrStvNULzdZEP9Tq833m6y",
-	"oRToUV1phvqdniG1ar9r9Ya1pBlSZi3+JMnCyKIJMv0ZWl9/77yw/3pj3/7qeNZ1999/fb0dynAQtS5R",
-	"27pPVSyjhnVih/NkCf2YwO1+MhFE8AQ9m0xOslrGBLWdUGWCfSZoAJPJyQNBG4bwRnx/eLgoyo8zbhsS",
-	"5HR6ugQ5/YdHyuOMUHy49TzO6VCjXMQ8pJy/9zUhr6woVz4TyCcBcVJc95f1/XtJwRdbcI05M6sq2jsm",
-	"BaKhG3qn+rekfqs5+h8akl4tq9UPJdLT7TCbdr1EVZxNu5jlHynrSFmPTFmlspl31lbMnlae6/pUs00z",
-	"QHa2kM38uMsHussls5l31krTy7b3mFh7rWzmR6A/ajbzzi5SaH9YwPxc5oeyEKZ0bZbGfDdTT3XKGjLI",
-	"72YF2E5xgKBvbZ5Bfo+55FYyyKOZ15xB/oP+zJQ5nwA3AoKB7GV66FAs9Y+fa/5w9c9NjMDDA9NBNWbT",
-	"bmdsyis+0phNe8NHzDZfr5GnKNu81sRTR7b5lGEcTTxHE0/JbP8DY7r/XidLloNBZ81C/XkJ/t/ToFMe",
-	"bozzpexXBp3b5izwr9xwaY4Z//gj+eIYKX4gkeLChn0IAu8pBYlTZLVLhorTz4vCw+lnAOfy8e/ksPDW",
-	"xD8pTUz0uorxkg9hHVpK2uaFnM1uCe3XvZpqeEUAjvGKXKsBNwjTGM27Ec7mQ01BZJ9vm4yV597V+pDy",
-	"+1yWeEyhdUyhdUyhdUyhdTgptETuVimFFr7FyHgnY6XoQFXASPEnRzZ6ZKNHNnpko0+MjSLetgYTxSzR",
-	"WO/nI6kdiDo/2dYRMh1hR8fHjzjAv0JCdzzhCNjk5MYoBOPifBWTtgD6c9eHLUk6nbp+tELDmC0gr8kX",
-	"2wS4MMSuIC5NoQLK0nYY8DJkw8TPgSq1T2wLors1f+SnfyjOaoWTianwvKf51BzowRhqQPoTfkGhWmxg",
-	"2KPEX8LUKwGKNKOwahjUzFcwPkiYVOSB2LlAAWGgOVJQZavA2AIp81kfiDSidWs0FIxvouAqMmXt7njZ",
-	"JS2GtPd9skLT6R8mG6ZrIDoFkmY6ozV7iS3Xts/v6yFIg89I4f2xRkN0BGdJ6MZ3GAeer9x/w7vnSbzA",
-	"cQoX6HV4zTCEXKtdxPHq7PTUC2a2twii+GxkjazT6zZ2X9EEJepR44fE9RzAs5aQIwSaLtbfsXuVXDFC",
-	"WhbG7RZHQyHbSfYU8wu0Qx8sghu0YnRcB3biuEjxR7/RISoIyb/4CX4p9o1+a7p9hZ2nPH039ehHOIlL",
-	"6EboZGIjCCPoYFRqYPjipYAb1/Oo9QDYgKGjMOyPCzvOGZU4IE09Bj5Ei1oGIT7JOO4shg7g7smIGCMQ",
-	"eG0vClgzcvAJpvbU9dzYhRFal+3FMEQnvmsE96/Qj4AdA2jPFmAVRG5MPVRs2nwM3exhDGxwDWdxEIIQ",
-	"rkIYQZ8EvuChqEfa9VdJzDFgCgG0I9e7wzdQkyV0wPQOLO3ZwvUh8ND2ImALOGJ78yB048VSRJIXyyl0",
-	"0IFRN7M3to8OeujE2owT3N+XYIrNPLHtekEIbArnOKBHTOL/nIE4tF3cwLFjWxjvJe9LM+BL10Onh5AT",
-	"Y7LyAtsBTjAjd/ckAOCP8OHiCtpxEsIIeO5XKFIMWrgwpjQTD0aFyIQ6OEULZRvgLu05zKDYHPpIaqBT",
-	"ug9vyEfCWK/Rby0ZuvQoTx5PceYjcG2H+JjNNu/adj176qWmgue/vW5J5dmgl7cSijnwNm6kPnD3SljC",
-	"zLOjiNQidWNgR2AVIKbv2p53BxZ2uLxKPGVAIiJJdX0pkRL2xOuY2VocZ+JP/HfQsxGlzhPXgWfg0/sV",
-	"hLMFoK2YYxu/jU4j/LIZB0308hmxSyBBjvvDa7h253jyr2jMAJMD0Qlm62RdaP5fIZIvxDpIBsXiH3F5",
-	"9SkV56wrvBli8w+h7XNgKL2oL0t15tnGrtJX5o5+zA7MRPLPkdgtEvQ0MyPvkP4u1d0fMJwGaq/X5GEz",
-	"t/cLHuzxqOJGh3NI8ACBjStYh3CtSXmAG/gC2s2QxFob69CwfFR1s0vssNxBqnWlHZXcWbkb6j/PdBal",
-	"ITl5e2mS4Y8vBXUbzeWhssUwfSHsLn+4/h6nI1baXk2rEnT0ONJeB1cmgyntqdAVBhXAKzxdH75o5A+4",
-	"j5+DaSUYI67yG7HsQ0fqJuL9oI8Ke+GNhayyaXOWlTavF5af2rAa9jpfeuAAUBM8SD3VvPaGloU8RGqH",
-	"AcAb46WXEQGPojh+4pqjPmCOpxR6hrnJJ2Fa+hYiZrdE1Eba5wZI7cHKuPySjlkWcznOiYOVQjViG5Ub",
-	"UntpbrPgxkfbph+xSY0R+ZRCEufIPZTCr20fB3RsER8MANccFLaIG4oChzxYH2/weJUQR2j3wnFjtS19",
-	"Vqr9H3boarVW8YW5J2XuJfZ0C8cu8FeQkIAGROFYNi4g+PRGEmqkg2cp8yFaDGJKvgNDxD8ccIPYERsp",
-	"hMJoaUSEe0WZSJQGTsQLuBS4CGm/Djog4n/DWldlCLjhWhxBaVmCJSgtSux6wXk4CpawniMxsGdhEEUg",
-	"gtcwtD0ws2OIlEuoVy2FY7NC5sv0zTN5b9kpe21652OucXjgjcsfHJR9SM0EDTnFss7OaVexcyJqWsHw",
-	"KgiXILajrwTkn9Apgt6KIfId0y3v+Plvr1MxzUW5YCXgNlMdzKXXRqCn46kwF18Uccz0W52oV1/my/3n",
-	"4qwFWpeel+xCo0Nk3pm7msNYAxzlabnmMlg0b8zd4Ised5qJZF8U8TNNJ9kXpTvR6Uvll5V++ZbRZlkF",
-	"XRpDbY001VI2GtndYKZ2wlxYjCKhdYH2SVRSDEN7FmMa1jJTjaKePjkNrmF47cIbgbDFi0HrUTUJxswY",
-	"3NjTXKxV24qPivBUbas8LUIutbny1NycfFIWlwREYDH5pbAgtdihncZ6Fm5cx5azrjfY8zekC3XT+eN8",
-	"rvmGz0Dgl8LTUs01LFd5k4t7mTVIz8o0zbBa+XkRAmcmoD7OUf7IN5UZmjDBddlZukv5aPyOWSqJ0/kW",
-	"zhKs7IeJDwLslyYXhOtA6DDxN0FmFr4gIDJ5VOhvwEt47juaHpR3+Qj9jixAQGT6pLDZe1pMU27KnuYi",
-	"sTTp9HdRk7QiptCMPivCd2lA8ZG5YWSsCEQM62qy5RJmPnmvhEfmhvxSV3lKk0tFCq6AtKBXLpXh/c+n",
-	"MHp5DF8Wg1HiYUcPJTTs3nmHSC3EL5f8CY7sZsVhSNVwfgUYkyM7ydPsyvRmWlqS5hOVUATD8enjXe69",
-	"4CxBPGtMfNZNmba4CbEr0nvLaM8B3fS8+mkqgjyb+On5EEzvwAqxCH8OPqt5xj+3wAcCWXzAI+arKQQ2",
-	"+PQex7A030OfZr+OLr5jeeEX8dJrRSs4a90s7Phm3grC+eky8WJ3Zc/hKQl/aSK+SI3bLdTif2WfP6Pg",
-	"xzvyNgnBr4FDTCC/4WzZ4P1P/47AKgyuXQeCBfRW6OCdxCwWIw5IdHzqewLQju5a4B0DENrLif9JPgOC",
-	"vxN39hUfFPNYL+od+5Bw0EhLd0xsik6v6pyZSpmfoBfbKg1R/aWJM+U0y1Kitqsw8ZuYJEv2lUKLEJ/O",
-	"Zh/l0rVwO39b0TrA9gJ2z2HtGB3wJohi4MBr6AUrxC8WQeIRM0OQhFm/r2hA0Pt+1d9NZgzEuHQNQ3x1",
-	"IgnBlN3i8OEN+pN8JyCZsNaTxokH5/bsjrHILKbR93nO5I0cyWs4kUWnrxgBdZGZPw2ldBSzVuq2TJ/h",
-	"69AZQ43hCIo/TOHCPvqFPHi4eHj4/wEAAP//QyNyPFCpBAA=",
+	"H4sIAAAAAAAC/+z963IbR9Ioir5KfVj7hKVvASAAkuBlhWKOxpY9mrEtjSSPPUtgEAV0AWir0Q13dZPC",
+	"aDFiv8P5dV5vP8mOzLp0VXf1BRfeZH5fxMhE1zUrKzMrr19a02i5ikIWJrx1/qXFpwu2pPifLzn3eULD",
+	"5Hs/YG8mv7NpAj97jE9jf5X4Udg6b70kgc8TEs3IR2jGL54deNGUH9CV34nZjMUsnLKDGXx6TmiS0OmC",
+	"eSSJCA3JmKoZxt1Wu7WKoxWLE5/h7Prbpe8Vp/2wYES3IK+/I8mCJiRZMAJTEZ+bc8HgyXrFWuctnsR+",
+	"OG/dtFvTmNGEeZc0cY/+S+h/Jom/ZDyhyxV55oeEs2kUevw5mUUxuV6wkCTWMnDqa8qJHNuY1w8TNmcx",
+	"TFy2Hd9jYeLPfBa3yfXCny7IlIZkwogGo0f8kLx8+5qw0FtFfphw586ikqOCScQ3An3ULACr4JquuXEe",
+	"XdgKHgoL02Xr/GPL/tS6KMx7027F7I/Uj5kH7X2vpVdiAbttnywM5CcBjPTSAiTPtqaH+dyJqP8TSyhs",
+	"boL/JnHK2i32mS5XOMiXUUjIqOV7o9Y5GbVgpA6dTPuDw1GrLb6J4cR3e1u6SbZeaNYfnp31jo8Ph0fy",
+	"s7kDPU5yqeYZhTejsNVuhXTJCriKSCJ3BEDTuy67Ye/YKmachQnP3RmB84AkUxoEiIvLyGMBoaFHUs5I",
+	"EkUBL96sW8D8WqS3ZnFNavwCxMQavkugxZJ+9pfpkgQsnCeItsf9AZkuaEynCYt5F2G+pJ9/xAat8+P+",
+	"oN0K0yCgk4ApTCncFjiPS9/jYlkzmgZJ6/zjRbuczkGPSjL3+juL/JBk4fPcbmKmbjfVG4tmZNATuJ/r",
+	"bsHie9EgZiSKPRYzj0zW0MaPxREABD2aMOKHhPIpCz0/nIu2AkR+wpa43QIslvTza/Fx0NOgonFM13dC",
+	"uPyQJ3E6haG5eyq+5glbErNhRvkzdEw542VIczg4GZ5WoQ02aIA4S5ZQjya0uNL3DBGlPySf2LpzRYOU",
+	"kRX1Y57d2AmzjpiGkiTAqn2umqSczdIALx1PIpiYUM/zYRoaED+cRfFSHDidRKmAghgHD58IKKWAI6Jp",
+	"l/yDrbkT9YZHBlBIEMFcoUdw9bkeooN9+7CHgGUJ5Gwq/mG9Yj/SCQta560lXSFAgXgVofn6O0UQsAGA",
+	"K+WsS/4dpbgspHQLRj7+CBcU25RIIeLbAVzk54iOSUQ4YwSoZzQj6yiNCb2iPq5ejtQmAHzGCHz8+BOu",
+	"ILpi8ZXPrtUsclz1s6CSxia43MBSwKeASYJPuPAdvjQmh4PjYRVeD46HDbB6D8KDW25wiAztFnKoxpQX",
+	"WhMWwvo9EoUOqJSQ1f7gFDtzsmKx1QV/lF1ghvWKcTKeRh679MOExauYJSwet8k4ZknssysawB+zNETq",
+	"M0b0GM9XiVjxuGvS1yhkb2at849fWv9XzGat89b/OMiE7QMpaR9oAQAX823ksdZNe5Mu79TKNuz3vdxE",
+	"bbff7H4/vP3wHnfburmwmEZ/cJrnGs2lQrwE9tkrkpDjDAptDN5tUGOXQLkXUdIS8apEyXIp8vTs9Ojs",
+	"5Fh+hh2Lrj/RZEE+pEkU674GHKAN3Fv5BWEi+s1XSedIdzGBJL4DiaQxXIYVizkyjSVMlcBUXfLrgoWE",
+	"8k/MI5T8kTIOXdvkOvYThsQ/TkPydp0sopDAlRCcil+zGK+e6tHVK8Bzgak/wt+EfBH/4Kf1Sm42f7lA",
+	"XoY2N/DPhRxJnSwOpn5UZww/frmplLJdAnZ2v86/5ERigR0umgdfNO2ZMGDBHpv5IfPOHXTCIHz5b/VP",
+	"JvxqoC8slRgj4BoKqFzYob7WhV3OjC9V912N8EbPsCV8NJk04KIX0QwebbuDBI1aYUOQZBRyXyefcQNj",
+	"a/rHzc9ar7B0R98uaPJtBKQJ1qgA8C0Ngjclz6r3Kzb1Z2uUGsmKxok/TQMaEwVQcuVTMv5iEqLl+lJ9",
+	"HbVuxiDITBm3hS/52KSJHkiIGjZcm8k0s+wccdxuqw5wOO5FY/hI4WIVsymQYkXk7bVWPk5f5p+m11rT",
+	"pBbvRYy3Scr1U8wA1iKKOBNPZqCoi+jagGE2Rnd7udCE4YTh0Mzrkp9SnsDftPOfNnnZ+d9t0uucobgy",
+	"jcKE+iFJQ4/FfBrFjOPaPMoXsJFrP1kQmhcw8YngXOaKxnTJEhbzpoTlbdZjy/P9iXFO5wxuN1yBalpX",
+	"hF8GM3WY4sQk8IrKyHieLpWKtDic/uw8WwRom1BO5ixkMU3yeOKH5O/v3/ys32g/RwnLrwxwjIRRosRt",
+	"NRQ80HwP+7fxFJd0TRY0CNKpH8L37HSwuyRhsAB87+hFijPqkn/BeDQRb6psY34o2qMcMGGzKBaoBtTF",
+	"GmhPmLwBNWgbx+PCnDK9RfawRBJfMmMj5ifH6JJv0zhmYRKs2yQKg7XBAonPCU9XqyiWSrLNGSJKzy6u",
+	"uNFdKcFhDYMyNG0Tnk4XgMb6nLC59eSpuv3VN/imqHCyO/xMl8zD5ovIn7IyfuczTqjYTXZ7+CJKA0/o",
+	"DX5BzahgbQ7ORgkX40wtlC6nLvfM9x4Mdm6OmO8YPiG0rCZRoghU4FgsLNFKyI+8oCchSzFel7yTyyRp",
+	"GDDOyRjAcYnYO8YHvFo0/iaAIZHJq9RpGWpkcwS30GEv/Tv9XTy12CqgU3HlzOUJZQ/iDjTLCHI0IzTH",
+	"xySWayGgguc8sbjHwuKyc2mXEwH35C9DEq2kshgXAXpJWIV4DPgr1IG9jaMr37OkfFOznETE82eoQk18",
+	"ANqEJdeMheYg+u5xmCWOAuYEUcxmKaeBG0ryo7q0pUzIN3XBHpsGeNZJRGLGV1HodZvcVlhiyTKiQB+W",
+	"XAonNE0WUdwGDEmEep6z7RWe4mbvxC2LcjPuyGlMlbtoNSXHSkg3qHHdA2oj+qyvgCLPTQ5sb7drT2ev",
+	"Ged2vBLX0NZwM252XsGx6ekZp9ZM/ewc5T3a2dRYN+0thviFs3inAQpiwVajwI3ZaYD8dbi5kMrjV59X",
+	"NPQyrK05kW/FWb+lcbLj4RQH/MA+J9vtrjjW6+Wedvl66ZTlfPj5Mo0db3aPJdQPLHNQi6ZJ1GqXSvoJ",
+	"ug5ANxKwKxao64uzdMmPjMYhWUYxE/eXkY//8jncq3nqe9qKj3/wgyv8dBBE150o7iz8+aIz8z0W+Mm6",
+	"gwN2hMokoWhTf26RfbHOILputVvQ1Un+5bbt3bzykwWLCSW/vPvRWj+R7HpCORseERZOI4958hsowmEB",
+	"glO3zltp7NcKEzD/9o8ISa6Q85t7z4606SPB7iFpHiKMNcmmVC9/JYraXvmrY5/sc6Lm3kELUAYinLgp",
+	"dHRjCZgPxto2g4tNx3d7V0nfC4NrN+TSD04M3YcAIKBhsX/xU/0pZ1w/L7S9t0Dc+JRNHrfbGaPapOqE",
+	"9wI7mMWCHPxQLS67nUCVykq9JH2upiY+Vw8CeL45fUDrZDJrcvM6GkBqfEamOLTbGaWcxfqMUDmRyRLV",
+	"dI3nzqfbMjZltHMcvONOo5oORjQpE1fWA/UIF84ijGZeYdLNgoxhaUL9otnBWFhKVpRzODY/FMyOZ94+",
+	"8Iks0yDxV4FkkxxeiNTDg9ZfzDGtBXaJ4DN+uEoTQBPUhGndl1hAitMDqMZoY+9c+TylQWcVM/DwGWdK",
+	"lC00n+VyIXhT+KHypjAec05Qt/Ia0wqZ7U9EmeF+WNQFftiFKv9iXLgm9x2oDmfW89kCOjhpwV1TPWpU",
+	"Hg5VXer5kcNRTuo/4SuJ0gTwexl5FORXQQ8R75jXFuASO1DmQI4SpeFIKMaJ5XbILI6W9qLyj/acMO90",
+	"jfyrLcqKOSbrhJVbHaTgLhAu08uq3+WunPjEPq/8mPFdPZ19ngfGtR8EJIzQ6ZHFaGadThnn/gTQVCyM",
+	"s/iKxThOyvH9gcSpk6RxCEC/YjHHG8Sbxwn8Evp/pKbHrbyxhRW6RdiYhmI0Bzj0N61HxwHLcbKBrcqA",
+	"f1sghLUI10tkI1a4iQbpSUH/pKC/Pxv0Y1N9C9Yq/sqk6oei587IRL2R8UP0iYU/RvNVHE2Kkjeynqqo",
+	"NkmQOYlVGI6SDH/58H3nVPIu/ZGaASwJTI0GZ/Di90OMW6DhlHHgLDEzvLXRTVOPIk5ey7I4jvDREXEe",
+	"MGluThCKhcPPNFpOFGLoGyp0E3GM/tsg6tu9u+RbIZyPAX/GEtNifEaFkXuTSlYUu3TElRi8rAQrtaU/",
+	"yM6niJdBNCfwlU58FGUUUuLEeCt8FOSBxEmhIIlWEEuzjHhCAv8TC9YSiF3yBjZ27XPWxpYiOmPcOTs7",
+	"O+v20PSLjlxJRLg/D/3ZOqOCOAS0uGLxGmzJOLJBIcJ0OREbxqZljhYSXo5Ls7qUkHDg5I8SIwUJyG/M",
+	"wI4cvNpEvY3F+lcR98WZvw5JTJGGcsbb8sSBdk8YmTHh5ksFQMXOYHotRZKxud4xiRlINsyzUOHptj3d",
+	"tgd52/KaVxwhA01b4mq5srwkwqFsoNztbsK3ouCOXbgfqp9Q5vRV5uoMSpQ4CriMSnrmzwgN188zac7n",
+	"UuS2hexROA6jkI3JktHQVHDIB16ifML0QEAW/JAnjHr6vnNCDYXcGExBxRFReeVPP2n1iOwt3LNld3TP",
+	"lRItNf2rG/tyZ3EWmSN32/rrnFS4fG/i862B5ys7HBrtxKs5jHRTQW4lNesSCZ9cJ39W0n4UjhX2FIGK",
+	"VBgXF4UIPDT1ibjnStXovo+d3MqpG/cL1ttqKzOjvk8XLlVtc3E771NZafbVvX5xK7bwZ8KBYfHEn3LN",
+	"swxVl6GtyavAVZtLwTuK4/+sZRDRQmmAsudSNohbpVKY5FLYjh2T/TVm9JMXXYfGfMiqMLDWnij3Tp9O",
+	"2QrCvlYx8/xp5ZZ+zZTNb0Vr5pE3qLrj7ZzgZW86G1zcS7paMRpnarE6UMQMaO1dr9LzPaFAwNU61kr+",
+	"Fl2zKxa3UZAgMaM8CoUFB8bF2Xgmo8WM8ATu7DRKUZDTEklCAxPvZAfAyFUaryLOUEEz8fHWtaW6VGgv",
+	"8G35OSHXfuhF1yTwl37i1NHdODjXKo6Wq2RjHBbd3EeFmykd8QNutXAAClcNFSl5JmYh/9OAzPOSjZkc",
+	"296T4xq1cot0snQMhrRyykhDCF4aHdb+1rhMMxrwgmJZhga6nhGYgqYmNQN5hhaqsUSDF0bgJh+1xs9d",
+	"+QRy7uMqJl+EFCOiGgFhiEPF0MAs9l+oikWih3rJVG23AUy3g+dTao6vIDXHU+aMp8wZcO3DtRR3c0Av",
+	"XJqvLKvGA8ui8ZTX4s+V10JcwHIW7XQAKWp3Sgz7Wbgs3gHTwG/ENokYY+ObZfMXfjlj6Q/gMw5JGsSE",
+	"o9bFuN6er/xMv1T64sqZxTIU5VShyPhGn5HxNb0CZFuuDuGfWUCn8G+0SrnEu9V02R9aPhzX9Aru4eoQ",
+	"NEcBncLTfpWC4IltnTaoK3eYIFx7/GRQP2B7OUOampkGQbTGrHULUObRIKAeysAxDaDZdAEPcukbwhf+",
+	"csniVrsF1v0GafLEGrUXrwuDZtCehdP15YqFNEjWFl3ptd0vDKVv6Ay6PWRHg26vS96i7v+KKeaEI/r/",
+	"YSRk1+rlMKFckyE/Juyzz1FdodehH1nwSOIRmdG4TTwGEo52m0KwfiOE48BfRJG0U64YTTJHoMAPGah3",
+	"JzTxl6hR+vieMeWvnefR2QJgP0I/NGViD4nPeDfnzg3r6yh9SxQeaCt0R776nyviDvS0dT5A7yvx351y",
+	"+TRTO+/iUuCHZEavhIlVuhOgCmaMYHjSZ+4xN8Vd6Sm/VnXjjjpGR6qSKjXjrDpzR/MLxcVVysSs7NwM",
+	"BTJdawALBxj0C0VGm3uSbb5j3iqKEbZ/Z+EZNw1Sz8Gz3igvTc7UFiIiW2daHuH61SbsioXKWUR6oUVL",
+	"P8F9L4FgSGQGCzAMAYgwFuSHdzODLg14pAQInrML+oznPAJhwnHW2ReuPpwlluio8Cs/G/BOfKFezvxw",
+	"DvKpj76YKTLWi9J4DMuo6SeXE1/kLnbrP77UZSZt/RR5wgjJTNYVzQy/Q6V7RBWm9FLOaTER71ANDJcW",
+	"0UrlzgPatKQrroZ5lg2sdQX4CVRVWpv5iYX+f1j8XL54KefR1BceQj7l0qyK59Hp93rQqt/rdQnkFWPA",
+	"Q+G6r4WqFjv4HJ7DmQ4DgVfqG7aKfdR2AdNeAdkQbyf2mU4TwmYz2BiSsisar/EpIh2WJmmiJA0tj/SR",
+	"uPWVTk3KDUiU/FD+dw70LBBY/7/UYPBd7DSKYadqsJjxNJAv+AkN4Sv7PA1SDiKPHkY9BWMWsCsaJtJG",
+	"vNML3HbbkLKZVLcVlOkY7pVE0mPCcbOimSlMS0yJYrhRXQJuvrA22Z2rAyyOgV735iCyS6YZHks/qjFS",
+	"TckfxlKVIlzDUdRQ9mChvNePeflWzXzk/Sh0+MiXAHUSRQGjoSSS5Qpu45meqbk/iuYXzw7M22EoiTJc",
+	"VvfT9rrGS/pB2wqyLEciMMDwAslGkj/6XBgFcvfkG65NB2K0Lvn4SmQTNLPoXTxbJMmKnx8cTKPo0ySK",
+	"PnWjFQup351GywOZfpAfLKLryyS6RMOGslbBG+4y8T/hn0Ihgt8zA0klFhtUTx51pTOOaoNAi30t25u+",
+	"y1L+38dOhbh/Kfgvbn1Bk/kquUTg8ud7ibYohljk2Ej2QHZwYXmVUJmCuLaOUnKNpAdJkiWLarFCu2gr",
+	"4yf5KdI6NSIcsFbiPs1MgQ0wycizakihgv6Kaxd18LGdu3bC0AAMXChDbf8k7GEMndcSfBxhDKLwHLB0",
+	"BAVGDu1abam5cPHpOmeket1lO5tNEJpef3Cs9ttqyx+TNJ5EhV/7/d6w8KNNqNTP+nPvsG/8Mewf6j8O",
+	"B5/M/7Zb4g9Z68PusVhT/u9Of/ip8FvvsNcv/ugYDXdUbNkfHLvmEUMUj6WxOhie46gGFj+r/N1IEmji",
+	"Cx+xnMYW/+moph2r6XOSIKYLXS6+wkkUSkyUN+I6ij8JRISZ4TaDWrnbMnOz5iFc4MsGAlo8uZ/f+d+i",
+	"a7Kk4boQlSNF1MJVRq4qmIR+jmXe8kgRQBaaCIfDOTAJQ6NisLACX6HTOOJcKc4FzxJiMvkHYysyDseE",
+	"cjLuj2FRqKsA3c004gm3wNM3tBrq1SH/asIcMqu909Nq5s/TOPNKpORj3isgp4hZqc8dIYzw52ai+HnM",
+	"KIgW/nIVR1fZO0YE5ohXegCAlAGGFp7qxkBGP4Xgr0EXjKLcCP3r9ZpNAk7y0RtLmqD9CddmP7GtVaGw",
+	"VjhmwTBwDBm3JmYR8jkLEz8ubE9n1Jfy2zLF+xJDFl5/+kn4RVbFgaqdIC8Qx5Y/NSn2+QDihBk6PhHJ",
+	"4gcMsVv4zhq7xqu69EOQRhY0nDut2rtFkHbJex2gGUnrjwg6tC+XgJpI1lrgeuYjWbjgqkyYuEsheOw7",
+	"plOm5qgSOi4ae2fqi6QhhuRGvGHISsRpymPEfpbsrhK1K5m/a2UrzsdTVHtvVsVfKM3sXeurr9XLasHW",
+	"OQ8jS1Utn5nVquqEBp+k3lnMtfKn/PGpqBW+X5aZb14q+znhmUrWiLfMhYChw6UyPX0rOXvAxJ39+MPb",
+	"D50j8gF4ck4mECISDb2OIa09RygBu4OOh91j0VXJAWEWgjAuykBCu/eeJfL1Q8ZfrDTjv/MovBSXVcix",
+	"1g/n5Eu3270hN2NpeOXkfWbyl75tkkmxkKcxK+htBQFH8zZ4RAc+EyFwKoqtwfJU+nhzGTgETKN45Dyl",
+	"MQ0TplYglbjZajIFsc+NUDxcwH//9+sl0E0aJuf//d9mcLsxDxCR//5vONr//m/xaFBuIrZEuIojL51K",
+	"PSlsnLNghpp6qvxLRLBrlp+A/OonC+Fg4fO2MZyleKUhSUPpDcOTmNGlSLTsJ4yv6JQReOMGprOn8CWl",
+	"04WEilBv4Ku8LdVAUjVH0b+iE6ch+hECxnHGln44D9Zk1OJJOv00amVvs5ew/9COfpQgV0RXhsig2QIU",
+	"a2SaApOcoQp05oc+X1wKz8UXo5ZQBYxaY/3eCj1/iseV2w/7PGXMg8CaTB0yJlFcfHTrlonQjeT1Do58",
+	"3Ab2u/mMgbru+57VfVKsZWwM6qjgtmVa7SziXFAgH5XxJfm1PZaweOmHTGXWFr09O2p8h3jQ3GruMq92",
+	"dlpubbbLIALLl4eovK9zO2hLwE+YR6hWT78XfaQrlSvLAaxrmli8vE6/KUgaET3Vsqi3EI5DBek5U3mi",
+	"3MwZSjW4yzztlaLMLAqC6FqIzZ9pNocSHZWWU6Iomfks8LrkTQjSEOHphAt/MxMEZoCLWOJYbABtGmNY",
+	"zriJUnPz/L0SqkqlkjNM5HwooNG4La+gGE06T+QvZU5TY3RQf4nGrQvjBZlrVpQJi/jBmDPPNlxfRoGv",
+	"Avz8kPyVJcAcXxs2lTb6gUkWIpnrJwZKfMbRwhDFibY/MH3pMcxAWDYwNTUSbOFjwLzMcqVVCehzMIaF",
+	"CiddIyxdGxBQI68bC07SHYXf6SmXyrSl+bIn3MaBi+phZuIGCOwrGLbG2aXUa4Dmyyj0k+wZpfB3Qqef",
+	"WOh1bVnwbDA4PDwZ9A6Hp8dHJyfDXq9nSofOzzVv/9KyODdIAKKVI+hjBQs/IlwIvjrYEtYNzoB4mtDV",
+	"vOmzNJY0ItNZZ4/XOu+6L43cZI9q31w8iWLWnKAJ+wvs9hNjK/lyz+mMkF7guF7+GyciG0Yzg4iQguoX",
+	"J+hkW8kiWlTxWJBQrjVRHNULqKP2Q1Sp/fD2A7gJCoWE0Qp4gkcT2sHAv4/CfNzBL2BWTnim1PfYFQuA",
+	"HXWX0X/8IKDdKJ4fsLDzy3sh+//KJgcv374+eJ8NcikGOfgFZNBLXvjwP17BP5di+/LR8hzWhI+6CZtG",
+	"S5YZoNrG3cYeRNxSZcKkZAx7OScfv3vz86uLcSaW7m6ukEs0Dvh5pfHFONyELVdwFdKYVesmf0W1kzS6",
+	"EqOb1M+29bNZvZnJ3/w5IKtpKO11Tw2iahgW8REb09CLliicBowE0XWh98Do7ctes2iK8hjMapFjfHX8",
+	"quRaEI5jOLQlw5dewmLB6X20Z2IE+WqMdmK4W5NICa9OVab5+u01ePwablWbaXMKAae2S2+5F2/etQQz",
+	"iBTCaW0HokzaoKpygSxSIMKulV6HUD3Vxp4s5CU+E6TLcMn8W/u7ALiamHqq8xu8DFX4fx6re3ndRKZD",
+	"dyRCyAzrNBHKejvvgcxGJ6Rxy5ciF/reNf1ZVLy/lEqFHCicbHxucHEZ0d61tDa9RohrhXytLlfVtOFl",
+	"KO5TSFFBZnhnSKKYUYu28hUM02nAUq5btg1mLc2GUch9j8VcPR3SwMwOKdkFDA8rNKFFlpTzLnkfkV63",
+	"Lx3TENuNnjlDchKtSL/3/ymMImyiciXM25CkZPtuTFj6GxIWzCjnIAVpIT2XlccCdUUs9DrQ3zSBLFiw",
+	"Im9WLHz52hQDFXGdJoRO0Bz3MUtonNMkcjpjyboDAnNnFdNp4k8ZP1CTdXyPP88BAHfR6Q8Oj2pj0FQZ",
+	"Ru010NzTXYi51VWtC2KUlo61vQMSdEifIlPfL0mjJ2idI6pY2M+qyHaJ2U/n6BAR5aCEjEKGGh6RBQHN",
+	"Vko/2a9IemIphMoSQFEudySuIU+i1Yp51utYZtTAF5WS2MbQcGwnhlr4CaEkhBtAxUhE2GzR6KMhhh+U",
+	"1N4ehWOhj8gGK7jcyEtcloEPi4ALnZwH40lt2eXMDzD+0c/sPNAyWvpJwjzipaKuJJkFdC582ESOL9FU",
+	"9OYwoFm0xdqxpG6Cd7ZdBV2eZY6kz0v6uv1g8dHTlkq8lpXXqt2yd9jKO4RfOEtce+xzSf1/+GQbfhWE",
+	"M1wVuOkME67IHGTzNctuoWP0cegdzKUFvyF9hCbbCMqX0t1W+DDye9UKISVJP130bJkl8NzEDmhn/yxG",
+	"85rEQOFDNplxjPWpZ3QF3c2zW0azTJ1beMTWFfP3vWbML8MtawKnsrOk+PcHM0TH22jE7StZw+jdbHTb",
+	"UGp/c15yhydziWIsa5FJCtzU+ZCp5W+Rs8bFqbxXImhEh74iaZ5G4e9m9kepjELtlyLZlvYpK24gcEMv",
+	"QWqjFvSKkQljIVlST5pHlv58kRB/uaLTxHgIllU6TxvdqFyikcKllUw9Q/+2SDeqxBR58hc1VZ9LKz3D",
+	"GU+Xq6BTVuo5hwT5gs+i2vPJyfB4MDg9dZdttn239AhF1BFdZqvLo6OT3pk3nE0n2XwCEtDko6y1PBIk",
+	"BX7qtdVPkrqI3D+6JHMcBcxdulp8l8RRNBmNwtEo/BsLgkgkK2ujxQVena9l5ClqQJPIo+u/6HFu9BoU",
+	"XbOqWcMHiySKyYDrirLQN6r2c5rbwMjOSgFfzvSQhQQVeCID/d1MVgGfBn2cS1WUnsdRumqd4zHbBabz",
+	"pNIoMy3F3/ogTxDRL6NZ9evuB23THcv2Y0uXqDRnqBcIPcsXfIRTjFrkGfwVhSy7/lCYhPGkwIZXShn7",
+	"HAJKxaNvSkN8OindmnqICROy9hWFWGFzjTJwzX6mT2noiYyu5iYwUUY41hIllygVro1H/P/zf///jPHV",
+	"M9ySvsfhWBq7wQ8P7Nx/ZVOaKhVKRuQySzlOYqylTXzhOS7dqWAjPF0y8WZD0JA/0iihQjUzpTGbpfAU",
+	"hT0IB4HM/w8JpcBnYQATXgAietcy7iIEUIbPKfc3VxlgIGqtvvjVdBEhYTeyzqCVXFoYldHCIG7NdJpP",
+	"UaoP1QXoKw6M+uHth+2Do+xMFz4nH/VQ+JA0Q0v+Ao7iLyYrhpMIXwyZ2hMujFwWf4q42jDiahS+BDZA",
+	"pCgmXJF0mQ+I/z3uDY6HwKNh8pux0IejrUjwurTXO5z+HxZ60QyO4//gD8ofCA9deINqQO8zzsuyxMlo",
+	"zJJoLOmJYCiUDc21FeiFWdqvmUzgPl1EnIVa+/N9FGfAKkRcHrdtu6vSg2c2igUjx85ErR/MfvIhZFjD",
+	"1Txjo5DHKlCXvk14ZKcPTtEsrFf3P/tjwgKm07hbrrgqEEtpnOSFjeKsv9hdjkceb8oi81FmSvgatm8r",
+	"5MwVbQaIiVFbOjuOZMOrIOW2eCBFMOHu9RADzTJt+nDjw9g07id7MSnvRCwqceWHU7/T6w0wO8dkAmX6",
+	"4K8dgl4eaQ6k/UTBGPK5M/JFZir8OuTtPUbMPLm8PxB5VyCodQKtEjGh5SL8ov8z/tzCf/NeoDuqLmHE",
+	"Rbo4NKfqiBbxAzd+Ucw9inO/iT8FoLM4spIV60DQaIrVRghnAMAE9aKWbpAzxomXCuNoTP0QF8gjkBqo",
+	"fvnJLA2ZDG/7WertUw79dMaHCZv7wp8aY4MAXdSK3PKVGZqkDsUyRqrQI0qSkmpOhtvX1mPkFeimEvBj",
+	"f9AftMlh/7RNBscnbdI/PBzA/15UZ9uvCie2xi+fwJphy6lqvd2c/pmPywvzz+KHeavelkRYnKVhXWY6",
+	"VnmIVEDcgolh1ETNb3U5qc2uQoM6RcY9MK6Q0EO3LlrtXV0/m7lXGs7soovQnSlvy1UczWPGufJXb2e2",
+	"ziePyrv0qOTpbOaX2NXFN/lQi5aMEzpLsN62qcifET/kDN3wAGvley3v2pWrFTqTSTIdb5O8gNlSLKk+",
+	"d+iTd+gdeYc++dg9+dg9OB87+Xyp8LDb2LvO4VinJXnIrIHpK87xAA3KL+9vGIUd/YPuLxYFEhuNWSap",
+	"8QVdMfJMFGvKPDVULpDnrsjEUh+9D6bnkyMvRyEANvMPEek5sqIKT655pmseXOG9eudV+8zZU1W7xVW7",
+	"tVW7pgHfvoxmM86SmndU0TH9Ewst1/R8Z4NtuPo6+5TnKsw7wuueNda5wioqipIVW7DPiWN1DR3UjESN",
+	"ONDdeafdpmPavnzSbssVTeThujRdjXIRnZdPvmj36YuGfmfaapj5oylurpjb9r5o4IeW/vHpKvjn+t//",
+	"OJn88O/43d/+2WO/Bb/6J07ntALGOJzTjk/Pjk5OD0/qnNOcnmYj9KIyHMlErrjMS0zp4fzQY8IvG/2R",
+	"DNeygo9ahYdYiY+YyqsgGt3APxv4ih1X+4qdlLqK9QeWq1jA5nS6VvzI9BSrcBJ7tZwwz/PD+ZYFe/wl",
+	"C3l5qZdMLMhaGk8N1NqKJx5TC9GqN7hXMkA+e+b6oYg37+j2nUOhuwvQCUtYqaRazLCbFAk0Ks1BT2Gm",
+	"o1Gao1kQ0cSpkhetDacw2I2xeD8rqcp8VNiMcTDMnvFxDOaS4dE400as1isfVSurOIKzOVitRZuD51ZN",
+	"S7kg8c2Oj1ffHKLMKk1c7gEAcOUxgmt32hCK9gEQLGWPrEitjO0TtWr8cB5oWa8tfCdoWDBGlJseyAct",
+	"M6ODXd7oTD/bWVEV/xSU/9lp/2xgfsojC/UomGTHz9uGUyENCVuuknVmO4GnZriWS1SOfoPe0amJx1FM",
+	"AtS43bfFGxETrZdkEkfXIZlFn8nv6XLFPDTjIoAC+p818aJ5q9QCUkR2iQfI0tRjQmftFS5OGrTdOvuH",
+	"nwQGetZnZfuwYPIADLxpvJQ6A83Hb3JL/KZGkwunn1fmyi3hKlsOi0vFhnR56S2Au7V56LY2g//Blcpe",
+	"+NvtsL3btk5tD4aKYgEbOZG4qVKrnf9w2OFLGgSuD5iH8k/pWmIqskugVeF98mdV5glhoFyXZ0iCmSov",
+	"J+05C+WZujFDEHK7kzaOrNPLcb3mK17DZsIs42WcT75pkZ59PpIBEqOWKbrBL873cOquXQyT4CdncGRp",
+	"1eKaaq8fXKVxzTxmO5R91ZnrKycwVr5hkdeagq653vpVqzAf0bZYvyN/AXYrA+sGC4ypMOZZGCUidS3g",
+	"KLr0oHdqEFFP+QKrt0hr4oc0XrtwUxaLLQvcTVjoMU9VFlY3Qc2C86NWBFzZ8DHLOkkaslELMezj9/IH",
+	"P5yXFS/VDUTOTLtorRhFF7MrYSRZDzHGRxmjWsZ35NfnUq9NIdUaIBfAELNMqmstX2euXRPMbhzHcBSw",
+	"SGMjts5YfcCiQ3qh9eloEQuy86lCtJB9wIn/Hk1KY7MW6xWLM4cU93nnGtmRqcYOye/RpEgyJjSZLi65",
+	"/59c1jdZ2b2sXLR6vBA/FH6YOA4kdkGZJBZ/ExhXV4WiiQon0IsdhTSGM/JEwhOsQywc+DA9DdjyZJy2",
+	"sPTGPtXeH9kLRp1aeXmozCp7PKxWCoA7RgBMGtQCwCou5SPXZ3EDCL2fUrTHzug0iTLNrhqRwIgAJRRS",
+	"WGx/0N7qolpsEhF6FfneKASpaOajF+nme9cBED+pbQvtkGn+zCn0AQjhJVtF0wVvsGmbr4huIleict4R",
+	"5y5S/4SihfCGwnZRyAi405LpehqwUZgs4iidC62s8hVEnxXOkh3O/rhXd/QuO8VGMr3p8Z33BrdrBDQQ",
+	"2t2iTBLpS20I8CK2RaVjTBZsFH7MNGa2QC8lToM0HEC2045o1ZnSsDNhHT2JVxA8N6h2UOYJ81Lrl2Yy",
+	"OKNv1nK2n4w6UgkF8GxhEiIAI+RnVjQKJWMxOcaIjFrTlCfRUmyyI8r4ydT3Ko8wNcaTZdRnybm12XOh",
+	"vzkvDHZ+sjoKfnnHgnGhRO+RQDv1Z7+Jz41E+styqUK86GiYY3DSrQjf4Ny+PDIDNCMfRRdSU538QDQT",
+	"LzGZzlb2pJkM8W84Enk3tZZMsODETOn6o+hCXmqRCgg8OEdiJzmwPODAiBFWUsxYn/tY7wSfrCaLQ9Qu",
+	"x3OxF/QJkt7dedSGuTt0Mu0PDl2ClxQ0QDu/49FkI2WH8xrfzzrBWiLsYIHMNwvNVD4v6y2TDTUKlyyJ",
+	"/SkWYPYjTzjCKrdrU9oBFStnRDWXEUPw8kbdzCjMCw/KL0ge/AflYoGrktp6qUqVL2bih9KHA9mArEGu",
+	"No0othUG/fth40zN5S55mds3vlxufL2kc/bK85NSmdFflr4o8ROgDvN8M3W0OBfy9ucfJLqhIIax7Ec/",
+	"/VWowvkfKY1FBZEl5Z+Ut7NyEmnLwfFg0BqaxDTkKwoEZa0eyYqgC2886TND+adus2cPNHUm6jNr6eMy",
+	"rhcRFzLF2lhIQmjMKCfPWHfelX5wNFgt8Fr9h8XRc50NXX4d43Bjo9YKgI55GwJPAERfmcx8QLmaoikI",
+	"NpFGPBoEHdYpDT5TQp1u1y51LRAKQ7wKAsJZyIy0z43VKGM7ZzUVyfZ1+Zvs3hjT5i/N9pFjtiyKa7Ui",
+	"x7KTU96oMh65V16yqLd5/FUW82NLPWhxy6WaR+LOOJAEseBn4pXL8rnZfQ6xzj1DTrIB+pJM04SRCZ2s",
+	"CWeUREnCYnItw98pmbCYOY2EzrIcCjvSOKiygvqqXJZRPkBthMvqPELnX6y8nsYB2kTHk+HRJaT4HnfJ",
+	"L+9+FN3Qk1RcLkC7YY8s/TBNtMN0oinagnLhfKGnN3VvYv1qBttsKr7VymPF53G/Nzj6DP/jBA20Vyeb",
+	"B0kRCoPj4efB8RASlxz3B5+P+wOZOl1PYqV8ks1b7ZZs3Woby7G2Z66ydpN/NqW4vKRtyTFreG4pv92O",
+	"IrfVfx7eMnF2UdzDh0JxMX+AYhyHY5mPeBy+6NtM5DGSZjIz9jYQ/ilHFU0Oxw2IuYt4/5HSwM/F+LbQ",
+	"V43GnhNrZA+1QSkWmi/ujJCS8cIbSzdHrk4XBe2ZH7KsaiJsT2VBQj9+nogoXFFEUM8j1beoAiwLYbEh",
+	"ot149Y4Wnk3mjE9PrO2xsbbcPSmOkTVtk3H/5Gyg/sjGOTkbjHOoo7zAGjPOdkuPrX8/ORvswFB5sg5y",
+	"sL3yr3z3ncTGzQGLAwkEk/774y75F/xIMPUBtzWGAaMhSaJrGnvcDBVA20EnZjQQfDmmmCxIT/uzGNs5",
+	"plKb4dNYLkK+foxhgyj6BDOpEbe8/Qpwch77VPTHJxHHKeLUiDb/ArNKZY7AJjqFlDP1pJ9Q7mdeeVdq",
+	"eOSd2ygdnp7Gf0JB7YlxP71J/3QEu+4pKn0ktnNRaVRaWtkaxURd25R1ODgZnuatWYVDA3J+6Xu25fjj",
+	"Rbs0Q/vH76stUc8hmWGx/qVUyuJ5fUB1rTRjZEUNocJMT9gaCE0SjDgUAYRqg+QXYWxHboUlc4TlL2ZJ",
+	"7LMrGsgsTdPIY5d+mLB4FTMMUdSp1uh0yrh4ASEjQMuGwwvX5VHc7zk821hC3W5270V9wP6QfGLrjkhM",
+	"t6J+zLPFTJi9URXvISWvqQ6EUpvmSSTUg4YOvZBVKcmc3oSPf1acVhWi/Adbc+cBDI/MJ28QyaqnMmzf",
+	"6iE6HPcH+R67ZUmMozJTHXxRKM/CBB7FCElfRvbpDFUKW3TtJMkB4Wo7WKAi89wZYJq79Li86hrW8vZH",
+	"nhQsyiU1d7hHFlChQj6mAeXcn61bDZIhvdY1vT/5Ig/kcruMSA0HcmRI2dyzeqmB1QloAsBqFz7wBNGp",
+	"RgYsHS4H4+soK8mrW3NVPprGRl6TcxmUUliLpDbuKcc6baNcnCgB6m6bM7nRNIl0IliSruYxWqZFaAjI",
+	"n4I+qOLSqrK+9GkVNZqBq2KyTjqdpsJhCf15iTRcA/Ur21ebXDOxGF0/zLui4ZSh2difMjJhs0g5g1mZ",
+	"4brkJc43XetSoy7ASecpHkDcZbCWPmP4oMiigJwwLfqTF3GkQvDO8/AaJ2vzFjdImID50eb+FQvF3RXX",
+	"2OdkFSUslBWfFzReztKg6N7nl4Q7lwchZ1t3eOtuGoycd7m2BkeHgm6J0g6+VVZ1yUYSAOYViRWmNGHz",
+	"KParSy/BArOW4gVqZzSMGSYemDNPlt8sAhz4FudLp5z1raQOyGLYZzhiDhP54dRPmAiTgCd7lGBIMQwE",
+	"FyGg4TwVr2yhwMGM9DSeM/NojPRD2RoOkgXiXAiALaznb7odmZpLkzXXMYEwJ1d+FLBwykQQR+xHKS5u",
+	"ucFyErYzMFAVLtNMxnTK2oBYHkj3LFmE/tRP1m0Ss8CfY029kApZBn/m7HNKAwLHGib4oU08n6v8Mzyh",
+	"SSomnFIO7+C/0QTlIwUV6i/Fcz2Mws4qjhI2TRjou6N0Jd0J2mS6YJyTVUDXLObP4YZm51AOmLoTshey",
+	"zfEAWovjUUu+O0g6t81ZMOvAEmuQQp2+CExNY3ip4tgeW/nThBM6FYmK9IAy5R8Fccyf+h5rgxEl0fGc",
+	"UqLzfB7FnjSfV6zvQGXPcgc32xisl0hWLAahGGbaeYVtolJpAgvgxFwRfKLelQ9nHyoPPciS5CdylmnS",
+	"YItJJa3KskXxFaOfWJzdVf0iE5SRhXM6lyHDOCqSf/yV4avhtk4LULJ8A0smRU4aRylnCoXZ5ykwCyxE",
+	"rJYhrX2mAVC2hmf+Fd6AKLaRU7WATHf+lAE1AH9rD1bO2WfCvHQqX1LATlgQhIzz51V7OVj6YeTy9n8v",
+	"prKIgaYDNETnpSvfgzbXiwh9BeFig2vtmtGYkyjw3BMrIlKD5OrieYwmi7YmPYJWL9YcpEvih7+n8bp6",
+	"noN5TFcLf7q/+QDD5KDSJulaQU5UQ87koMMmC22V8lOTkjmuVCkh0TibP3DjHBygckmUUlxZX/JpFG8i",
+	"3RCKD3HlMenHRIwA12AVM8+fJkaZy83EHNQ2TkXivdicd02+yfp9Y5xPlkioqejSbA5zjLL5Erbp6Akr",
+	"H2uXVdu93XNU8M6qwXW3mlFrOF6jKawx6udLNsahfO+yOdx8oXpk6FM1Xiltrh9WdnWPXk6AqwZWvarH",
+	"LCe2TcZWvV1zfG3kVD7uioBSiXfhqSNp6YQF0bVFUbPXYQPWo6Zqm4/TIkG/aJJbrZABSnmVq3f01ume",
+	"lpEXd36D/9Opl4zcTHlVSa+XVQ6UU7szNMnNw0fU5GZfMmBY1QHhkzhc+FlYN8xvgHJlXxSyub9rpCr7",
+	"bGBU+dwmIrtb5fGvZjUS6+tbZRehbv/5NVqQN5dY+HhTPCCFoBWn1O8OBqeD3kmfdXpD52n1ur1+b3g2",
+	"HBznv5tn1usOzk6PBkfHJ+UH1+8eDw6HZ4Nj1umdVh/gcfdkcDQcDE8LTV0H2ev2esPe8GR4ODyqPc+j",
+	"7tHhca9/VNiw61hPu72z06OjPuv0ew1Pd9A9PTo7HR4fs06/3/CUe93hYe/4eDA8Lj3rXvfsrNfvn55m",
+	"i74x05ip5GJGOrGC9s1IJ/YuDbezT2ZNL6vFkJerFQs9bpussg5E2glZ6GkXR/OzTqOQhlLrLaKqlEVs",
+	"ibXllAp6whb0yo9iEoWEEvRrSkPp4gLic5QmqEWPfXzzRcgnzPkaZdnWQeaXvlcVVYbRS7pxfWS9dE5J",
+	"IsI+M3QoRY8T2Lo7W1gV3N+IbUpHsI9m47qVHAgPUp0U4LnajG6y21E0ArIovRMFl36irDMlGmxHRttI",
+	"FGcncZSGHklif8UVFqEREzOOSxuGn5AZRoqBZSSMEpmMX+UQDfyln+j0lUa0vkiXL2GAUsRmPjNPluM9",
+	"W44rrBzGfcSMRlXpknSiD2kTKdxFwBMqNoamHZV6WVQ29qVjtiQzZvJ2o7qkjn40rpRAv3bTDlaAXreZ",
+	"j2tWuSJXyGUMXcZtXQuYqhIO0UxWmhC4t6BAznVtoAUj79IQtYKF0hRtXf4BmuqcvNCehXjkVLUIUBkt",
+	"Y0JLy0Q0rOeAjiHl9FBmtld1hjNwqtRa4n6rs96VzmkjV2a4r8qipGnuB1jht5HH0FjevMs75QqzYb/v",
+	"ZYrd6pRpRiK20qNwP3Usnllub32/Ymy62E4kqXCnUI4UWU2q1PMjkePCHSBy1Dsb5mL3rDQBZ8NdvVqT",
+	"hHf6rbb4t7PwmmSZeKNTRhh52z5++PA+lzVC/HWQJPw5eC/ADMJPUk02rqv5V+nRuVwd1uRaFfD1wy55",
+	"bzqML2ki3t7j5Qo8U8fRKuXwL6VT+GcWiH+v6dVY2BXGq+nS8l4Uc0O/VrtF6bSFmgD455petdqt1XTp",
+	"Tma90kWsqnxusVnR9RL30yXvReYOahYGHve6g2MsLjs+6vbGXTLud3tjXWxNzNY1qz4dmflcuoNjlzoo",
+	"8sv0S/hJyYpIVs1yAgum16oBjz0k3CEV0xpAzKaLCEEuPT7GUbj+DP+G0RVVwOcLf7lk8bhL3sYMEg7o",
+	"WiPGmBkmygQyHz/I68bxNjuD9lEdkUQd0eQAh+tEK1m6xzhvXHBL1ihvt2bSwQNW22q3YLGtdkuus959",
+	"y06up+BcTo8+wAPNexl62z+UHtNjwURZVc1NeXA+vQGe3gBPb4CnN8CjeAMg2a4t0GCQeEXcnx4Quz8g",
+	"7uSlYB/bZjxZYlOlCf7jslmKS1HfkcaCcgrEE7VMmmbOdUaL3DyFGtwys7gpR62Yhhq8+84wK1+e1Xlm",
+	"E7mCCWsDYLNMgVw9svg52C+nbbJcHcL/HMH/sDn875y2yfKItkk0hwqC9ApdcK7ZZNksZ60DYLgdSLYp",
+	"vVvdW1NfM0X+Kk3M50igiZ74pDv4Ifn4+v2bzvDwrNPPKjGwsHvtf/JXzPNFOVP46wDSnl9Gs8vX799c",
+	"YofLaeTBTRQbEzzRXwJPZtL7XVYYDyjmOSgp6rPR6/164XOg1f1dMrqLgFM91Jg80/mpV+AQL7x6wJM/",
+	"WrGQ8CiNp4z8KtqTfw3EcOi+OtWxLvo5lneWz5Zc+fIvTboREvE+o0GmT0kt6eYbrkLjRZk3P0wZFqdj",
+	"V+jqKnCfszm62aLm5aOYLh+3h69CeB/CTAeiDeZ3k3FkS8xYq1+7GpNKjrZSm/G7qFZWqs6QR5doqiBL",
+	"4BSvpny/npMxxqK2RRwD/Mtj/OeKxZOIs0v5GTQyV4kOa5CoJdcDXVvtFo/hf82O8GfizlBeVv+159qe",
+	"q/xrvu5r/wHUfZUFkgHfeu18lXkQuD4G0dwsUlpLQKL5pdH8uVBYmSE3fggmMFlnwQAPScPED8iUxbLU",
+	"dcz4Igo8oQhZ+ImFf0bJPVWr7nIe0zANaOwnPuMfL+ywy5a8Gi1nelk9CLEGgdWvolUKxC2TPROTh3XJ",
+	"OHcDxjp5I0DWxkutWnDP1yWvRJ2kKBYpI/Poj7DQIXbnZHwdxZ7EdrnBsaobKkJBMT+hKWlIQi0EEdEl",
+	"Ww4XuaYNrRdMYHyH40tj7hhQHI+WyjQxjzAfjQH9mig3dyZxwUAumsoV4kD+7iwfahVhtc4yq6Oq67Ar",
+	"z892FisgCwSIRyky26JbqCrq6MA0LX7Iita1sdDuuo51nktZ8TfIbeGH4r5d+4HHeEJ8j1EhwK6j9Jsr",
+	"Bm/KmCxoVqv/m5gB4xO8BQVScKz3VTk/PqWBqLwcLVmyUJWRvgGY9nu9NvzThixPiDpk4s/nLM5ebBTi",
+	"Q6Yqu+RaJm+eC0rkRThWd9RSHhcYrYFZtz0/sj0w7AMsOGE48eJf4ko2QA95ecnvWGz2dnDFk5Ub3fii",
+	"vroEPxc73l6MdI0mr63TB198ybNwhdeIR8KjGisNALDQMUQlj236hLNOUM7qLN66y5VrI51ybPPV5wQf",
+	"RR4SQl66q4xCbrexX4FM1tFCfbbtDGna29IHyj9J70UNHu20qCYSDVg4D3y+0F/V3MJ76+ik1+v1BsOT",
+	"3uD0tHfWzpOfD6iHgdII15jCWPDTmPBVlAi9zCJKCE/ByEA8uu6StyxaQRZjBrzu2l8uRREtIQxNGQ2B",
+	"SfkBwp3T0IMQq0AFKkLcGXwQU15FQcDWExoEXb18hdNul0zh8WnWv+SMfSr8ltBYOuWZP7MQex92D/tn",
+	"8H+Hh4OjwcnZadtVlJNsDBmrVmdW+/Kj+pGQ4x7455Gjo16bnBwfHrXJ4VlPFg47PDk6bEPqvdM2ORwM",
+	"5K+Dw+FpmxwNhsM2OTkdQmWxNjnuHR/21KgX1uq1vFbcPb2aq/LJ8LHT6w5Oh72T02Fv0Ds5PoaUGVlj",
+	"uBAx4xxSiCM6SVfJwyH8/9HZ4fB0cDrsGz3C6FK8XS7VDOCUeHZ6fHZydnRy3DvtnQ1PRqHpqNntdi3P",
+	"vR35SEDvSWshJ39gGounR/3jedRPUBH0SlDyx/ySf3qXP4p3+Q6vuIC63nDu99U2L6eq2XIvg4cjqEtk",
+	"S7Ilk2cyJ8lYymfj5/sQ4QM0hz5ECT5bWf2beRNJ+abd+o4FzHDKFtXvynKSiMbaQokWZDgPRUVsy6UE",
+	"osztCMoVL2KiZoSHA+HX+sxfyhSUQFiE4xGJY3nGnTBMtr7nzL6VVXbUDkHaWg6zdtWgta4/drn9YrdS",
+	"SFfU19zzhm5tL3lkuY1t5Iqh7Gnl6KpxW0vf71KVRfp2wSwszLeBKlkF10p9k1EGmlwxrJxnapeyjyz0",
+	"VpEfSt5rw4KVz/VhwQozmIVbtYUey+iLxBpElNnXRfFVXXiPrZjgB1LPJbMkMU+uGcvCCqFV+v5GM7Ur",
+	"0ZmrrsodB+dHTZmgitlaXX6O+qvwasycODRX0o8b3I/TepCv7J1/mgD+hB77XJZLzmOfFf/MVusr37Z8",
+	"JWB3SdkdSuzqoe06u/rnBkiMuzPw2NW3oVJJNJNao2xlUvFi/KKVFvCEHxz2hkeDYxWY18Fn/eHgZHA2",
+	"yN7xXfKsf3w4VJgpauyCDUPWC39udB6cnh4NBgPR+0LOjvtErYEjji87OuPlb9UmdZ8OFta6lLXEfo8m",
+	"Y3VesalFzhUfVa5eMjGuiAjziFnt8eXb166rLZte0hJk+SX0Pxu2pWd+SDibRqEnLPiZl1h+RaCAkoO7",
+	"UZTFceTIQPt9FOfH0p5sVwAe6gcMDFRoOMPXi6z8Jl5AptuLpAWYYl1dKeifiszXeU+UHGQij7lcjpZ0",
+	"uoD1AWGH3gQ3QqC5O52bcBVyDbVIlzTMD2Tkhy2Mhdnd3QelK7/KchOUEz/EfMptkvIUH2RjqxaaiDHI",
+	"1d0bS4vKzGeBpx0WAVLEtwCIM2CdMjUxeIdP/Zk/7W5cqw1hnYFKbdSZSEBeD+ZdNqxTXqhqqfKQThgg",
+	"mEJSZCvCG8u57Rx++5zwBNrFaRjKSue1/pwzP/T54raumxr9Frdi3N/9V1AmeyoiWCBy91Zwl9TU2x3h",
+	"IkYt4rGpjv6NVom/tMq9y2VYNkAz6bgaUOp4dGyJHGFJw1QUBb3Wpn7MtyG/2znpj3tyvu6tVgM2r78+",
+	"H9eFLwvEUM9XnWfTzEY+YUS/d7Xw9/Ltay3m8k1TbwLwnfQjIy/OIXeQxHKSgC2P5T66jqQVxXMa+v8R",
+	"1L0UjkYjsbXoOuRlJc5LEooi7+Bl+c+XK+DZVqFT8vq7Z5KmuWbS1ZdlsnAm3wNiAO1aj0oODgdbVW1X",
+	"jdGR6d2EcJ/5lTQtUZvXLomcjCWbFsYAmbcxz4rkNnMYy4SnjmbJkk9jyN0fKUtR7BlLIg3/ydPplDFP",
+	"/K4FI+DqUxpOWQB/W6VecgO32i0xbqvdksO22i09KgZwwaCYPUcO6EQ0JG3MuxQWRDdEhHydEbWJLzgM",
+	"EZ1A9TxlnIt3qSzQm0OKu2BrDQpES/w1mJnsU4K2FuHfD/JuVz65sPCsV8nSswb7vXwbiofZI0W9G2xZ",
+	"yiEWFgWUtp3BST9A81QyR9P0PS+geR5ZiqcAd8VPYJu5p98uz+ACW2jbmaVmye/RRJIxV24po3a+/pxB",
+	"GI3mw7PBcNjv9Y/kZwPWxvf+WS/7bkFfLeTcmOt8ue5E8VwWeL8UFeTPT/44Xa4+L9d6JbnTECNF8bxj",
+	"7sY8IMtfYWTS8FHLfK2LUxTjaRKnR8ydHDQDHJVfrXNWp2DMI5vlMM7K4DTSUg78LAB7Yw6v8QpTKZ0M",
+	"Tx1KhTyJK1MtvLpypv77Ptcdw76IRsEqzUCRUJboQAN2JUQoxXTgQY7x3nGob+9F9Tu5kf7augRd3Mqm",
+	"+lWLroiFZ+u42OMdFctz3FT83ULX4l08ORn2e8PeQHbGdYr+ANrshot1iy/CHOnlEWbUaoBUFlYgaslg",
+	"sTf6FPKqcgPJilqOXN7fa1VsZiaHRfNVm6Sa9Rs+GtNFFKnAeSz3LVMx0yCwxnDyRLHHWvWAWoYIIoWh",
+	"rSrknf+0ycvO/26TXuesrdwqqB+KDMAqt2voEY/yBWxExkTmslRgDFW5Uke/oavMnuog3mY9RJCoP7V9",
+	"K6S/kDvTYhIRFqLkJXoSMTyh3kJwbmeeAg0mAJDpTYAMPO9QMIsCyOEIv7LPNJvDY4Bt+kU2zvY+Fion",
+	"6ZFDCU8nXATK/f39m5/Je9HfKvKGyxyLTYxFwQ6Iie02CDrNP0Dp0nHhDdR/a52R20lLzFShmeMWIDlW",
+	"N1olvC1vyERU7qfWhlXcn1aNlKZnyGrjHagpOnBWWkciCZb0asTBzJm04JY5foC7TEecHPp9CIxPKBpp",
+	"OsbXAzGDF03TpUpfbwQdqujCUTgK3yx9oaCwzl7gBWq2FZ6JaxQStlwl6wyIaALp1sYR3rTRS726AAis",
+	"LY0DojK0ZoW6aGhXHMxIkyxxBur0AsvUVedKNQjDo46yeiHs3VXj2vCkKQaBAIZnpfPcj/ErnzPvssyB",
+	"7INwHl+ukkxL7Kwmki0jQX96aAgaI5xAEstED+ZcSxqXaFJ+effj5vvG2oHPJIF77nbX2Ixdp7HkouDS",
+	"mQmWJgCN7w6+KRDE4JOIcLzcpCwZu1ucUrHCjfxfcKZa5241nxwcDI8QjWk5pVQsd6MVWYO+KUmoC8+0",
+	"mKvcKo31LgvKL0HBa3WSLK5omw9oxQxHWEmxSr7UXYDO1HoFZaZ6AJZSKhn7zNZj7KNwEns/hU1PgHKe",
+	"XN7qCagZbvsEaiC/i1AP68lCFmhCq/z9RyZMLTd7c0jtTWS1KLzGT89OByeHQ6MJ0CEp6kdoZf6QJlFs",
+	"jWJQXus5K74a7/T5KukcWV3z6XFHrX+rqmVY6BPSDuilYxn/eSi4CHqjLhmZsCRhMaEJGEb9cP5fuUiD",
+	"KBAPdzMUQFW3LHxQuRTgw5cb2yG/AvBHx8O9AL5/6gT8T2vy0jnKnx7wJ6dn+wD88OjQAfgcOPcI7Fzf",
+	"fcDKVEApylRGHUaKYJUBc6TpmE5Ing9DmS5QlyGlFOAxGbrwLMDQEFqgzT4FASEffy8DOvLcp6jIQSJ/",
+	"sRmVd73UxD7yOrB97ao48t3vTuac2edhGUM+yWzNZDYJsj2fwKbQX/L57Ypr1RPclbSmYI5p3vYFcRjs",
+	"7m/vWzr3Q+BxFim5Ffrk2pyJEkUU2M/Wq+RsCYV3afg+Yat9bVsOt+nt4Qlb3e71UTPc82sng/oeIb4p",
+	"tOM0vF1gywke2Mvypt2SxF0W3nu9tFmtQzMpNbA80z/Wh/H4YUF5afqQ2meNg2oPgWI8camXUP06sghb",
+	"sXK5LrkUtb76QCu1jPL6TAUTk4xayzZneb1kP9cTNPzazneRJnw8QHSiaNUeNiRVfhmGkdCFc4Det774",
+	"o+z4X5KpbIG67xz8RGlMdF3DSEuivG3JH2mUyOzWxq8wY0060ig2Z+iSH7Q2VruZZo1TLt0TR61YJZ0c",
+	"tUTm3iQinNF4ukDgOBwwWehd6piHLJu2y/8Gj18BYkMkzVDQBgPeDwVbnyOsnDprBKV77By4/VDH4DVH",
+	"aTWBC7Ux/0NTIFXENYpC5q6rJ1AoZMzj0tYZM8yZ43ZcrL5r1jGNbcdE40vjGyfzp9mdbai0DTSyHGuC",
+	"7HS3uphvabIov5RgrsjcFAOmshLNa26LMLGNwdhzCUcXr2KWsHisr0xW3kCj0W63ZkWTxdY3Rm8NbT16",
+	"c7vR68eI1ADFIkLDr1shM3ZsjsiyeQMkflPhWIwAsyDkczCh1okH6gjsX2l2XSw5sVmO40354k17x/GM",
+	"61xVHiUvvKJjqRuc6LeJYAQtKyfpSqY0aBI4LsZtW1DcXLaBuSyszEWeN0BIA9U+CAQtw7IqITXLuYy8",
+	"3s5TTMYStcbd24s0k1MIilUbZlZG+RrGDTSIGRDLaVIwQjatzVGtPKQaCP/WAew3AGEsg5cVtSgI1o7v",
+	"O3ngGZA0cPUn47h5nePsBP8VDiGlpVddrpumicKxr3JH2dN+72Qos0qNjC2IodTf//wxep38dfLH9frl",
+	"31/9J/iwPlqffXrz0096XMlFHQt01Yg0b4Chy7eVidV5CNUY8qlByUexbTe6iW/8efFaV1dMgcILq1Xg",
+	"T4H0Ci+xLQuowJ2gabKIYpSsfG5ysdrAO+AjAZOYth/yg5RHDdsstkBy5LIwGf2AN6eBswEWhb/LHCoH",
+	"USwe2dvUHKhWSmzOfbdgtXtnBbVcQFnt7Ay+F+1S5vZxVq/v4BmlziR/mR0Lk4v9kiXoFyUoMHOTfj7D",
+	"UZL8+yArAgDugZzLJzV5aWbj7/fEz85iAebF0LjhqtYjaz70e8UTunWu6Yfq7uwXC5Y0/iT8KLMZml1O",
+	"Y0UykNRRVSREzZxuqaZuq9hT6fR4vVjbl7huOTZNjRkt9SIU36pHVwxakhRQZCUsbqkSTTJ4BdSmWViX",
+	"+Jt9Xvmx/ktGf9XydLlel1T7VAZjzzWT9iXOVUhyzvCMOCqLKmNh4idrqaCMIy+dSt2HVizKQojjlIP+",
+	"A+ITNb20lgHfW0bFZvdC0nALUSNOQzc1j9OQP3crSlHaAHSKZptLHFXBoXZQqKYhzmBQPwRn1HnMOMaB",
+	"ZhddRXrKP+1IT6NXyyRtLUMUckJXYEK5GaCJkAhwl5wxAxqZMEB+7n6mNH8kZAs0AvMctDsn8+U5jkTo",
+	"TCbLFQrXeGbIDgY1M9/SBiXWS979kZIZ4Bu8USqeJ2enh8e9Q/lZA88cJD8NAMbtqzVS0HI7PsKm5cDs",
+	"s+pj5yj+YlXKB6opOvzN/y/yt+gakf81erphCvck8uj6L8ZI0M1QpAgnLGdpfftdZbprjayTLvfGEggg",
+	"vmc2TP057+9V+kozH2ju/ALf4V8TYfeTAQYimCaazVisUuEbDM8gU85IBMPVfDPBKhOqRDDPtuoV0X2v",
+	"yRl2yKQg3QCtyrS5xKHGPNcQizlZb5wuAYfckri1jHlN5YcMVq52UlZY+q+X70T8LeKtg2pIONjEQlCK",
+	"0+HZ4XFPRxmqxYh+0YqF1HfrIgSeWjjuz9ZGPsZtcltXhhR+wLKnVlBhodapq0q0LYsJMcwoE33cHzRK",
+	"4bPpS/L7Ji9JU85FtmnvJmZOcXTQc2hhc7AQUfo0BtT1VEJrmYQVEAAjMKkwaVI+VRn4oK0snKkVrSqL",
+	"dLAuTIi7tXKRcojVTFdm5rqs1uaEyVylnjBc22u2675UPF0HrqdrZe1cFL9EqVyzoeslDxbvMlQ6HJwM",
+	"T6uQCRs0KZr79D66pZqypSnkG+eGVxkxUpnC+iP6U9u12131aA8A158jR0PPCEZoAKwcRJrYKMAtWqMY",
+	"D43goyh2i6VooYB3rkK8+llGW2abUG8JzMDfOPK5lmAOjodVOD44HjbAcKNAawNqCa1leLJOddWIFPYH",
+	"p1LJtmKx1QV/lF1ghvWKcYddHlLrKM0c/KECUeU7a75KxIrHj7POa0233+x+P7z98B53my8Q2x+cOqIO",
+	"i4ZEFAJyVVI3Lfv6RBlvuYCqOKWti+U/ndAdndBu1ZOfDumWD8kIeXKn9P1eZFt15PFVGRNyCXzTVRBR",
+	"TwBdjO5INrBOyjLumbkhRZUAPyTY3v2I32MS4KChMa5hbha3e2W52gEX8DC0DuOCv0SJg0S7tUrjVcRZ",
+	"WULwhIWAC7KVBRvyXmdDkVeAxjKHNOakHLeNPzoyhRv8mNnWxyIfiPHLpSgNMs6nm8RBWu3sv9WApvLU",
+	"/kMO5dy1qSFfxWwqFFauLCrf6e9dUpVcMShToqv7BDvXeQalYIcZqWwzhGwtrpxoXJm6SqzDNhs239H3",
+	"KMtjVwK+34t1LsG3zh+I2C2MckZmvja+HtDZVOxFJm+OwmIy8U21U4LI5FTw+v5mmKtP09BdGWSxqQKr",
+	"zjPHcsXBtaHyagD1AtuNsmeptYvxOA0YfyNfVd2VN9ODy43l9OAcvzsyaNl+OO/S8Ftha/Cj8Bd39m/8",
+	"GTEY6zNxEjNZjkYoVOI0lFzWzng5Br41Vjkv4zQU9XglKxUVn2iAAzPyzO+ybsGGpHOJsmTafd4kE7ra",
+	"S2mCz591Ws+ssUrsiepqeLrKOJU0zogY7NLJI0QClgbziYY7zYWZSUun+pDLW2rO9EzO/j+NbT93TZK7",
+	"ZPbu2g4I51blMq1noVh1FUA+s2kKXxBdoltz9vqwtXeXTkiaLVXZXO1TMzy6lOfC7lILQAWFFjVkU2+u",
+	"vfmU6RVs6E+2L7lNz18ltgnfEL6n2YCciRGb7VWwvf1MLsZqOG8zff+HBdtQ47/1HTGvRbmS/B48uur0",
+	"7m6F+84wcNTB48llSXkRPCfKE1lso+j3Icclv7r4bcxQvg4j0Z1vW0VEOcRwFl+xWKwVFZA0YZeBv/ST",
+	"S/Y5S+0tC3nIrGRSbhW+XFFw6Scy7x/POpnirDlJq91yzIHeEeYU2lXMPXxd/taaOicO2x0url44zdUJ",
+	"eXI4u0uDSpmR/xZv8s7ObnEauhzd4jR0+5ZJXLukU7fp+bvsnQY7Fs2I6gY4o4vuaiG+SEnCSPX0ue5c",
+	"T0t4OoFbi7dSvKt57QqhsSz1yTFMLgd2c8mOeDCYakoDly+sYbOBnbKAXdEwERNil8bVPN+lIRgdvqVB",
+	"UJZb4KY0QApez2F0LetB+Vy/+R3QGkuPuXDmx0ubRhYbC98waOlElc8d9bWwpG/FB4I+3DJnLt7ThH5i",
+	"oUOqnpb7OcgB9FFeo3c5+pEnEQ64kX4sE8/1OdVTXbk+HLVIcl0RZ6WxoPsUVuWAzcS45u6YcRqWaJGy",
+	"Ghy5B7XcP5dkw/pJYglVH+QjQ1bwyOp0mBU8DKdOqaMSbtkWyurKHbavZ24t5i/mUrKSHqLoh+kInhX9",
+	"UMtoKel/K6dR403YyH1URyGL16AwBGNmZBXCWsk0mhicTXEd2++Riz0+23BF6E61r02qJL4a8p7Xhm3p",
+	"75vz0NXuv3m2bT0BrIerRYZySgTzjVnwHlZVQ6xXjsI1t4+wAo+hEn2ZaWDEvvbiKuxwTnW4Csdp2DSK",
+	"sZl/bCNnYrPqhgap+TW21nHWOzk8OhnKz9nB5epxmOeW+6TPMN/FOE9zsrNTM/kiokyuZ0kOyYr8kWbu",
+	"yC+mX7SROeWmTaxPeX+UEVzLCh9m2/1Y/piqChDSz3pkaxqFslwl1RwV1Y5YmuR4qBuYOkhRluQMPrm8",
+	"nRGxLRU4ZObahxqc8IStqnTh1wuV5EW1/oYrpg65w01ufd/abrGZO1R5V0z4ePXegFryoaMCUqUra5lG",
+	"XD+L7Oha7QE7WRcAlvejwB6XqkcxTUbzRABWTidD76ornznOrUQ2z8XMb5ZUIr8nS77Mf2ycbMLZMRfM",
+	"r7/Vnq96GaJk1PB0oSl5bcbUqseOdciqSG4UXKGSs3joeaLsPtiK6bCuha8q1NiD++EqTco0pas0USSw",
+	"fHi3zqRMMwADy4+Z03XF4MVv8B4SI5AoZETVXUWBt038cBqk6DyOcerPxkE05+PnRAerk2ciRdv4eZe8",
+	"otOFPC4ulKraL0bcA0o8f4Yyd2KqerYQsKvwCTfzYzTnDcPfa8fCeHojJN4p3dWGyBcKqgOmZEe7SZnU",
+	"Zi/+MkoBI8AX7ZorMOOD1LVIbds8wlPH9EuOhFf6gVQcyQpWtvs1TCUiiY6ztyQ6iMe+C8c3JT+FIy4w",
+	"AV8Vndkkt+Jsw9yKt55EsZg/cbPUiZXQxxaSjmx1AMZ9LcITSI8YuwmRI9TMi1XO/YGUVaTaaj7hFlnJ",
+	"kIyaBwI/ND4P3bjsOIJovvlh1JWEU87zm5VfUwCyx6LxHH0kSw5Af4YRVpRzxTf3XBuuhuFW8dv8cJKA",
+	"ul16FIte0CuGjj3oEfpRaKgT5pWHsR+INnBI4qLw52TNkkbh6lqdTd3HporHobY9V79PpjzJiue1mhVc",
+	"axtHq8G4I29TBrtbZXE6NKQha1PtN2NpVi+VIzBDyM1ZWEPp2drCBvYgM1GRGoJXC9zgzcmzeJ6cMT4K",
+	"5UWMGZNhO3Jsfl4fwAN6c31QuZDC3QXHncRFrbHdbZgcEd4kA1M1x8mO2Tae6p8bc59cF5UyQaPHBtib",
+	"B1pR9NoPldA41MDW6CAOumJhYYpaS/ve6FN2DRoSqGzPG1Eou5s8XH1OjWhUo1x1SDv80PYORHFN3Ou7",
+	"cVJ05YipUNTs3UVRU9D79VPEZdyno2IGh3pvxX1OKUeEVGz4t8/JNAq5L4Lq5Vclxa0oai6kf7bqeuee",
+	"jrjQTdwd690E87rlHd0G9+CsJw0Ed++xhzKGy2dvQ/e7J3e6p/xtm7i0dQHhS/za8NtGidM+bJQpLUvs",
+	"pemLb7hmOO/4Rs43LqJSkgxtB+8Z22lmJ+8XWG95ykiRrMZ6YJlCwzYvEbfJa8tHxDa66lty9yl16KmV",
+	"i2vQpmDnQrQoeeXkGxdfMfn1NfWCcRnEN/CEyXm/mI4xOledFMy1Z4yFm063mM09YSr8W97Jc9hPnm6j",
+	"TFeNYwsSvXLvlrPe8HBw1m+W122Pzi+Zd0ceqRr6x1T4uTj9WcxtZsfb0EOm1AHGRCLLuaR2f8T56dxM",
+	"GljImG7kPTTy+T0QDxfkd7abS851uUinckoHXniwVivL1ddKW3JzHblycxS+++zzCpYkky3elvq8XhIt",
+	"6IN3NXEKCfP1d2SZ8iT3LsEXEuxY6MuLfvJ+SFIusi4y8vG9bGW2SCJSKSe5VPHqHbSrbtqwEpjxAyD8",
+	"dkmZispQhe5XMZ0/pPf5jW+dXoYnMaNLZ6LfMXCOcZvELEnjUKiIoDHAiV1liL6gqxULiZfG6jSBQ1FO",
+	"xKOsw1mYyA5tFTudQFP9iIb2LETZvxBdjY9QSsbADc/Jx+/e/PzqYqyTBFe9EoyKhtXRHC9zXsrigQ8i",
+	"jmkqojEjEwbr1lYiy0/Chmtze5WBcqhY1KM7A13KfLFRcrrcRDsrk3OMc369OoWKUR4vczvMXYscPPB2",
+	"OMlQiX28xBXDOi4X/otcPY3UmkJokM/lKEyoH3JdJIbXVIm5xQI7cl0PobTOk/LhQSkfHDqHHSv+uPJp",
+	"780x3i2VF58Qzav71KR8ljfHEBA/xDTUkH7P5ktZ/yUnvl3NL4NovoqjiYMHXLGYzhmRDXSJSzEY5miF",
+	"v8Ul8AFNrkUZkZB0+m2to8ZGcgxu6IQF2rbOW7MgooYPiPD8VQaEmHEOUjSGArtC3nQTgk1qVzlHUMt1",
+	"DrpHuYUac260VhY6iNKr0EPCl1sUyShgs8FdBO+X0P8jdenH1c6dpDOMLvmKseni0n3mb+NoQid+4Cdo",
+	"Tw8jIpor1lgK1oU/Xyio9rs9JDDISw0UGwv+GETXeQTxuYYN9wO5+nq4cMY+uWg0+0Si2YyzpBFMMBjE",
+	"MQz8vJfjS9hyxWIK1NpBArOPoMukSwbYqQO8ZEFMJUYaG2ky7+cyT7Vc0acifExRyu2n/zJzuvjEQkwt",
+	"ocqVmmUgXdkiDOBX+596LXnI6pTERdOVLjPnfQPEbYusuchI4R44BSqTgv4axV6RfDa69NdR7G2MMo1x",
+	"cqvRr+Vuagp4GlPUv6RxTPuYXFAtzfdaAG7Dl6mQt1FHwbxzM1+uITLoH0tCqmEkRzYTt2+JbG6IDnoX",
+	"uKSWe9vrcPrtgiaZIjaT1Td4nGaKpTrFL6rpmT2lLsa/T9v4VLwj1BwNTOTlVlf8lBWYmjknqLUrbxKP",
+	"nht8z+kgYPSuMToFNLCkWWeL2ryF1das3J42DSs37ViZeQtTGGjDV4OIcNOOlRm31Ag7ye9G0r/CTZNZ",
+	"EKSGdDsVULVPqqyaqFtgtnV88LsieepGupYerkK1AY0JJmYMZWSY2IDLt/Vxqjn+DOq1gr+DgQRVSIsY",
+	"u63echekLaLWrRwT4mC84VnpTvd1YHhASH52oiiJ204iS5pabtBNWBsLry6vaMxdEuiVH0chvlWuaOzD",
+	"MHyjpG08nSiRqNrSwtOJOB9RuwLe4PoZKHLjxjxpvKU0dkz5y7sfNwONi+j89h0LmDo/KQNtWhlI8LLz",
+	"LwXkUWJGhdxgujJ31VAbcsBiN2N/r0O+YtNkewS9nSO39wey8zzqwI8d/slfdaKVWF0HlbEs1q4eTTAB",
+	"FuCLbde+TmC8erhliJF/5yXxWmalK9U920sD+ueLKATsTXCHLuGSfV5FcZkBVX7MXYCihrIZVJtZT51H",
+	"p4QNzioQq6bUBwD5PUNYw3jFVSiNDZqc/LB8yyVmXPucjBU7jx6q6kjvmFfIf8oRQBkDGqUV+80ctCyx",
+	"mEUctB458Hk9LmcEQWmwnVsT+ux9bcoyLzl2g7de3o5sP5Yi3IVTC8ovl1HMrG6SPhXJbEAr5zg6Hla/",
+	"3nYCtLHHbCXGDsoPQiRL2g9uoYF701OAa3C7ZyBneIAnICuZ/EkEt5hlTFwmIZO7yun5U+nPMXVsIosn",
+	"Bo6bRCRmQr/mIMjmi+GrExodhHxD5KFBgGjyuUR7BA3EGXxOdmDsU0vL99otjUIj4w6UzgX4FK43nN2k",
+	"b7cz8rdoUnfI3xsMJ5DdCR0Wx87fdbaUivhrl8v76+/KvgBKvf7OjQ+ZGKm8/pySmF8mx5mmAXxUm/YA",
+	"jyasg31LpLt3sqyK21ID0ls6+dYdhftB0Q643O4Qy+bnpFT/NdX/NSgVwCVkLkru8g5MAHDPoqqu4iq/",
+	"Gpo86FBNLj0azlkcpRxL5zqio9V3dJnUtQmXjKos5jRcC5gjvEVYvwjcl6m6pA0xl39z6+tza4yr+RJm",
+	"pcWu4It0j3RniXfe1eYz3wd3a7o6RxIW9yUwpMf7loL2Zn7KCuc2qwJWWeL5u2JMW9lz+DbFuGZahu0d",
+	"pMt67+CnFUVBIWjfbcZ65GJiQUFnWaYkBEtvn9J/lGQR2LW6hfbAKDngIJpi2IHMVFymxilD0GwzPErj",
+	"qeM1G/ghuwwjtwgBs6t7V5giZquoOF45PquKWhpdcEVKbQSjtUVST/8KGcNbmixcIFnB784Z4Is5ns4A",
+	"LaaSDp0yCw36mMI8lHh+zKZJFK/RC0qlraHTJKUBLtud/uHK56WWG/U1twTnQFFURlLf/QhkMxaCyb++",
+	"fS92Jc3CsygNPdeAV1MH5kHvD3IUQRJ4Ol0QyiFbrZ+MWk2MgS7EQlXCkq5W0GcnFL2O4k9+OL/0fJdk",
+	"j6n9OZumsZ+s34N6RYz7cuX/g61fpgIpUO+C0hKjMYuzTS2SBKpy4g2dRYpFUkE8BdLKQnSqVGJL0iDs",
+	"ys8PDhYsWHWjFQupDw4AB26dqBzk3av3H8BzuUveBoxyRjhjRI20CmgCUr45WtGHG4kDJk6UMVVArgN/",
+	"yuSzTa76p9cfCkud+8kineC4Ygr5Twf/WfkHkyCaHCwpT1h88OPrb1/9/P4VngmLl/zN7D0UupsyY0Bj",
+	"oaso8Kc+4wfYuBPNOikW9rPL+cHeW+3WFYvFJWkNur1uD3mWWELrvHWIP4kbjWdppNGAP+dCdx2tZGEZ",
+	"eCe3QEP3MmvWbmknPI7hscW4iKWfqCoXWZZagXZcBiwIyyjzuuRHbA5XLAZ5nkxYcs1YSPpIG/q9Xlu7",
+	"Ssq3BRZA78nEQTDnHymL15m/Ly6g1RaoSa1HiZEq3ciEW3Aii+KERLHHYpV9dpyxsLEhc0nCKrfWhVKd",
+	"U5HZhfIpCzHlphgHC3l6TH32mP29fDP42b0ZXLUhUFD8C390Gc6KJzVNYx7FuKCUI2NY0Tlal6MQNjPD",
+	"JJE+z1z0wVsCX1ZC3cVF4fxVQDO+AmpLEe0EfJeGU9YmPlbYJ0v6iRGKLZTXBgImZlMGPKjf6ylYtokE",
+	"j8g3Nfn9chZFbTEdTycceoeJ1A7RUKY4ZQTX/EK2hyUJ8CcRmbFEBueE4MK3wlSHs2zJpSeAQ1onsDto",
+	"J2wWxeyRwVYsuga4K2DE8CZvDmAxbiWEL4D9Cy0XEqpBr2c8uuA/6WoV+EJ4OvidCykhG6/KYmDTt8wB",
+	"8KbAbd78AzkyT5dLGq9FRiXp36GCwTJ6im8rCskpP7ay4VsX9eESuMM4U8NMBauBf8hIMwi68k1udtU3",
+	"aPlf8GBewOpHaa83GCJJfDHojVpkNBqFhHT+RkbqZdqBeJRzkoeg3Rb4fRT7/8Hv5+SvyO3J//Xm7auf",
+	"X76+fPn29eU/Xv3b7iL4UuevLKHnBmBeXPVHLUSGMPJY93cOxHgJAoBi5ahdHwm+5Y9a/2sUjsJpFAKE",
+	"8SfygoTsWrZ+9hy/o1dgFpK7pH747LmIRRZdl+vsFMgLQq+pr8brwiF0jaOD03yGfYnA8XMyQlzQ0dMI",
+	"UPh10JO/3Yh1iOmigHWDaP7MnLTr0YRCoxtoJxb4v4CdrpMFohduW+7QAsgonAY+CxPyQu8Zh1hfUnNL",
+	"opF7M8ZeXri28kLv5PkoXMV+mDyzhheLH4VCEFeabBXWYwbuwHQ6bEfF5HwUUxnR5eUh/ITkh9TLsFoU",
+	"Q4LOTgcnh0OjSVZP+NsIKd6HNIliaxTjhlvB9eJrSYUJuYVclYlR699RSmjMCCUgukL4ml46sHx/HoqQ",
+	"NyTWS5R1EhYT9EWF9f2XNX5WquLC+NVRc4IQVwwUISo4vxLwR8fDvQC+f+oE/E9r8tI5yp8e8CenZ/sA",
+	"/PDo0AH4HDj3COxc333ACv7JSqoIA3Z5go+AOhpkwBxpcze0QF2tiAe8abfmcZSuWud2TXwphYAYQKwP",
+	"0nvTCvZunpbwQJznc/06QNlhFXHHE0v4Tup7IgsnMZ78NfLWexN0crMoQ8+NrbOT2vxbE7f0/MpJo4Gc",
+	"JVZOaGhca+nTiriLkq6JqDsJXx93lL4ejJCl2nnkG52UpYp2rljMMbp6SZMFSYBXdsmvCwZg/8Q8QglC",
+	"RQRMxD6eiId22LcowwAxZSKkm1/LcFHVo2sknjG4A0xkM+XSEk2ldZjcJAy+3Hxzr3JmnZgp6LkSNM2T",
+	"Oc8o5l0fDxxOydHIBM8fv6BC030mRB8KHkmep9RJybclH5eLx/IQimfw4n5g/6Ic9C8aXwiE/QsT9E6x",
+	"vlSgr+K/VXKKW0Y5Ojs5lp8rrn65lLJBpbe7PjOTWhUkvqqjcoo+tdXkVGYDq2aEUc4Ccw2WMK8mrOtx",
+	"Mq6Q/O0dmUSJ0BSDNgxLNNDplImsWQBZbpwkW66CaM2y4+QyiQjIKzRcE6Vy79azJbNyYBU/0p+sYxZ/",
+	"dtQVu/jquNZdnI1iWX97R/7GghWr4ljGcdWwKkLUSTnO6TEzs7s6khelJ/Ki/goVOZh5Ii9cB3JvLO6s",
+	"1zs76h0WWFx+9/vmcLd/kA3Zm3GAdXzNpIIdM51kM4b3PewIsKTyLa/ei9aDWj/mw+1f8V3xXDUbfDHT",
+	"kt5kgXDFV76IsDNf+ZWWVDs4Wc8Cxylm6Cp7yko4bsjN57LR2i/7+zKy5Pa+kZVF9LVe/7djXGkiIR0Y",
+	"9OKBSUu/ke9e/fjqw6u7lx4U2tSJDh4LnuUorouFquEk/9wD9zQWWMI5xZUqrE6xFL2kvbETOaNn8Ab5",
+	"9zkBjG2ktFRXw0no8CMcmEwGAbfK6eHxA0v2QZUkF3hUdGkbbaSso8P4E0l6kObdOiqk8PSZkkWsOws/",
+	"Pji5PltyCX26D5H3pHf2JPLelshbQ/gVDSoh/R8WbHshlyxpMl3ohHorNoX0iR55/V2VDUuEke6Djyxx",
+	"pFvhIvs3quW2/YiMarhy/4mLbaKGvD/qRGQJSC3Jov0TXKsFP5U5y1R+fz/IKNrG6stan4AqFWbboHTo",
+	"W3Ih6eO9aDV/WXnAuBrLBim2d0sGeZcOp+qTPA58KFeZNlaalqpNbcWpARcbT1xfbGeki7bBWt0yWf58",
+	"9yyaCXTwmohoBua48OYelLE7oEiJ+raZ8talui1V3BbJhdDkGoJt4RCeBNy7xoc7Eorb+V8RI3YUlYWE",
+	"ViEoL4Ug5N2iWvgAodksxEaouLcVn+XJYRbxcA6Ism9Buv0U8vMU8vMU8vMU8vOVhPwgvd1X2I9kmw/i",
+	"FS2Yzo7v402e33vUCO/89KPW8dY9+8SpGZEyJUph+/lhz5F/eozCXR4fGXueyQ2UvDtySzfZ+ovCLrS+",
+	"ODf8bUT2uF97ZdYwaF0d7HDWG/aO+gOjSU1B0NpIDPer8+5XWB7/UIRhLv6huIX9xD8IOlYbBIHNaoVl",
+	"XOT24RDfi4QQW8nDRlm+SGa9IZTAiAZz2lIwzlI1GsfUars52a2Hc8Ce7lv7DGvYMaxDPF7WskIcVn0j",
+	"H78vxTJBvcRzeIP32/MHyKGRiX7TkEV/Y3WqZtJ223ImbbSzNd7y4e4gSVuqdvdp7QXcaMbeLefIGt2u",
+	"3HLZht3yQG5VtykQ1MkDxl6rJAJTN/eisNUSaaFW/ebiWrU81clPj48Ph0fNyn83YnJ5x0CVbKjEO3Br",
+	"9tZQIXTwRcJ+E7/BXdihLjJ91zoie0EqFWGlH6MEzUN1YRT8djc3RgTEQ2JFB8bVfSAPxx29G3dmNdIt",
+	"bwt+g96OFczGwVqKPMU1/X4Zi5zhcjMGo/wlcSe1LKYJk3Gvo4TZOFgzTiTIb5HJ5Lwt5V87eFoWOcdW",
+	"7pa7EPPrRfRQaPk1+yZmZM4SKEz0SOj5tq8Wy/3TGuThU/JNnxfNHxc1T4tH8UCodgzdhGo/oJeAtamn",
+	"t0CVC2WRptt+lFs/B6o9KvGhkHp+dCAq7mKO1wrF2HvR6ja1SmKKvamTomnCkk5WMC9bik69P/FDihai",
+	"QhZSB0FutxaMekyklsYyxDMWd16FIplPMRfrdJGGn7C6ZjmrubGp/A+irDTjBI8mq/iBedKxDLBF7qFR",
+	"gdLvRt0NlLgjWdyMtzacV5KEd/oGAUQQiE8fMCben34ikzi6Dsks+kx+T5cr5pHoSpW2p/9ZEy+am8HU",
+	"V5E/lU4jNAiitcrXoVbSkYU0xfa7y9Wh5iAZ+5hxxTpmHNmG/B3kDvUF/tv8toO7ofguViSZCozejRmP",
+	"AvTN7x4Y6201ZVWrwzx7wqPvyrHseGvtc2cfCsLTgKb8GU8Kzyny6Bptz+Q6Cj0WQ44s+CmJyCT1A4/w",
+	"aMkSpFErFq0CRoLoiv2XmbbDZnEZHLJvCZmksxmLyQvyV/yPLsD5mdjbcnXYxfzt4tOz56Kf+DjjXajI",
+	"4HPGu5iLAQY25mjLke2QMAcfhRMJ/IlipJDTWp+9PO1wFIqBkYNdQg/yAls+uxQ/XT7vrmjMwoQckFHL",
+	"PFMrlKzitEw/OPOk8Jxe2MeEh/Ri47uEPFmtpiuI62USXc4yyGUbRD5tMkSkV3m9GM84i8kBJQUElNc1",
+	"6022lZiF4Hkd+7LKxldysWUaJP6KxskBsImOqgO2CSOzJrtF80gUsjczfLttvCYx699hyJv21v3/xeJJ",
+	"pIa5aPKOUcNMNI/zwyQyeFxAw3kKBWI34HMft2Z0NhLtleE58Chr/j0i9otR6/97ABflIIlQghOrEpc+",
+	"a6qu9PXC5ysWd0zHhnq+dJuu7hb43PzEhnCOr8Cez8lM/fyOUe89khQIOctA8TyfMcOARHlODGvmLshO",
+	"tXR8k/cQLE+9haDfM5tmt8moFU8wWC5bSPZsqgKOScbzO0W0yeZGcux+C8GGhazzegkuYaK8wLUfeIwn",
+	"xPcYFYr5dZR+c4VlqWKyoJ52AQbdCqThj1Ll27uIrgmwVH++SAifUqFOz1g4DPcNJ1Q6U5J+u9frCS9G",
+	"MvHncxbL2gwoEQiHM1H4ABzLpjQEXQ4M6UU4VnfUymdi+E76JG6XcejxXPlRSzt/Xs5jGqYBjf3EZ/zj",
+	"xYvrKPZqyEP2UeHFpXjzvBi1rgTNvhRC+BMhsa4XyQPsnOQhJtuVnA+GJokTuvg6KVOOArWrqFUd9mGj",
+	"Eki+MAFpxGZkK+vC53IvsoTyT/IpqYUOw59JiBmiAQvngc8X+quXCgESvp52j056PchnftIbnJ7q6IyM",
+	"voK0OmF0usAqV5SsohXsgvBVlJAoJJQsooSADMRieP50yVvx2LlmMSP82l8ugXxK39toymjYFu8j+JnT",
+	"0JtSngSMC9q8CugaPogpr6IgYOsJDYIsbALh4vaTExCVq7Ycy3hCY9xQr9szfmahJ34cHJ7h/x0ND4+P",
+	"T/tnJ7anW7fbrZgsW6V7zpPuUQ//7+z4cHhydDgoruCke2Y3Mf3Y8nzi1yj2MsTif2p+wdl8ycLkiWU8",
+	"ZJahD+mJa+zMNUxYPjGOTRiHhByv8rE2mQNn7FPht0o+ctg97CMbOTwcHA1Ozsz8/RlgyMaQyUWdf2Kh",
+	"uQn4v+MeWHLI0VGvTU6OD4/a5PCs1yaD45M2OTw5OmyTo17vtE0OBwP56+BweNomR4PhsE1OTodt0j9s",
+	"k+Pe8WEvHyssVr9EvVMas+Lu6dX8MojmqziawMdOrzs4HfZOToe9Qe/k+PhkaMIBdDAx49yPwktEJ7RG",
+	"dQeHQ/j/o7PD4engdNg3eoTRpdS9qRl63V7v7PT47OTs6OS4d9o7G7r5dYFzvhcoYDHPizoVXlLQrlm2",
+	"LOuztE6VWLSQ5cI1z4xZMaHko6QAZNOhZL+OOaRDjxjQ5lrEgN6ZDjGgD02DqFa0nf4woHvQHgY0sZWH",
+	"rwQRvhPLmIkt9y8Lzlm8pGF3eUQfur7QktoCWiOzBdQSIL5kVLxKarPMYEamhwrRTQtaDlEroA9c0MpB",
+	"ad9qw7+xIIjaZLkW5X99Tn6NgtmchnOUJl6TabRkAk9+QDxcY6LzmBEqVXpgL0fFINgB/+LykCjnJgF1",
+	"8hL1jXnSGi5I+XRBE6Q9whuulpB/u6DJt7r5rXo12FPdU7CMeykb+BGLAbiufaJWqksbz/0rFhI4B7hJ",
+	"UBBUXB+DKMP0e7bi5M/9jnI4lbgs/Ovlu0v8Ex2EsrTsjHM6Z7ZA+sXMRBNHgXxQ8DVP2DKXqEaiQG3V",
+	"qa4KFcnEvNKJUm6l3ylMg7f/v4wBxX/cW6747JDzfANwoJt9znMNBX3MLQT7t8CsbMv1kHUkbnect/Pl",
+	"ni2uO12ALZ5/7F3sM2mQBRzJKMrAYrIJxwYUuF7o958LOzdDypu2YyyJgGV4p/R6xgPeCcauXHCtTyDA",
+	"Y7pcBZ0yp8AcwPJegcIl8ORkeDwYnJ66k+0cdo87SRpPok6vPzjWIwiwXc78cM5i3IvoMltdHh2d9M68",
+	"4Ww6yeYTe5NZ07T3k8c+m09tTVbgR+ORngG4pJybCezRKByNQgQ5EPGYtdHIt6Rr8lqeIDJyxcDb9hty",
+	"1JJv2nyNNvDADH2+uIwZ5UIbMmrxJFpJjysVd5zmNjBqgT/OKrnMXvBnesjsaIzPOvB51EqihAbGp0Ef",
+	"59qrCfFh8RvM79QRJeg7mBCDXW/Jd6rZwcfsd2uEfComITy2Cw20TPnrgib/z//9/+dCZ+Vz4i/pnP0l",
+	"YzM276qZDjtfpnHgmNP4dp4fA1EvlkBUh52ugoh63Wv/k79knk+7UTw/gL9W8Bcc+jIK+UGySJeTA+/A",
+	"8w5+mK061z4HSu+HnSX1fFAyJAvWCVEN1JlENPauafCp+/tqfjA4HvZWnzub9bIho9lw4Y+LPJ/OsIB+",
+	"Ni7FYa93Xxy8LF97Hf+28v2VYbvB5R2Yrth+Acs197cxXOcglAiNb41K/K1GWjVcOcLqL+dFVH3oGNou",
+	"u7yZelT9elHm2KldCgsC0mbiUeNU/FXiUS6bYB3OvTCQp0CtKkhsNZlV4xXJazOKetN2jVb4qTlNLaGt",
+	"jww/XSzGxNQCBc3o54vDXs/OE+nC2ic59EkObSKHgleedHr9GmTRP4PuQ+9K+L1nRVMem0qkQoFRIkrt",
+	"TwmwhRogA70AvAC7rW/BZJgIg2cSOhB+RaKZASbLFqGVM9DOVCh4LEhoV67m+f/KLu+TqqZKVYMdxfm8",
+	"+IC3AvcL5yKOwg+NoziH1lKt4zwAFx8VPLTIQjP2WeCeXRwdG2X8sz88OxoMT/tnvXZGw0o45wZs0+KZ",
+	"H79kzBKmwU2NWucZYHOc0YDtqIUHYXI1wdQK7Ax+vrlA3PxqwGPCAVFsC2B00b3hqwFKs/0r0ebmwpY0",
+	"hIEUA073Jmc0lzI2ljG0hFEu1moZ1SFeOGXQHMfPETJ4QxGfiwAJRkECJYH/iRE/JH+NeBKFf3GmTWyU",
+	"nlwxcGv67MdzW0jJcr7PWXI5TeOYhcmlXFROZsnlgB9Bjg/cg+ym9+KHhEoDXRBNaW41KO7qVCC5Fdl7",
+	"UXembTdYxWBjTXxW7C2EczWnUxOXDS/Coh0PNsdewRg89ZM12qJ5QhPWJqw775L3NCTfxzScwguxTb59",
+	"WVChFZ7gaegnuyyOhelSoEFrygLup1yWGKCLmIUL5ie6IIlbj5eDp7ILyzEz+F0UXqn6PwqIeSnoinyD",
+	"pUmE9vf7qIci7yh5gVVgasWKX0UYUfll1M/AmwsjCBgvI8zhFP4r72PFjdzsTu71VtbcywY3s/Zu1t7O",
+	"hldg5xtaGPHGcc2ya+paU9N7mB+5SA7Kr1+pptO+jReGDXg/eu885zNfaeq/7Orj+I/xkyQHGTEoN1fn",
+	"KqHu5dlj3U6tP6i4lSU3svlt3NtNrLiFNTew8vZV3rwGt26fNy7PgPZ/024ssDS4YTdmGaabUXgxCm+T",
+	"kdzOw9y6mqKOUXYvjVv5IuPQTn+H5krliqRHjfTKZ2enZ8Oz/nAjvbKpKS5GDeQ1xmU643qtcU5wNxS9",
+	"WbW5SygnweuN1hpyNAguHeXBGokNNaLD5uKD6EHjearjMEatL6geN67JCH8fjVoCjdvkp5fw1wjI9cb2",
+	"YuNUSrToJXp0E9oOGbSBTv10UKNUPylVqp+dOZXq38uj4E8q9f1ouk2U0EpXcSCrS/Pj4OtwDJQAM90C",
+	"FYyaOQASoqBiAcwE1zkZ/Al8BZsrjRVcUG0sWWMGrReDjZwAq1qpIe/GRnvSGwxPj09OTh8DL1UHQ/4W",
+	"XZMpDd121zqm8WU7/zGg6sYiHCzWjp077J8Mjg97x4Vmk3UiQXcyaJN+rw//c6r+p9+/aBfntslYwQXD",
+	"/SSuW/EGq2648voHcu1K/QbL7EN8Zu+od9holcfFZdk/XGzi15ct9b9qUaA3ODztnZ0OK1Agv7TDw3Kf",
+	"jz0hw381QoSStefXf3i4h0MX7hQNlnXYPTk9GQ76dYuCc+9DLGzvSOFpX/zXLeECUKR6dOj1esdHw+HZ",
+	"8PSkAiVg9Yi5fVz32S2ggHO5Gy65dtm748Uo7fUOp/+Hhd7/wf9sgiL9Xvfs+PDssGa58HK4JVSY0rAe",
+	"FfrHp73+sNevwYOzszY5OwF49m4DDVxL3WS5dUveHQXAvarBEo+6/WG/NzhsQhh6aoGDW6MGr2sQ4LB7",
+	"Mjw7GQyOWWcj5jAo7O/k9vmFYzcb7chJKPbCNoTw14QoHHaPz4bD4yY0TODusfqfnv6v/vC20KVkH4Vb",
+	"eHR80u8PjutoRsUGbgE7Gh9C6QZ2PoXNMQe8ihphdb93etY7HjaiK0eWTNwf3Ba6rKO0BleOu0eHp8cn",
+	"hyfV9AWXPehrnn1yG/jhWu1GK65f9T4kUHg8NqEkg+5p72R4dtxYBMVF9noSpW+P57h3UBTojnq9k/7w",
+	"+LAOL9yLvwUEaQr6isXvAv2NceUvjdD5eAAeVHUMZ3h4S+jwlyavkdN+77R/MqjAhOHhLZz4X5o+Pdzr",
+	"awLDLQ511EQUPun2T4+Oh/3aJQHWbXa0NWaPyhiBza0aNZECZ6U2jf7pKFQrK/MgFI8r2+jxo8QYK1ET",
+	"aCgLmTVkegYj7wVWSzqXeksr20ZWb/xjrps73xI0OrArkLRF8ibhFMw8Iiq+TxmW880NKpyEK4bmyotR",
+	"jc6JL4pBSTMP8bmeqjsKzcwgnzuNcoP8pqrbrsPpny1DyG+OXW9cVZcTmscxLP4fpQkBy4wfztFR309I",
+	"EqlGrIvp3owuPicCD5mH3fGUx6LC2TkZQV3/lHmj1pjQ0ENt9YSRFeSz80gaJn4AE/icjNUM3phEMRnP",
+	"qB8wb9yVZQkcuHHwxbizskKms5zab1hPzYUnG1RVy4MqiYgqnFRSzsxa3YMparYj7hi1zYoggb8hB6C0",
+	"rDRBp6aHezCl4ZQFVfQAG9zGOYup/0ynLGBZesZIvP0gIGNxu8dtwiP5Cb+GmKGesxAPG8CKBrUueVk6",
+	"IA1iRr01GfshJEacx4zzcdskC22TLuAsEUQO0XDOPCQuBVLkJ1z7jwmKlJGTDfJP3RFneSB5p3bNOWWc",
+	"rco3tYqjK99jHhHyFx5WdiWttFPGsew5+9QD9xQRoBFN3tO1jA/nhJKEGXqlfI4Iw+sml9P0Afp4bBnk",
+	"KEDjBkyWTDaDSwYVAybKDl/jyLFVIgO374Z019jYU0Ns90UFGhhh7mKnxj5f9EYNXBDBXyL949NV8M/1",
+	"v/9xMvnh3/G7v/2zx34LfvVPnE4UkMTgssaJ4vj07Ojk9NDlROHY5i4h7sUQHp1jQYSnq9Ilfugx5tnQ",
+	"I+XuGZs51QUsnCeLbZ+ex9VPz3J3uv7A6U73c0T4jsFjfzYS+cBixMUq7pZqbhOkLfo0C9DGjKwZvu6B",
+	"rtpByvdFZB0R1FVh0hIMDajyif/yxP/777+f/mvwnzefvv3h6tfvB4uXn7779a///N9sa9I8POudHJ+d",
+	"9AabEVMgo/ulmpnDgUUvS/3t/JAncQpb3ZRnlMbVmoo3Q9xstwI2p9O1erjltHH2I8CleKvTuRmPW7fq",
+	"zdC4ZY03UqCx5YR5kMa39lHzSrW81TeNnuVenzTGKrZ50YREg5VcsWkSxSRmq5jB81ZVbHbX/H2VHcde",
+	"05tnx3wPZX9ztX1nUeSh0sdjgT8VFehCTwTyUD9hMUT3G6w5u+gArY7eSod6tNPrDYy2TJZrlrVF5EUP",
+	"IpqoYsB3z6P1evNsOjuT0nq81fvNKvFuUOVV987ByoBU+atHr2WvLuuCIxfBYRW8rQKFWe12A+zKQeCF",
+	"gSqlnNdko0HmvjFqiZT+LuZodtE7sHik8atlFQRb3uCwNzwaHJtmc7TxnR0OTgZnpokPsmKQZ/3jwyHB",
+	"fXCC7wAhlgl4Pc8NMjg9PRoMBtkoF07OXc1+K4+mWaRQ6cvl1Hi4GJnlDa6VZ7vWp4ztviRwWmia0i3c",
+	"XDcbIMd0uUpHP/MlGXbaDn70efI9tqjRIr8Jg7VUP2IGf55ZQ0S06iqNVxHPjAV/pCxeZxuWn1v3pTHW",
+	"G92ISWbyjzoQsXfU6k5YEGFFAYQCxJh8w0kUz2komZTJKwWQ98omxVI255B3z1UQeDmGgqvvwpdnpU8y",
+	"aANAh1bO99hMV1+/2TuJNxdYRmDL6ah69MAonUJMp01noY31UbsY9E+OjZ/li+dSyAr9Yf9weHJyeHps",
+	"PUgClgV5chow/uaKxZArtLvyZtYs8krm4nJ4IaXh/nd11Kvc1cnJWX/QL93VKl2t1l24/kH5fmZ+yDpJ",
+	"GmZLsDhCkTMWyPZMkkVJwH70JUKWkmq44m4qjd1cBLpd+YiBAW+7thPMcU+vF3HncJNNaPEvmNKVUDwE",
+	"QYGluT3lzCN0GkeckysqykSz0FtFfphwYdHn/n+QktAgQGqNJ0JElljmkcmaRCGziLcefEWSCJzLyA9/",
+	"xTxe5nB+6PlXvpfSQI4oO1FQr/jLdAmNjvsD8tNfSRSTAVn6QSDMriA0IMV7qW9el7xnDJf3MfuRfMB0",
+	"FfPU9zLs0l8PMIb/OSwxYDQOyTKKmayRDQMBi+UZ3+LpCugf8wRUvpeXBOT9l29fkwiYvGzDyVjcsbHo",
+	"i3t/GzDKGSgDwoROE5Lyi2eKQYGzrcmhnhN/hhF7IWMeLNAP4apz3CFnhCdRTOeMBP7ST2D4h8kts1pW",
+	"kr68sIhLsSzWcg33UNEnN7O9jyKlssyTgwk3L0Zq700VtpKAcZFd58NMce1bYdj5Qp+yrJW9cl3YSihL",
+	"XQfbwMxU5IKlHNDkfgMIt7KVmJr5nZwM+72h1mPajC+3B9GkgutVMzRJT2eKyZilrTRh3JCpWY+Ogy/w",
+	"j3Jd8ljAElZkdd/h75LVbeDIIrhABMRfGuLRPwKpQokvi1zOg/Fiyba+0aNEdJOM8C7eGAcGoit69xv5",
+	"7tWPrz68ehTvj3LS57HgWe4i3znFEjejsIy9Uh8xh5eZAKtpg0SxAm3A3wHG0t0IMdOpWFCOdH/Ki72h",
+	"ZKu0DH4odHsAYCHCUcJXbOrP/Om9XvZHermVA+u93/DShXzdEoaiAW4ZY0PRgixpMl0og5S8Fswjr78r",
+	"EToOjKvsJFHfRdchiDlfLYnKj9ecEiXoBI/TcLXpDOT3QYrUaW71gsOsAmLZArUfIJGStsptadVuhYAV",
+	"cHUWJnttl9OSxUl34yb3X+FTgQ6YH7OrHLJLoZg4+B3CiarsF2/p3A+BxoE64wN2+jv0qbnSrz0WJoDQ",
+	"sXbkDShPyO/RROCAcO1lV6hPWolJ4HTzFz1n6aCzhMWVdo52fik/p8sJi4WaJtPIwMadcRi5CVGBYk3o",
+	"ybqC54NeW83uhwmbs/gOzCwl57HRG+dHme4ptnRy3/ACgHJqI/1x3+TIxse/IMxfDB6x9UUdTRf2U2uH",
+	"wdZ1thjR6PbsMfoMzDXfku07N1uXXbFc1SgtoyUd/Nj58PtvveCn2ZvQ//Z//zY8Ss7e/vLPD8cLO39v",
+	"Xhw7PTvtHx6dnhlNAnalrNXXNLa7GwnWRojuRKyRrOJoyjgnEC26gh+8FEUUoGYiwCcoJhNWoMh5tWWZ",
+	"RvV0OYsQmO/zfwnzChm1FpRfghq64rGZXdO8fcW+3SWmlpWiMORjrkeZPKkbbWOFMajYrbqTWTPdk1HG",
+	"3u1moTG5syDXC3+6IBM296VIqZAUPAChFzSkSNFEJXekDCr9NSAnZwnaHRTvIH44DVKPceKxhPqBFk5Z",
+	"KEK1cF7RSK1CqCq0Xw2gWybHiwUzTyyAkyicsix6D6b++GPermJsU6EbWme4iWfPt2BMH/fAme7Bsz2J",
+	"qR+iZ5IfMOPd+td/nEz+88/fD7+f/e/vf4tPvpv8OPz89+tZ5HaXy6WWvy8HOM3qahimbTOxQFB4uFcY",
+	"QjKWuUdhvoRfGpYRa70vXHoGs+qodSyNGG5ubs17M575ezTJKzYaJiXNuwscnfZODo8zfYaYmXmXejzN",
+	"3kYtU5q8VKuJ4rmVXTVmPA0ShI1wIVdeA4KUiE4q8Ft+uqKB74lh1TUwpi27IgYE9lgZ/AHTBOvIG5RV",
+	"giaL9YrFJXUPRq3wkq2i6SJL/Kzy9H8lxKPdqARHDkbn5AtRgDknAwmRr4ME4bfcfl9oxDPQQcWRPVGs",
+	"26FYpXfTvpM3BeL2Cj9+/bTNAeHNyeBXSMtycPkq5KXcnlQbj82OjodPMtW+KJSbCm0sXv1LjyxsU2bQ",
+	"nFM7If31cy/cnHrCVEZ0t1BGlGm/D74Yv1z+Hk0q0wFllndbb7GRfcvapvDNcxq18suqtG/Jly50TDov",
+	"v+//Gr37wzukf3/5N/7H9Oznf5/4P55+32rfqal+c30HVO4CS7020Rehdadagz0w0YOK83gkPgDNmJVp",
+	"iLfI5f1zm/Kl3QVz8OiVH059KxYqzxXOBsNhv9c/yriCzxf571iUuJRrwELOjbnOl+tOFM/PpylPouUl",
+	"T2cz//P5yR+ny9Xn5XrU2onD2PEDlnThYj48nU4Z8+5EQna+XgVgb8zhmWdm1DgZnjbTpRuG13J+hT4Y",
+	"DqrUlFvlA8BMR4wG/Ks245lI0rU/LmakOnviZwY/e71cMs+nCQvWEj4GT2MZ/98TV+r8Rt6+ef9hM+6U",
+	"ES+JNl8VVxJb2oYn3aJ1tWxRD+ypcnp2CCUJTu/iqVJOym1CbhS5zui5yWqkQfY2njrNGISgrcT+ZrMG",
+	"vcadmMRmLAHt6HXByuruvBKNd2UJc5YQMS/4Pdw3a2g39VLCJd+fn5KE2CP0TrIYpMChjTyT4Pkn7jJJ",
+	"Vx5avuFgqPvRfB9POYNZymP6CryU4POl2M4z33tR4CFEemQ9Qh8mtS1cdoHMvHCyS7nb28v9sYX/k+d9",
+	"+PvsOv3pX6vZj79x9qb3ctn74Y/fl5X+T2eDo97JUa/v9n8CPUsz/yf09IAXHOezNAjW2onD24/H096g",
+	"lKz9H9K/ngzY1T/D6epvpyef2XHv+P1VEyj1toHSz+y64OiiUlifk1lybklb5wKpz89PVkfBL+9YsBv4",
+	"zMf2nvzCmOL7Ls+wQsN8OhR/SeeMHzDPT2qTiL2Gtq88P7ntIHw90T05feH8fOv0YZ6fMI9EMWGfExZ6",
+	"zCMIZakXoCGJYh+kkkD+TkOPUJmi0IwjEMvYL380z3un6G8cCOK7oyRhcXcVzs2vS8o/wUf4N/9N52J8",
+	"SaZpwsiETtaEM0pwJHLNaCwc4SYsZonZM8w8jL/HnAMvRq1+b3D0Gf7nIcWWi3PNcW8B+i6AXpkH8aey",
+	"4HIDsM910mP+qax5BurnhZSgDSFdHqKOC+3CXd77S9sEC0wrEEuGqRswsGPUEcFko2zndptNEQ07hS+E",
+	"mc+FXqXCRVVa5HL5Io0lw1LXFbOblTLayubIWAocRMC2YLbDnwlTlLyY3VLncMGW7keupCQlabbk1zkL",
+	"JR9pxl1u1Z8YZ3iULMXiH3fLKYwTvN8s0R4Ngg7rHJZkiHbecaNtiJdT/wnXW3S0bvj9+JZUsQsJf/bs",
+	"S+bzZoCijsiPWvdF0PXCTVeP3CFWU2hNkft/Dop828QYckFtQIv/pZrfibivZ3uEBJpoyMI5qYANccXu",
+	"hkpnR3uLQv1XIX4LwqCxbTtJ/M5IqkL3LBLZ2salPvei6Ix/XIKQd6nemy4h+c8j715Z9Ow26KwImqq0",
+	"1/wkmtyyUl/MsnGEsUx0IEpoBWtCr6gf0Ekg63nxtijlJMo7cTKh3J86srQwOl2QKGSggFwQKkaNrkMW",
+	"Y385qh/4ydokjxI0eyWPYt2PVuEvll8TjYyNKtX42MLU4e9P2LNWuEfdu9IT4/gd3+v0ShOryjdCUV0s",
+	"LeLDs8PjXm9g9r4Gg/hkre3d2gjegU9xBVEqrKt/p+tqN1/Y4PYWJvHeXMsGiWSXigSaGu1lRhcdqWTx",
+	"q5sii47VFPngC/7bIO8e0qAmNnQckCQRkeM5jeRLOVozu3jO8ECnbMmm0bl0AhTmrjv2njKAsm1KPtvQ",
+	"0iX/jlKyTHlCFvRKJHd9g5whjgJG/LCY5CIDMqFykDthGgfNTuRRJgAU2OtmNjIFYKPNu52yNLu5DU6T",
+	"ZQdsusLapGINB3JQOJOS1icVzBO+0luyY47BxkQscwTS5MyVwmt34mbB945pmIDGpsWOBUD8kCc0nLK2",
+	"FHrBXFAm9WZgdIu9KxYvfc79CK3jd0PCzEpoj54wGREBuYixOiJ0C2TIWIxdbq6W3DhrY5YTlXLRrFws",
+	"q6E7Cs8dxAad4DeVtupTEUK3hmagn3TTW7UFZdPca60ycxmbaB4DyjkAWdSJY58T4nOyimBZPgV3nwWN",
+	"l7O0ICqpQ9g7sbk/E5FRoOw1uaaiBvknXxQ2WHbvz6qTgcVF0CTAdLxwVhDMvQu3zjEbyZa3dovJslZu",
+	"0L3cmlXlLveCn49CUR3TWGMdbVxGXtz5Df7P5QaPtaqy0Tq93nHOSb2kwuUsoPN5JpiZD1+asHkU+8wO",
+	"RIJPnH1OKc48owFnbfPbgias7EtMOV+yMHF/5yyYdeByln2GSQ+WfhjF3N0E5j5IFngEoSw7Vmx15UcB",
+	"Uux5TFcLf1qzmgMf72p9K1GeE7Cgbv/5NVqQN5dY+HhTPKD1JZ9GceUp9buDwemgd9Jnnd7QeVq9bq/f",
+	"G54NB8fDijPrdQdnp0eDo+OT8oPrd48Hh8OzwTHr9E6rD/C4ezI4Gg6Gp4WmroOEum7D3vBkeDg8qj3P",
+	"o+7R4XGvf1TYsOtYT7u9s9Ojoz7r9HsNT3fQPT06Ox0eH7NOv9/wlHvd4WHv+HgwPC4961737KzX75+e",
+	"Zou+qdTqm9JDXrW/tMUFI/g8+1IuyshRS4I0cGtercTyAZvdqrQipjAklduUTMRkbxAUG9hBCSUCYKbM",
+	"kdXtKYgcE/xXvBl3y/kmz+mOZA/oIphl568soeckqz704qpvySj3UrB0lazFCealDgB4V8JKsXB3nVA9",
+	"xD7fTzjsZaKWJsUK56KU5GB2qZUdRLPLCm2NaFEez33W6w/Ojs7k5yVLqLJPfCmU338FS9suZY+Jrs2R",
+	"dWNUbYaotreV8FYXUpQhP4FuVoAw5YYVAoEYaQ4zav2NBUHUJtcLiu+Rl6//YrWVOd/F8Lk4vQtlTCDb",
+	"zBtdEy9iMCO5juJPfyGvPq8C6ofET4gfEu4DdSEJi5c8MyFf3NvDQIC5+S2VIFHHY8TyG7IQAMsBKqJy",
+	"idceECHqgBzH4xDONp17s0MqTHhR7nlhAXSfNEsO3IhqwaLUCb0ovkHu4g6VWwdv9ya1pdyGMJOPPgty",
+	"JcTb987JNxbd/gaHEkRbfxM/ZuRaEeuj3ulhW4BdkGoXof5JHomV00geXUGaTDJRzpAkxa9uKVKOVCI6",
+	"HsRp2FB+fBl679LwDqRIMdE9ab3epeH2giWq0eNU4WIUMjOm9z5ETjzfOyrKv4HcaVx83UiH91POk0tH",
+	"rVolHeUe2JZMkH0A6lKkKnlyooiHx9hKFOT0RYVoSo7JmtGYRIHXHbVusoEv8m/Ce2DQgGP1bFlcJMWc",
+	"TUCXgVn0NwDs4OiEfMmzU5OLNoWowadttuBkoHEa7jerk4BgObe8pKF3GafCbdEE3QsX5ETfF245dRTe",
+	"Gj5eZBlTFV8DSNW9ROI0rH+GdOM0rHqKnAxPzpSdp8kl1g+g6vdQRXpBntA4W4SRJYR9Xvkx49bqTg71",
+	"6nRmjGLPGfWdv+tg5OKngEIEeBxHce5DLh/KkV53Xm01aoGPCY0ZoQSK8M7SIEOxbgYuqBRs5TOxZKsL",
+	"5zNQ/piqcGJY315zVT8KxlKKkXYSVwdHKeUnTW4visYGs7iwxV3A4JjRZeZ/cT/cQ6xiYwZSwkJsNl3g",
+	"ICU8pIaLSEgaTCJjE+YTT2zFAGepE6oMLp/JLk43VGzjTCWxG7PRAN+B39wCs7HR9SJLfiTW++IDAhV3",
+	"AOAUEPRDBXQRH4VqMIRbgevgz+dK6ar8BEL5EJLsSPMBucGME5n6MJsB9U/6vUNIeXvctujflxs8M3ve",
+	"OA3L5wZOWDqx4oAVk+fIjH1WFsMr7FMzOpPP2TxOMBebvcnphzh9jrPJ9iZTkz/l+Jn8VT2rLulUlBpS",
+	"HyweJ39T7E1yN8zy1cE0Ruwal55jc7Kb4mLAr0wG9vEif3btjG1B35KjlLB6OslHf5J+eLmKo3nMOH+o",
+	"x2kusXCm1nxPJ2ucLE/YqpzmwtfLXq9ffrY4QMUBD9sCQRy4ssO5y6Q4mqFe4uSyBFsVVrhP2H2c5Xji",
+	"wAjXESP0ZDEtOJK6dRd/PP+S/SohseRzcSI3m5xw5QV+OuXHfcqyb/k11qM5z1d2rzneHc6xBDMqDtAP",
+	"1WEZkJXwNr41IMlCsDaWL7apZet6OloB8Mpb9QT02wG6x4KEbglu2RnayP86/2ItDMYLPfZ51DrvmRQI",
+	"3AUFzPE/oNcVDVLxUT7O4LzCMEqoYtkfL25uLsRWINz4Ee2IJJFH16OWXv9jWfhfatesUfYR3lgr7+Ie",
+	"7qte+UmjW/tlowvxXwQMwFMaktdSSwLxeAKz/lJ2W7agC5kUW36yj17CsU++kXxjHe5jknK+qGRMWX2G",
+	"QS/bH+Tx1h/Al7SVRAkNst8O+6W6pXIMeRiPWPuYGz5h1fFv+Xi1icBDfcLuGSm8KGQKCT5+9+bnVxeW",
+	"2UVka8F4wj+f4aVQQG/ftpdfpT9SsmDkmtFkwWIS+J8Y8UPynobk+5iGU59Po79UGWgym5vDiczMm6vM",
+	"K5YzmfmzZQKBTyFdyr5zllzKHCaXcqnWMCJUVzueiE6QxtxIfqL36Ic6n1MQTWlhTTBYSTWb4q4UkWrn",
+	"m6xicAxKimEoqkE2t+OzPYkIqi1MUrJvLG3gJ2v0rQGqxtqEdedd+1Db5NuXytsr+7+bdnGhaegnuy6S",
+	"helSIElrygLup1wg5IwuYhYuGMxwUVjMKKxaW0Ym5cgZRK2hjGFucp4oF3drZxTf8caQF46gpsrLUnpV",
+	"Nrkoe7wmlZek9orUXJCa69EI73a8Gu067MvuhWs1TZHeHvcmB6RyDDca3jiCbi5u1bBda9beg1vUJuyp",
+	"1DWKiNt2Lv6RPz0OE7hFJrLKvOUkooRANCcPeyMOFaShhjBUkoVKotCAJOyTIOQv6v6JwY0FlgaEQHW4",
+	"kah4sY0jhe0qcW8SpthLvRch3JEX2d1+FG4Yx/3T/ul9uWGoye/JeH88OOqf7vBKvg8Tr6lkMYmu8cf5",
+	"F01lS4lsjvhsTFttmmouKqOjNvX8YhFMs0dGIAur2oQi3rQ14SsZXVI9i+jlad5N2yJvNnW7aaCNvB83",
+	"mKeb9HST/pw36VbckPZ7nerdkNR8Tzfr6WY9mJt1m25ggPBnt2s+A3S8nNIg4LfrGqRu6O5Gs9yKzT/B",
+	"EvowXLueTu5WT67EfaLhmbkdKLZdeM7bQi4FPl/+9tvPq9N//0C/j3+P3/8+/+Nz8u3p3//e/6t9kLsQ",
+	"fxrP0yULE3HwYt9pIlKxIRDBpeORQrIJgOz9fxmNRq1R68+16YyrZft2Ok19nds3eP6f69xHo1HrpnrT",
+	"UvzhSp59oJJ/fpkPRvq3pM90svSTSzxEQWIl33X9jj0Lx32PnAEpo6YUI/htNGoVZe8R9B1J8Vs1M+Rq",
+	"A+eenkVPz6KcmNbUN4hc+8mCfC8PdJOkMCr5SD45TJyW5BeM07rEggdfNJ1qUJpCpxncIK27XLquoNB1",
+	"p3LXy6hM5373hSdU2sNtKk/sIRfhDl5kVvKFB5aYUFWquIe8Klk1s3IXAlF/Ipe9wpm0RI52m9XW8isT",
+	"pSfyi9PJQdSK9pWrsKtLSjQsMVGgYfI+OBJb5epKlJeV+IElu9EelSv/0VCfjTOgmpUjnghPnvDcQ4bF",
+	"JilQsxIOls+svpXwszPb4C0kR13WZEbN1lpKfJZ3mylVJ99zZ0qtoknqtrioEhagaJBwb6MSFO2S/Hs/",
+	"RZ4/W+9G3JY4Rpe8CYM1fhorcIwxkGbCRBOfefunf/vPFGiC5J5yBG5MfX8S8H0ivs3TAlpX1kr3J3FV",
+	"0gGQMWyXO+G9BR9NOnnPCfvSlQcEqgHRFy3LSH4+caqRWFTfYgMuBIBhgsJ2q3MxD2ule+YgcuxqTmIA",
+	"wL19tecXZhH+MpwowweRNE8zJntl98ugdttVHW8T9LOMs6k598/iStQKB8ohs7oosWq0EQ9slhcXWqpF",
+	"kAkLIthAtFdW2M6vEyqHLoEAhDh9mC4nLIZlC0hy4NsTRsTZMK9LfsTmwK5jGs4ZmbDkmrGQ9FHr0+/1",
+	"ROVjGMwT2f2Iz8mg1x2FaiN/pCxeZzvBBbTMVcuOGAOntuCHCZuz2LWH93Djo9hjMZlIwSLD8jFJ/CXj",
+	"CV2u1GnIrXXJmPLpWHin8ykLsWadGAe2MPaY+uwx+3v5ZvCzezO46lYbFYDAbin+hT9etJuc1DSNeRTj",
+	"glKOzr4rOvdDRFDYzCxh8RigTUN1EV5/R5IFTeAo/JBxUTJ0FdApdgdgBD5PuuT7KDYq+PkzaEiW9BNT",
+	"xb4loxeqPTZl/hWDw1awbBMJHlQaRpPfL2dR1BbT8XTCoXcIaBMEiDt+OA1SjxFc8wvZHpYkwJ9EZMaS",
+	"6ULgJNTsWtE5U+eHSy49ARyyteElqAHthM2imD0y2IpF1wAXlf5RyjcAsBi3dV8aB5MKb6TvLJav18QW",
+	"SYA0MDwguVizpD+tdkKAQx13pbiqYCUKrG+oqLDn6Xo0ofuUOOUqltk+XPJmbgel6ovcaGK1t1FQns9d",
+	"2c8dulcjeUi+ULolaA4PTw+NJg3SMG9Sk8GKoikJmlSJPezP+KMj9Enl/NihJocays4GQj7WhtJelJWy",
+	"MD/kY9x1EmgJtzR0f8jroeoq5QtMODoePmFCXWWYfR+3FdRv1jBx9dwrPoxCNTjMHPPkspQySDeDUnwZ",
+	"tRaUXy6jOKsFWf9ABE6veXTOmKxY+Ef5vaRwnez8XMv8FSpOWWZWdLmV910kK7MQqrYFksdj0HVasLkn",
+	"ZaecfZuiKCo71pNQ11TrebtVkL55HJKkUa6qQgNamT1+M/CUK0Pt5d+ebFonmhogcQMEgPHCwhoJjhfb",
+	"yFAlMm99deQig6oVVtyCysmwf7RJ1RDnxXEJJ878JDmhxCmQ7EksrZBR3AKAo+JHqbjhFDU2N3+qyrWa",
+	"J9tla5uw/uZ+ZVmXL1kit5tSbfAPLLldWeF64aOSxudaWhBKYX67KmF7uWrqeueUDGgPxjtlc5FBG9wf",
+	"qNBwkFG2P6/LimZVDXh4neuKtmOZLKPUn0Wyn/3Xzazju9Y2spv2wsHqNBl44drs81zZySdW+udgpZqw",
+	"uZgpuhJVslNFlUrY6i5ORVtx0cyr6MGxSenmtH8meVsuTI/tWW84MT3x6CfPpq3EgkbOTU4TiMvjKYON",
+	"w/Up+5j3gSpJMfbNHcgTxv7d0kQjYWIPLlBtlZbsSTD5CgWTO/EgK5NoMheyXUSbjTUGBzNf8pU6L7Lv",
+	"seFWcs+CJpbcQUOP4Lx35ThWIv6odZlr4eWL2VIcenJje3Jje3Jje3Jj+zrc2JAN7MeVTdDdB/scEqzx",
+	"gdSM2PCFsq/3CZ52s0eKOMwqf7ZK7aVTd4nT5xWYu2XUVkx8JndW+fDI7an+fVGi6iw+GMT8t+EIZ7nd",
+	"NPJ/wm3WOUEN+ycnQ6OJVT7IcaaVLloPZ43lbkPFNeb8hlwNdnQcEhSxxnsIG9XYEXFt9tOAb/k2OPgi",
+	"X1pNrItwYXfVjdrvBBhRiuY7vREkz8jai5Nrtbd/PYiT2Nu7IVthhqebL08uCWQXZYYpC1CV59pwUQa6",
+	"t9p3Kn0YuLVl7L55cx64vHFgwPlJ9thE9NjKeKp/LHirVgol9y6T5DZbJ5nUmWEJkcTgRQESG0ouVdyx",
+	"GXuvYe11bH1T2yLuvNTAuCWzreK1cRpWK9zeQYPtFG2MxGlYz5Ge4jGfFFlPiqwnRdaDVGQVIIwpWAQR",
+	"AfLGxV5gxWJKzrRlj3xi6w5ao8iK+jEql9jnVRB5TNE316pUd2td1PN8WAEN3uqsjI716tsXSdm03eLJ",
+	"GkVmj7GVklhvWz0HTGNHtRzCVvAOH40yDyvxykMq4XoPOfZg85Vpr9Jwu3BS6LhfeVau1ZnwylqlY404",
+	"gEy7Bwu7BQ0ZWIKbKZ9kvuIqndPJce9kUBHU5i7ku1EYoU5sTHJVqc0Wcc26rCTH+Yi6XJ7j/Gcz4XGh",
+	"q535OJvcjJi00vrmR1D5fYlI8HvYPe4kaTyJrB3mcvzmxygWIK4IppxGHrv0w4TFq5glLDYr4O4Q4th2",
+	"fcGoQteYtkuk8UGlwrU9LPIFt0l/cGhN6Cq+TY6Oh1ajXCFucnxylnexaNddmwZxtQ2uzfBwcNZ7gNcm",
+	"v647vTYwef/p2jzGa1NuRyhwm5wZoXCttrcixEJx4DQebJLPukHk8bs03E5FEcEqH08U8bs0vCdX43dp",
+	"uE30sITu1tL6x69RXC+6FNdynFuq/t5Ezq8X8xvG+jordGc5DSseBHt/D1Q9B4zd1Omxq4oB598OtSpq",
+	"B2WuFGZqBJlmQkxDr11TeMnKgoa1UkupxFIhrZRJKrVSSqmEUpBOjvTqSyWSojTidEguk0LKfYOdFp6C",
+	"3UdLHBfOmCX5o5YyYNmCK2fVKL6Tytqb9u409PESUBu8otp2ltf+foiqLoC+FV1tQFRFEzmP2KtNX9FO",
+	"gJM/E0sS9cijmezzXGG7SYixzfP/lTmY74keV9aDb0CSq+lx9vVWKrHfSkX0w97wqHd/dZwP+wOc/jFV",
+	"m32gFbmfTvK+TvJWKkLv9zjrK0LDfP2nk727isQK4LdY11b5i+DkRjnA26luq/Bk9+q2znUXfzz/kv0q",
+	"IQEeMXgiNw+kevHTKd/3Kcu+5ddYj+Y8XyMyteJ4dzjHEsyoOEA/VIdlQFbC2/jWgCSLCFlj+WKbOkK2",
+	"no5WALzyVj0B/XaAXlKXtxG43VV5jYWVFdpVsdLyP6CXCoyWiVjxqx3l/PECa5+W1lh+uDsiSeTRtazd",
+	"+pgW/pfaNWfmwsd3Yy1T5x7uq175oNGt/bLRhfgvAvkCpjQkr6UuAR3cELP+UnZbtqALmRRbfrKPXsKx",
+	"T76RfGMd7mOScr4UbbuDXtttz+332wUb7mG/DE0qMORhPGLtY274hFXHv+Xj1SYCD/UJu2ekaFp8ei8K",
+	"/6/CaKrV/kXHEsstIzPnmAXZjQbZz+d5hxRZp52UFmq3Wtvl0cnGVdutwawK7sW0+9musoruuSZWfff8",
+	"CNAgm9vx2Z4kK9PuaFbY9yZ14fMD3rSLC5V143dapKwuT6zy8iRXX76wmFFYtTarFj2xi9HXlTWQ/3Fx",
+	"t9Yr8R1vDHlRaft0XJbSq7LJRdnjNam8JLVXpOaC1FyPRni349Vo12Ffdi9cq2mK9Pa4NzkglWO40fCm",
+	"nUPrm1F4cRfm0rIUdJXeKHqxeA/OxT/6R9Ou6ijE+aCMq9ZF1oyz4hKXXOHmF3hv17fi8tZc3cqLW3lt",
+	"G1zafV7Z/FXa/3W9scDS4Kra+RRH4cU+TPSNvaawAeLsi+zOPR7D/dFp7+T4/sy9R6fDk+Md3lVPhvun",
+	"k/w6Dff7Pc56w72a7+lk78hwDwAffk0mXYUnT4b7p1P+sxju1fE+2ZDv0HD/BPQnw/2T4f4xGe7v5Mbe",
+	"iuEeVn7yZLh/2BLOtoZ7dbiPScp5VIb7/T5i6wz3zifsPgz3mgg8Ge4tw71IJPW91L5zTNJUV94zTsNc",
+	"iP1GofV1iQEPvgg6VJlsd+Pg+4ZlPBc0IdeU7z1CvyZlbZyGDSp2Crg8mGqdm4Xnm8lod43Q36uvyUEW",
+	"BP1Vld1sFEbfOGOsGSn+UKLmrcXXWYDE5XmR38l9BMxnialuLWA+n+2nJkHWHcTMZwmxmsfM5zP6fDWx",
+	"89ooXpGdpzYzT2lWnk3Ki+aZOWb+3YSd71JK9Ovk4pUFRbfl4bdVTPSxZPcxioh+pdLDbTqtOkuHikp+",
+	"mqngH47aIA82BVDDmqCOXJfVNUElVAowcburPARByIDEVmJQvjRoBWLctJ9kpieZ6Q5kJrPaaDmNeniS",
+	"lWCrTrkqK3C6PwGrkSblQCAk8LuSjIb4fYeMhkZVd6P8wj0IX2KnX6MCRZyRFICEjOtzMjasnOMHKRZJ",
+	"5LuDcum/kbdv3n94qAkLEQqPUs9iLP0xaVmG/cHwliUGweczj223yGAsxBYZ5OcT/XkPgoPxaffUhKPW",
+	"v6OUCBrk/4eRSRR90jXLG4oPUktHg3q5YdPEg1V8WJBLQS0fECcGO2Nt7aP32GiX+kdYCyUNCU53PzXG",
+	"BZdiGyxjC/b8VJDpqSDTU0Gmp4JMj7yyuKL5u5cvskitrmH0UFWmgh3+SYt8xuLQ658OCKRmdcVdz4fC",
+	"4wFm3fsD4lIcZcUzorCN+pKdjZ4TYubbKJMEAzevk6Rd7OqqvpgFTrTPXXlVplsoDJNJ5y7ntg3qx9TU",
+	"f2lU40W8ibaoIFNZHCbn0FcWyVuxf+L8XIjsrS+xbmdYeAwVW4qInyvZohrsqWaL4FoVhVuwQcVDDT5v",
+	"Uu3d8Sg7+IKbqnc8A/K5e4X3/CvtHnWm9qIaLGYfD7XiSnDiei84eUoPSYsLGLG9Kxxu/AGLZwcGNXgS",
+	"1ZqIalt51ekfLeJ7D0JcvQy3cen1cqszIfI+vyhs3CHl1WqOXYyrXlqrkdRqpLS9qpdrJZM6m3WFCrm2",
+	"lk2JJFaufC7VMJdIX40krxqpq4nEdfMwbcOm1x3ivdP1bgtZZ2+a6UwIOvjcwViCcmX1b4bm4pVoWpCK",
+	"9inJ7E0Q2ZNQ0f7iVCeJ1DAuddIkigJGw/KuGA/o6pkpi29TkikeqKmPsmUYS3InElOaYlo6Wfpw/aLg",
+	"MkqTVZrwcteE99j4QxQFb1Jo+SG6La/RB+PFsKBChwqWQvwVIEUEpAgCj3PQ4z50D1Pz6PCUH4uz6a8L",
+	"FkrZfEHFEYwF1z3PElpxHUM2FuaVXGxZF6CMKvaxA+HHbYFnLPRWkR8KC9SEkZQzfCiKLji17CHkWo0O",
+	"oB7nJAqn8Lxk629iRlBhrnh8l7wMAt13mfIEhhfDJswTedC4H84DphT2QkV+n3UzrTcI/OGA3AN2szWX",
+	"WZH6FVrB8WkBBv+Q4btGQzGSaHLSIx6bx4xxRDaehuG6mymYVN7OB+2wy/P0oKrMnBWyaitoTTCXF242",
+	"wVwKZCJvSAWInYntLh6aC7DjotTXrrOeZXYuPDXIC4drRxP83QB7hR5yKyehXX2Kj89qfIrr32/blyw1",
+	"p3f6BfXPBvWPunvxC9rUhfgpbe+9p+1tnrV3u8Vtkcn6ZrsMv+Vpq/fnWXa7JW2fxJstxZtHWlT3axd8",
+	"Hllp30cvK91uhuLbTTZ0PDg6OrvdZEMa6HxfaYaOB0clqVWPD3tHJ3tJM5RbtfmnSBYmNi2Q6de49+mf",
+	"g1f03z/Rzz97Qe/q8B///vT5xIaDKXUZf5x/0SJWqYTVovE8XbIwEXD7MhoZLHgEv41GraKUMYK+IylM",
+	"qGaGBDAatW4E2iiEL8V3SHNWkx/nrJ8dl6WuHxy5EuQc39xRHmdA8ZNbz+OspzqtRMzHlPP3y56Q1xaU",
+	"N34T2C8Bc1GZ7G/L+18sAd/skUnMhVVtIr3ftOWlKh1dyt+W+J3P0X/TtuRqW6y+aZCe7h6zae/3UtVn",
+	"064n+U836+lm3fHNapTNfLC1YPZ15bnen2i2awbIwS1kM3865Ud6yg2zmQ+2StOrjvcpsfZW2cyfgH6n",
+	"2cwH95FC+8OCVecyfywbUULXqPX4lq5lyj1kkL+fHaCe4hGCvrt7BvkHTCVvJYM8rHzPGeQ/uN9MhfcJ",
+	"8TkxFGTf60dHTlN/97nmH6/8uYsS+OSRyaAOtenh4Kwsr/ipQ216dHKH2eb3q+SpyzbvVPHsI9u8JhhP",
+	"Kp4nFU/DbP/D0nT/R4PitRwOB1sW6q9K8P9eOp1m7saYL+VhZdD53JlG4cyPl+U+4799K1o8eYo/Ek9x",
+	"48DAS+JrchKXyEobuorL5nXu4bIZwVw+4dp2Cwc/7saXSYarlAb5CNLhvEm3GZCzW5TQw4qr2QyvBMAR",
+	"r0RYDbkGTFN33ueYzUeqgsQ5f+4oUl4Zq/VB0/tKkviUQusphdZTCq2nFFqPJ4WWSd02SqEF/YiinYqU",
+	"woOqhpBikycy+kRGn8joExn9ysgo0LYtiCh0a5XW+/lN1A6EwVu39YTUM9zT8/E3dPDfIKE7LpgTKl5u",
+	"6oYgLs5XiehLWDj3Q9a1uNOBH/IVTFOuAXktWtwmwI0p7gvi1hI2QFnZDwFvQzZOwwqoSv3EbUH0ftUf",
+	"1ekf6rNapaEDnl9kPjWPBSxhDpB+hx8kVOsVDA8o8Zex9I0AJbpJWLVLxMwfWPIoYbIhDUTjggREyZ0T",
+	"BVVuFRi3cJWzVT8SbiQW7LrB8I+oItNU747bbqgxlKM/JC20XP7jJMNyD0KmAG7mUlqrj6i5pmEWrweQ",
+	"JmMQeL/doyKas2ka+8kaceDlyv8HW0NoK/opXMDn+EphiAirXSTJ6vzgAAxswSLiyflp77R3cNVH85VM",
+	"UJJ/avw19QOPZFlLxBMClovyO5pXRYhRysUaeTdDw6xfq/iK+ZHROCSL6Bp2DM91QlPPB8Ef/oZHVBSL",
+	"f/EX/GiODX87hv0BjadZ+m5p0eeYxCX2ObxMKEAYoIOo1Eb44lbItR8EUnsAZgiJI8a03y5oUjGrMECW",
+	"jRiFDDa1jGJ8yXj+NGEeycyTXCgjALw04JHqJh4+0YRO/MBPfMZhXzRIWAwvvitGhAWT0IQwOl2QVcT9",
+	"RFqo1LKzOVyrZwmh5IpNkygmMVvFjLNQOL7gVNIi7YdgAdMYMGGEUe4Ha4AmT5fMA33GkoItkpEAjheA",
+	"beAIDeZR7CeLpYkkr5YT5sGD0bWyn2gIDz14sXaSFMf7PZqgmgc8PUAVIuGcRPKJKeyfU5LE1McOYMA1",
+	"5vs+G8sx4fd+wDihcXYZ01UQUY940VTE7lkAwEb4uJgxmqQx4yTwPzHzxsDGjTmtlQSM1yITDHAAG1UH",
+	"4C/pnBVQbM5C4BrwSoeYa2xkzPUa/nZeQ18+5cXPE8x8RK5ojM9sdXhX1A/oJNCqgpdvX3et8mwsqNqJ",
+	"xBz2OWlrG7g/M7YwDSjnohapnxDKySpKWJj4NAjWZEHj5SwNchPGNKuubyVSQku8i5htRXHAH+AdCyjc",
+	"1Hnqe+ycfHy/YgwUEqKXMmzjV37A8WMniTrw8bnQS3it8xaOh3u48ue4+B+kz4DiA7yFZF3sC9b/iQF/",
+	"EdpBMSmy/2RR/FWyczUUHobZ/UNMwwwYuVHyHxsNFtDSoQJaO9C3xYkVS/47N4cFRi8zM2YDyr8bDfcv",
+	"Fk+i/KhX4sdO5egXmbPHnbIbF84B4yEGGc9hHeBaR9IAPwoNtJsCx9oa62DabNb8YTc4YXsAdSbZQA1P",
+	"1h5G2s8Lg3HtklN1lmU8/O65oOugM36YO2KmPxinm/24/RnrGTc6XkevBvfobri9C66KB8u7l4euMakB",
+	"XuPX7eELM3/AMf4eTTaCMVCVt0KzzzxrGJ6NA41qR8k6G1lldXeVlbZqFJWfumQ36nM190AH0DJ44MfK",
+	"/iU9a2mI1Q8BkHXGrTdhAXciOH7MJEe3w1yWUug5UpOPxrLcPUzM7pqoHTC+C1IHbGNc/l7O2RRzM5wz",
+	"J2uEakI3ancUv1V3i65DODb3jB2pjKi+KSJxjj1CI/y67eeAiyziw4BkkkOOLGJHk+GIH7bHG5xvI8Qx",
+	"+r3y/CTfV/7WqP+/aOw7pVbzQ/lIubU3ONNbeHYRqB6KDg1ww5E3gpPtTxZTEwM818RHSDFAlEKPxUA/",
+	"PHIN5EjNFDNjNu0R4c8kEeHacSJZsKVBRUT/bdABLv9PqvemBAE7bkURcj0bkIRcjwanXvMe5tGS7edJ",
+	"TOg0jjgnnF2xmIJ+MGEgXDK3aGk8m3PXfKm/PLfPVjbf/r5nc27xeMg6N3845M5Bqwnadopll56TbqLn",
+	"hNu0YvEsAr0w5Z8EyD/CK0JGxQj+jvc2G/jl29eaTWesPAN69qMT5tbnUqDr+fIwNz/UUUzd1sXq8x+r",
+	"+f5Lc9XGXbd+bziEQ4YofCsfas4SB3ByvzbrboPF8aV8GAz0WDsWUvxQR88cgxQ/NB7EJS8135Zu+Ubd",
+	"zaYCujVHvjdIqo10NLa5ofy2C+KifBTFXTfuvvBKSlhMpwneYScxdQjq+peD6IrFEGNmXGwzMGi7Wy2c",
+	"MQsKN/VrJdbm+5o/1eFpvm/u1zrkynfP/VreXTRpiksGInxQzqdNsEBr7OCkUc7Czvs4cjX0Dmf+kxgi",
+	"f+jZz9VU86dsBQa9NH5t1N1BcnNfKnGvsAfrtyZdC6TW/r0OgQsLyP9cIfyJNhsTNGOB25IzfUrVaPxO",
+	"aSqF0fkzm6bwBS3REdqlRYDwPhA6TsNdkFm5LySL3E+19gbcwsvQc4yQ+1aN0O/EBgxElr/Udnsvi2na",
+	"XdWvlUhsLVr/XddFV8RMFvnf6vDdmtD8qbwjL60IlCxyn/Gt0kDNZ5+V8VN5xyyoq/lNs0tFZivOCnpV",
+	"3jI8/+obJoPHMFiMcQgRiGbqoqF5B7z00GbA02X2C3p2q+Iwfjg340jFY0G95GV2ZRmZpkvSfJQcSmA4",
+	"vj7eVcYFFy/E8/YoVMM06YtdhF5Rxi3DmRN56BXdCwjyfBTq9yFYRFZAIsI5GefzjI+75IOALD7whPpq",
+	"wgglH9+jD0vnPQtl9mt+8UzlhV8ky6DLV2zaBT3G9bwbxfODZRok/orO2YFwf+lw0O2Krl3o8T+Kvz+X",
+	"4McTeZPG5OfIEyqQt5gtm7z/7h8clG9XvsfIggUreHinifLFSCLhHa9tT4RRvu6SdwpAcJaj8KP9BiR/",
+	"pP70Ez4Uq0gvjI42JHQa6bqeiR3T6LU5ZZZc5jsWJDR/h6T80sFMOZ2mN9E5VJyGHbySDcfS0BKXz6Wz",
+	"55X32ojOvy1vHUIhQD175W/lo0N+inhCPHbFgmgF9GIRpYFQM4CBq2D3NRUIbttv/u+OUgYiLoGiaC7G",
+	"nqgojpBdw3+KdgaSGXtttVsBm9PpWpHIIqbJ71XG5J0MyVsYkU2jr7GXm4vC+sVifc9YATdyPbzSv920",
+	"ZTPrYpU8QX3PhItq9KP4ARJG/b8DAEAPCTq8ywQA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -126,8 +126,9 @@ const (
 
 // Defines values for ChatCompletionToolChoiceOption0.
 const (
-	ChatCompletionToolChoiceOption0Auto ChatCompletionToolChoiceOption0 = "auto"
-	ChatCompletionToolChoiceOption0None ChatCompletionToolChoiceOption0 = "none"
+	ChatCompletionToolChoiceOption0Auto     ChatCompletionToolChoiceOption0 = "auto"
+	ChatCompletionToolChoiceOption0None     ChatCompletionToolChoiceOption0 = "none"
+	ChatCompletionToolChoiceOption0Required ChatCompletionToolChoiceOption0 = "required"
 )
 
 // Defines values for CreateChatCompletionFunctionResponseChoicesFinishReason.
@@ -143,12 +144,46 @@ const (
 	CreateChatCompletionFunctionResponseObjectChatCompletion CreateChatCompletionFunctionResponseObject = "chat.completion"
 )
 
+// Defines values for CreateChatCompletionRequestAudioFormat.
+const (
+	CreateChatCompletionRequestAudioFormatFlac  CreateChatCompletionRequestAudioFormat = "flac"
+	CreateChatCompletionRequestAudioFormatMp3   CreateChatCompletionRequestAudioFormat = "mp3"
+	CreateChatCompletionRequestAudioFormatOpus  CreateChatCompletionRequestAudioFormat = "opus"
+	CreateChatCompletionRequestAudioFormatPcm16 CreateChatCompletionRequestAudioFormat = "pcm16"
+	CreateChatCompletionRequestAudioFormatWav   CreateChatCompletionRequestAudioFormat = "wav"
+)
+
+// Defines values for CreateChatCompletionRequestAudioVoice.
+const (
+	CreateChatCompletionRequestAudioVoiceAlloy   CreateChatCompletionRequestAudioVoice = "alloy"
+	CreateChatCompletionRequestAudioVoiceAsh     CreateChatCompletionRequestAudioVoice = "ash"
+	CreateChatCompletionRequestAudioVoiceBallad  CreateChatCompletionRequestAudioVoice = "ballad"
+	CreateChatCompletionRequestAudioVoiceCoral   CreateChatCompletionRequestAudioVoice = "coral"
+	CreateChatCompletionRequestAudioVoiceEcho    CreateChatCompletionRequestAudioVoice = "echo"
+	CreateChatCompletionRequestAudioVoiceSage    CreateChatCompletionRequestAudioVoice = "sage"
+	CreateChatCompletionRequestAudioVoiceShimmer CreateChatCompletionRequestAudioVoice = "shimmer"
+	CreateChatCompletionRequestAudioVoiceVerse   CreateChatCompletionRequestAudioVoice = "verse"
+)
+
 // Defines values for CreateChatCompletionRequestFunctionCall0.
 const (
 	CreateChatCompletionRequestFunctionCall0Auto CreateChatCompletionRequestFunctionCall0 = "auto"
 	CreateChatCompletionRequestFunctionCall0None CreateChatCompletionRequestFunctionCall0 = "none"
 )
 
+// Defines values for CreateChatCompletionRequestInclude.
+const (
+	ChoicesLogprobs   CreateChatCompletionRequestInclude = "choices.logprobs"
+	SystemFingerprint CreateChatCompletionRequestInclude = "system_fingerprint"
+	Usage             CreateChatCompletionRequestInclude = "usage"
+)
+
+// Defines values for CreateChatCompletionRequestModalities.
+const (
+	CreateChatCompletionRequestModalitiesAudio CreateChatCompletionRequestModalities = "audio"
+	CreateChatCompletionRequestModalitiesText  CreateChatCompletionRequestModalities = "text"
+)
+
 // Defines values for CreateChatCompletionRequestModel1.
 const (
 	CreateChatCompletionRequestModel1Gpt35Turbo        CreateChatCompletionRequestModel1 = "gpt-3.5-turbo"
@@ -170,9 +205,15 @@ const (
 	CreateChatCompletionRequestModel1Gpt4VisionPreview CreateChatCompletionRequestModel1 = "gpt-4-vision-preview"
 )
 
+// Defines values for CreateChatCompletionRequestPredictionType.
+const (
+	Content CreateChatCompletionRequestPredictionType = "content"
+)
+
 // Defines values for CreateChatCompletionRequestResponseFormatType.
 const (
 	CreateChatCompletionRequestResponseFormatTypeJsonObject CreateChatCompletionRequestResponseFormatType = "json_object"
+	CreateChatCompletionRequestResponseFormatTypeJsonSchema CreateChatCompletionRequestResponseFormatType = "json_schema"
 	CreateChatCompletionRequestResponseFormatTypeText       CreateChatCompletionRequestResponseFormatType = "text"
 )
 
@@ -357,22 +398,22 @@ const (
 
 // Defines values for CreateSpeechRequestResponseFormat.
 const (
-	Aac  CreateSpeechRequestResponseFormat = "aac"
-	Flac CreateSpeechRequestResponseFormat = "flac"
-	Mp3  CreateSpeechRequestResponseFormat = "mp3"
-	Opus CreateSpeechRequestResponseFormat = "opus"
-	Pcm  CreateSpeechRequestResponseFormat = "pcm"
-	Wav  CreateSpeechRequestResponseFormat = "wav"
+	CreateSpeechRequestResponseFormatAac  CreateSpeechRequestResponseFormat = "aac"
+	CreateSpeechRequestResponseFormatFlac CreateSpeechRequestResponseFormat = "flac"
+	CreateSpeechRequestResponseFormatMp3  CreateSpeechRequestResponseFormat = "mp3"
+	CreateSpeechRequestResponseFormatOpus CreateSpeechRequestResponseFormat = "opus"
+	CreateSpeechRequestResponseFormatPcm  CreateSpeechRequestResponseFormat = "pcm"
+	CreateSpeechRequestResponseFormatWav  CreateSpeechRequestResponseFormat = "wav"
 )
 
 // Defines values for CreateSpeechRequestVoice.
 const (
-	Alloy   CreateSpeechRequestVoice = "alloy"
-	Echo    CreateSpeechRequestVoice = "echo"
-	Fable   CreateSpeechRequestVoice = "fable"
-	Nova    CreateSpeechRequestVoice = "nova"
-	Onyx    CreateSpeechRequestVoice = "onyx"
-	Shimmer CreateSpeechRequestVoice = "shimmer"
+	CreateSpeechRequestVoiceAlloy   CreateSpeechRequestVoice = "alloy"
+	CreateSpeechRequestVoiceEcho    CreateSpeechRequestVoice = "echo"
+	CreateSpeechRequestVoiceFable   CreateSpeechRequestVoice = "fable"
+	CreateSpeechRequestVoiceNova    CreateSpeechRequestVoice = "nova"
+	CreateSpeechRequestVoiceOnyx    CreateSpeechRequestVoice = "onyx"
+	CreateSpeechRequestVoiceShimmer CreateSpeechRequestVoice = "shimmer"
 )
 
 // Defines values for CreateTranscriptionRequestModel1.
@@ -534,7 +575,7 @@ const (
 
 // Defines values for MessageDeltaContentTextObjectType.
 const (
-	Text MessageDeltaContentTextObjectType = "text"
+	MessageDeltaContentTextObjectTypeText MessageDeltaContentTextObjectType = "text"
 )
 
 // Defines values for MessageDeltaObjectDeltaRole.
@@ -632,9 +673,10 @@ const (
 
 // Defines values for RunObjectLastErrorCode.
 const (
-	RunObjectLastErrorCodeInvalidPrompt     RunObjectLastErrorCode = "invalid_prompt"
-	RunObjectLastErrorCodeRateLimitExceeded RunObjectLastErrorCode = "rate_limit_exceeded"
-	RunObjectLastErrorCodeServerError       RunObjectLastErrorCode = "server_error"
+	RunObjectLastErrorCodeInvalidPrompt             RunObjectLastErrorCode = "invalid_prompt"
+	RunObjectLastErrorCodeMaxToolIterationsExceeded RunObjectLastErrorCode = "max_tool_iterations_exceeded"
+	RunObjectLastErrorCodeRateLimitExceeded         RunObjectLastErrorCode = "rate_limit_exceeded"
+	RunObjectLastErrorCodeServerError               RunObjectLastErrorCode = "server_error"
 )
 
 // Defines values for RunObjectObject.
@@ -862,6 +904,20 @@ const (
 	Gptscript XAssistantToolsGPTScriptType = "gptscript"
 )
 
+// Defines values for XAsyncChatCompletionObjectObject.
+const (
+	ChatCompletionAsync XAsyncChatCompletionObjectObject = "chat.completion.async"
+)
+
+// Defines values for XAsyncChatCompletionObjectStatus.
+const (
+	Cancelled  XAsyncChatCompletionObjectStatus = "cancelled"
+	Completed  XAsyncChatCompletionObjectStatus = "completed"
+	Failed     XAsyncChatCompletionObjectStatus = "failed"
+	InProgress XAsyncChatCompletionObjectStatus = "in_progress"
+	Queued     XAsyncChatCompletionObjectStatus = "queued"
+)
+
 // Defines values for XDeleteToolResponseObject.
 const (
 	ToolDeleted XDeleteToolResponseObject = "tool.deleted"
@@ -1128,6 +1184,9 @@ type ChatCompletionRequestAssistantMessage struct {
 	// Name An optional name for the participant. Provides the model information to differentiate between participants of the same role.
 	Name *string `json:"name,omitempty"`
 
+	// Refusal The refusal message generated by the model, if the model declined to respond.
+	Refusal *string `json:"refusal"`
+
 	// Role The role of the messages author, in this case `assistant`.
 	Role ChatCompletionRequestAssistantMessageRole `json:"role"`
 
@@ -1253,6 +1312,21 @@ type ChatCompletionRequestUserMessageRole string
 
 // ChatCompletionResponseMessage A chat completion message generated by the model.
 type ChatCompletionResponseMessage struct {
+	// Audio If the audio output modality is requested, this object contains data about the audio response from the model.
+	Audio *struct {
+		// Data Base64 encoded audio bytes generated by the model, in the format specified in the request.
+		Data string `json:"data"`
+
+		// ExpiresAt The Unix timestamp (in seconds) for when this audio response will no longer be accessible on the server for use in multi-turn conversations.
+		ExpiresAt int `json:"expires_at"`
+
+		// Id Unique identifier for this audio response.
+		Id string `json:"id"`
+
+		// Transcript Transcript of the audio generated by the model.
+		Transcript string `json:"transcript"`
+	} `json:"audio"`
+
 	// Content The contents of the message.
 	Content *string `json:"content"`
 
@@ -1266,6 +1340,9 @@ type ChatCompletionResponseMessage struct {
 		Name string `json:"name"`
 	} `json:"function_call,omitempty"`
 
+	// Refusal The refusal message generated by the model, if the model declined to respond.
+	Refusal *string `json:"refusal"`
+
 	// Role The role of the author of this message.
 	Role ChatCompletionResponseMessageRole `json:"role"`
 
@@ -1294,6 +1371,9 @@ type ChatCompletionStreamResponseDelta struct {
 		Name *string `json:"name,omitempty"`
 	} `json:"function_call,omitempty"`
 
+	// Refusal The refusal message generated by the model, if the model declined to respond.
+	Refusal *string `json:"refusal"`
+
 	// Role The role of the author of this message.
 	Role      *ChatCompletionStreamResponseDeltaRole `json:"role,omitempty"`
 	ToolCalls *[]ChatCompletionMessageToolCallChunk  `json:"tool_calls,omitempty"`
@@ -1343,6 +1423,7 @@ type ChatCompletionToolType string
 // Specifying a particular function via `{"type": "function", "function": {"name": "my_function"}}` forces the model to call that function.
 //
 // `none` is the default when no functions are present. `auto` is the default if functions are present.
+// `required` means the model must call one or more tools.
 type ChatCompletionToolChoiceOption struct {
 	union json.RawMessage
 }
@@ -1355,6 +1436,15 @@ type CompletionUsage struct {
 	// CompletionTokens Number of tokens in the generated completion.
 	CompletionTokens int `json:"completion_tokens"`
 
+	// CompletionTokensDetails Breakdown of tokens used in a completion.
+	CompletionTokensDetails *struct {
+		// AcceptedPredictionTokens When using Predicted Outputs, the number of tokens in the prediction that appeared in the completion.
+		AcceptedPredictionTokens *int `json:"accepted_prediction_tokens,omitempty"`
+
+		// RejectedPredictionTokens When using Predicted Outputs, the number of tokens in the prediction that did not appear in the completion. However, like reasoning tokens, these tokens are still counted in the total completion tokens for purposes of billing, output, and context window limits.
+		RejectedPredictionTokens *int `json:"rejected_prediction_tokens,omitempty"`
+	} `json:"completion_tokens_details,omitempty"`
+
 	// PromptTokens Number of tokens in the prompt.
 	PromptTokens int `json:"prompt_tokens"`
 
@@ -1451,6 +1541,15 @@ type CreateChatCompletionImageResponse = map[string]interface{}
 
 // CreateChatCompletionRequest defines model for CreateChatCompletionRequest.
 type CreateChatCompletionRequest struct {
+	// Audio Parameters for audio output. Required when audio output is requested with `modalities: ["audio"]`.
+	Audio *struct {
+		// Format Specifies the output audio format. Must be one of `wav`, `mp3`, `flac`, `opus`, or `pcm16`.
+		Format CreateChatCompletionRequestAudioFormat `json:"format"`
+
+		// Voice The voice the model uses to respond.
+		Voice CreateChatCompletionRequestAudioVoice `json:"voice"`
+	} `json:"audio"`
+
 	// FrequencyPenalty Number between -2.0 and 2.0. Positive values penalize new tokens based on their existing frequency in the text so far, decreasing the model's likelihood to repeat the same line verbatim.
 	//
 	// [See more information about frequency and presence penalties.](/docs/guides/text-generation/parameter-details)
@@ -1473,6 +1572,9 @@ type CreateChatCompletionRequest struct {
 	// Deprecated:
 	Functions *[]ChatCompletionFunctions `json:"functions,omitempty"`
 
+	// Include Optional sections to include in the response, even if the server omits them by default. Including `choices.logprobs` also requests log probabilities from the model if `logprobs` is not set.
+	Include *[]CreateChatCompletionRequestInclude `json:"include,omitempty"`
+
 	// LogitBias Modify the likelihood of specified tokens appearing in the completion.
 	//
 	// Accepts a JSON object that maps tokens (specified by their token ID in the tokenizer) to an associated bias value from -100 to 100. Mathematically, the bias is added to the logits generated by the model prior to sampling. The exact effect will vary per model, but values between -1 and 1 should decrease or increase likelihood of selection; values like -100 or 100 should result in a ban or exclusive selection of the relevant token.
@@ -1489,12 +1591,24 @@ type CreateChatCompletionRequest struct {
 	// Messages A list of messages comprising the conversation so far. [Example Python code](https://cookbook.openai.com/examples/how_to_format_inputs_to_chatgpt_models).
 	Messages []ChatCompletionRequestMessage `json:"messages"`
 
+	// Modalities Output types that you would like the model to generate for this request. Most models are capable of generating text, which is the default. The `gpt-4o-audio-preview` model can also be used to generate audio, which is requested with `["text", "audio"]`.
+	Modalities *[]CreateChatCompletionRequestModalities `json:"modalities"`
+
 	// Model ID of the model to use. See the [model endpoint compatibility](/docs/models/model-endpoint-compatibility) table for details on which models work with the Chat API.
 	Model CreateChatCompletionRequest_Model `json:"model"`
 
 	// N How many chat completion choices to generate for each input message. Note that you will be charged based on the number of generated tokens across all of the choices. Keep `n` as `1` to minimize costs.
 	N *int `json:"n"`
 
+	// Prediction Configuration for a [Predicted Output](/docs/guides/predicted-outputs), which can greatly improve response times when large parts of the model response are known ahead of time.
+	Prediction *struct {
+		// Content The content that should be matched when generating a model response. If generated tokens would match this content, the entire model response can be returned much more quickly.
+		Content CreateChatCompletionRequest_Prediction_Content `json:"content"`
+
+		// Type The type of the predicted content you want to provide. This type is currently always `content`.
+		Type CreateChatCompletionRequestPredictionType `json:"type"`
+	} `json:"prediction"`
+
 	// PresencePenalty Number between -2.0 and 2.0. Positive values penalize new tokens based on whether they appear in the text so far, increasing the model's likelihood to talk about new topics.
 	//
 	// [See more information about frequency and presence penalties.](/docs/guides/text-generation/parameter-details)
@@ -1502,11 +1616,28 @@ type CreateChatCompletionRequest struct {
 
 	// ResponseFormat An object specifying the format that the model must output. Compatible with [GPT-4 Turbo](/docs/models/gpt-4-and-gpt-4-turbo) and all GPT-3.5 Turbo models newer than `gpt-3.5-turbo-1106`.
 	//
+	// Setting to `{ "type": "json_schema", "json_schema": {...} }` enables Structured Outputs which ensures the model will match your supplied JSON schema.
+	//
 	// Setting to `{ "type": "json_object" }` enables JSON mode, which guarantees the message the model generates is valid JSON.
 	//
 	// **Important:** when using JSON mode, you **must** also instruct the model to produce JSON yourself via a system or user message. Without this, the model may generate an unending stream of whitespace until the generation reaches the token limit, resulting in a long-running and seemingly "stuck" request. Also note that the message content may be partially cut off if `finish_reason="length"`, which indicates the generation exceeded `max_tokens` or the conversation exceeded the max context length.
 	ResponseFormat *struct {
-		// Type Must be one of `text` or `json_object`.
+		// JsonSchema The JSON schema the model must output when the type is `json_schema`.
+		JsonSchema *struct {
+			// Description A description of what the response format is for, used by the model to determine how to respond in the format.
+			Description *string `json:"description,omitempty"`
+
+			// Name The name of the response format. Must be a-z, A-Z, 0-9, or contain underscores and dashes, with a maximum length of 64.
+			Name string `json:"name"`
+
+			// Schema The schema for the response format, described as a JSON Schema object.
+			Schema *map[string]interface{} `json:"schema,omitempty"`
+
+			// Strict Whether to enable strict schema adherence when generating the output. If set to true, the model will always follow the exact schema defined in the `schema` field. Only a subset of JSON Schema is supported when `strict` is `true`.
+			Strict *bool `json:"strict"`
+		} `json:"json_schema,omitempty"`
+
+		// Type Must be one of `text`, `json_object`, or `json_schema`.
 		Type *CreateChatCompletionRequestResponseFormatType `json:"type,omitempty"`
 	} `json:"response_format,omitempty"`
 
@@ -1518,6 +1649,9 @@ type CreateChatCompletionRequest struct {
 	// Stop Up to 4 sequences where the API will stop generating further tokens.
 	Stop *CreateChatCompletionRequest_Stop `json:"stop,omitempty"`
 
+	// Store Whether or not to keep this chat completion when stored chat completions expire.
+	Store *bool `json:"store"`
+
 	// Stream If set, partial message deltas will be sent, like in ChatGPT. Tokens will be sent as data-only [server-sent events](https://developer.mozilla.org/en-US/docs/Web/API/Server-sent_events/Using_server-sent_events#Event_stream_format) as they become available, with the stream terminated by a `data: [DONE]` message. [Example Python code](https://cookbook.openai.com/examples/how_to_stream_completions).
 	Stream *bool `json:"stream"`
 
@@ -1532,6 +1666,7 @@ type CreateChatCompletionRequest struct {
 	// Specifying a particular function via `{"type": "function", "function": {"name": "my_function"}}` forces the model to call that function.
 	//
 	// `none` is the default when no functions are present. `auto` is the default if functions are present.
+	// `required` means the model must call one or more tools.
 	ToolChoice *ChatCompletionToolChoiceOption `json:"tool_choice,omitempty"`
 
 	// Tools A list of tools the model may call. Currently, only functions are supported as a tool. Use this to provide a list of functions the model may generate JSON inputs for. A max of 128 functions are supported.
@@ -1549,6 +1684,12 @@ type CreateChatCompletionRequest struct {
 	User *string `json:"user,omitempty"`
 }
 
+// CreateChatCompletionRequestAudioFormat Specifies the output audio format. Must be one of `wav`, `mp3`, `flac`, `opus`, or `pcm16`.
+type CreateChatCompletionRequestAudioFormat string
+
+// CreateChatCompletionRequestAudioVoice The voice the model uses to respond.
+type CreateChatCompletionRequestAudioVoice string
+
 // CreateChatCompletionRequestFunctionCall0 `none` means the model will not call a function and instead generates a message. `auto` means the model can pick between generating a message or calling a function.
 type CreateChatCompletionRequestFunctionCall0 string
 
@@ -1564,6 +1705,12 @@ type CreateChatCompletionRequest_FunctionCall struct {
 	union json.RawMessage
 }
 
+// CreateChatCompletionRequestInclude defines model for CreateChatCompletionRequest.Include.
+type CreateChatCompletionRequestInclude string
+
+// CreateChatCompletionRequestModalities defines model for CreateChatCompletionRequest.Modalities.
+type CreateChatCompletionRequestModalities string
+
 // CreateChatCompletionRequestModel0 defines model for .
 type CreateChatCompletionRequestModel0 = string
 
@@ -1575,7 +1722,21 @@ type CreateChatCompletionRequest_Model struct {
 	union json.RawMessage
 }
 
-// CreateChatCompletionRequestResponseFormatType Must be one of `text` or `json_object`.
+// CreateChatCompletionRequestPredictionContent0 The content used for a Predicted Output. This is often the text of a file you are regenerating with minor changes.
+type CreateChatCompletionRequestPredictionContent0 = string
+
+// CreateChatCompletionRequestPredictionContent1 An array of content parts with a defined type. Supported options differ based on the model being used to generate the response. Can contain text inputs.
+type CreateChatCompletionRequestPredictionContent1 = []ChatCompletionRequestMessageContentPartText
+
+// CreateChatCompletionRequest_Prediction_Content The content that should be matched when generating a model response. If generated tokens would match this content, the entire model response can be returned much more quickly.
+type CreateChatCompletionRequest_Prediction_Content struct {
+	union json.RawMessage
+}
+
+// CreateChatCompletionRequestPredictionType The type of the predicted content you want to provide. This type is currently always `content`.
+type CreateChatCompletionRequestPredictionType string
+
+// CreateChatCompletionRequestResponseFormatType Must be one of `text`, `json_object`, or `json_schema`.
 type CreateChatCompletionRequestResponseFormatType string
 
 // CreateChatCompletionRequestStop0 defines model for .
@@ -2329,6 +2490,9 @@ type CreateRunRequest struct {
 	// Instructions Overrides the [instructions](/docs/api-reference/assistants/createAssistant) of the assistant. This is useful for modifying the behavior on a per-run basis.
 	Instructions *string `json:"instructions"`
 
+	// MaxToolIterations The maximum number of tool call round trips the run can make before it fails. If not set, then the limit configured for the server is used.
+	MaxToolIterations *int `json:"max_tool_iterations"`
+
 	// Metadata Set of 16 key-value pairs that can be attached to an object. This can be useful for storing additional information about the object in a structured format. Keys can be a maximum of 64 characters long and values can be a maxium of 512 characters long.
 	Metadata *map[string]interface{} `json:"metadata"`
 
@@ -2390,6 +2554,9 @@ type CreateThreadAndRunRequest struct {
 	// Instructions Override the default system message of the assistant. This is useful for modifying the behavior on a per-run basis.
 	Instructions *string `json:"instructions"`
 
+	// MaxToolIterations The maximum number of tool call round trips the run can make before it fails. If not set, then the limit configured for the server is used.
+	MaxToolIterations *int `json:"max_tool_iterations"`
+
 	// Metadata Set of 16 key-value pairs that can be attached to an object. This can be useful for storing additional information about the object in a structured format. Keys can be a maximum of 64 characters long and values can be a maxium of 512 characters long.
 	Metadata *map[string]interface{} `json:"metadata"`
 
@@ -2752,6 +2919,9 @@ type FunctionObject struct {
 	//
 	// Omitting `parameters` defines a function with an empty parameter list.
 	Parameters *FunctionParameters `json:"parameters"`
+
+	// Strict Whether to enable strict schema adherence when generating the function call. If set to true, the model will follow the exact schema defined in the `parameters` field. Only a subset of JSON Schema is supported when `strict` is `true`.
+	Strict *bool `json:"strict"`
 }
 
 // FunctionParameters The parameters the functions accepts, described as a JSON Schema object. See the [guide](/docs/guides/text-generation/function-calling) for examples, and the [JSON Schema reference](https://json-schema.org/understanding-json-schema/) for documentation about the format.
@@ -3364,7 +3534,7 @@ type RunObject struct {
 
 	// LastError The last error associated with this run. Will be `null` if there are no errors.
 	LastError *struct {
-		// Code One of `server_error`, `rate_limit_exceeded`, or `invalid_prompt`.
+		// Code One of `server_error`, `rate_limit_exceeded`, `invalid_prompt`, or `max_tool_iterations_exceeded`.
 		Code RunObjectLastErrorCode `json:"code"`
 
 		// Message A human-readable description of the error.
@@ -3417,7 +3587,7 @@ type RunObject struct {
 	Usage *RunCompletionUsage `json:"usage"`
 }
 
-// RunObjectLastErrorCode One of `server_error`, `rate_limit_exceeded`, or `invalid_prompt`.
+// RunObjectLastErrorCode One of `server_error`, `rate_limit_exceeded`, `invalid_prompt`, or `max_tool_iterations_exceeded`.
 type RunObjectLastErrorCode string
 
 // RunObjectObject The object type, which is always `thread.run`.
@@ -4099,6 +4269,33 @@ type XAssistantToolsGPTScript struct {
 // XAssistantToolsGPTScriptType The type of tool being defined: `gptscript`
 type XAssistantToolsGPTScriptType string
 
+// XAsyncChatCompletionObject defines model for XAsyncChatCompletionObject.
+type XAsyncChatCompletionObject struct {
+	// Completion Represents a chat completion response returned by model, based on the provided input.
+	Completion *CreateChatCompletionResponse `json:"completion,omitempty"`
+
+	// CreatedAt The Unix timestamp (in seconds) for when the chat completion was created.
+	CreatedAt int `json:"created_at"`
+
+	// Error The error message if the chat completion failed.
+	Error *string `json:"error"`
+
+	// Id The ID of the chat completion.
+	Id string `json:"id"`
+
+	// Object The object type, which is always `chat.completion.async`.
+	Object XAsyncChatCompletionObjectObject `json:"object"`
+
+	// Status The status of the chat completion, which can be either `queued`, `in_progress`, `completed`, `failed`, or `cancelled`.
+	Status XAsyncChatCompletionObjectStatus `json:"status"`
+}
+
+// XAsyncChatCompletionObjectObject The object type, which is always `chat.completion.async`.
+type XAsyncChatCompletionObjectObject string
+
+// XAsyncChatCompletionObjectStatus The status of the chat completion, which can be either `queued`, `in_progress`, `completed`, `failed`, or `cancelled`.
+type XAsyncChatCompletionObjectStatus string
+
 // XConfirmRunToolRequest defines model for XConfirmRunToolRequest.
 type XConfirmRunToolRequest struct {
 	// Confirmation The confirmation to submit.
@@ -4432,6 +4629,9 @@ type ListRunsParams struct {
 
 	// Before A cursor for use in pagination. `before` is an object ID that defines your place in the list. For instance, if you make a list request and receive 100 objects, ending with obj_foo, your subsequent call can include before=obj_foo in order to fetch the previous page of the list.
 	Before *string `form:"before,omitempty" json:"before,omitempty"`
+
+	// Metadata Only return runs with all of these metadata key-value pairs.
+	Metadata *map[string]string `json:"metadata,omitempty"`
 }
 
 // ListRunsParamsOrder defines parameters for ListRuns.
@@ -4523,6 +4723,9 @@ type CreateTranslationMultipartRequestBody = CreateTranslationRequest
 // CreateChatCompletionJSONRequestBody defines body for CreateChatCompletion for application/json ContentType.
 type CreateChatCompletionJSONRequestBody = CreateChatCompletionRequest
 
+// XCreateAsyncChatCompletionJSONRequestBody defines body for XCreateAsyncChatCompletion for application/json ContentType.
+type XCreateAsyncChatCompletionJSONRequestBody = CreateChatCompletionRequest
+
 // CreateCompletionJSONRequestBody defines body for CreateCompletion for application/json ContentType.
 type CreateCompletionJSONRequestBody = CreateCompletionRequest
 
@@ -5469,6 +5672,68 @@ func (t *CreateChatCompletionRequest_Model) UnmarshalJSON(b []byte) error {
 	return err
 }
 
+// AsCreateChatCompletionRequestPredictionContent0 returns the union data inside the CreateChatCompletionRequest_Prediction_Content as a CreateChatCompletionRequestPredictionContent0
+func (t CreateChatCompletionRequest_Prediction_Content) AsCreateChatCompletionRequestPredictionContent0() (CreateChatCompletionRequestPredictionContent0, error) {
+	var body CreateChatCompletionRequestPredictionContent0
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCreateChatCompletionRequestPredictionContent0 overwrites any union data inside the CreateChatCompletionRequest_Prediction_Content as the provided CreateChatCompletionRequestPredictionContent0
+func (t *CreateChatCompletionRequest_Prediction_Content) FromCreateChatCompletionRequestPredictionContent0(v CreateChatCompletionRequestPredictionContent0) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCreateChatCompletionRequestPredictionContent0 performs a merge with any union data inside the CreateChatCompletionRequest_Prediction_Content, using the provided CreateChatCompletionRequestPredictionContent0
+func (t *CreateChatCompletionRequest_Prediction_Content) MergeCreateChatCompletionRequestPredictionContent0(v CreateChatCompletionRequestPredictionContent0) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsCreateChatCompletionRequestPredictionContent1 returns the union data inside the CreateChatCompletionRequest_Prediction_Content as a CreateChatCompletionRequestPredictionContent1
+func (t CreateChatCompletionRequest_Prediction_Content) AsCreateChatCompletionRequestPredictionContent1() (CreateChatCompletionRequestPredictionContent1, error) {
+	var body CreateChatCompletionRequestPredictionContent1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCreateChatCompletionRequestPredictionContent1 overwrites any union data inside the CreateChatCompletionRequest_Prediction_Content as the provided CreateChatCompletionRequestPredictionContent1
+func (t *CreateChatCompletionRequest_Prediction_Content) FromCreateChatCompletionRequestPredictionContent1(v CreateChatCompletionRequestPredictionContent1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCreateChatCompletionRequestPredictionContent1 performs a merge with any union data inside the CreateChatCompletionRequest_Prediction_Content, using the provided CreateChatCompletionRequestPredictionContent1
+func (t *CreateChatCompletionRequest_Prediction_Content) MergeCreateChatCompletionRequestPredictionContent1(v CreateChatCompletionRequestPredictionContent1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t CreateChatCompletionRequest_Prediction_Content) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *CreateChatCompletionRequest_Prediction_Content) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
 // AsCreateChatCompletionRequestStop0 returns the union data inside the CreateChatCompletionRequest_Stop as a CreateChatCompletionRequestStop0
 func (t CreateChatCompletionRequest_Stop) AsCreateChatCompletionRequestStop0() (CreateChatCompletionRequestStop0, error) {
 	var body CreateChatCompletionRequestStop0
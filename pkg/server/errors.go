@@ -2,6 +2,7 @@ package server
 
 import (
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/acorn-io/z"
@@ -27,6 +28,15 @@ func NewAPIError(message, errorType string) *APIError {
 	}
 }
 
+// errorTypeForStatusCode returns the type of error for a failed job with the status code. Failures with a 4xx status
+// code are caused by the request, and all others are internal errors.
+func errorTypeForStatusCode(code int) string {
+	if code >= http.StatusBadRequest && code < http.StatusInternalServerError {
+		return InvalidRequestErrorType
+	}
+	return InternalErrorType
+}
+
 func NewNotFoundError(obj db.Storer) *APIError {
 	return NewAPIError(
 		fmt.Sprintf("No %s found with id '%s'.", strings.ToLower(strings.Split(fmt.Sprintf("%T", obj), ".")[1]), obj.GetID()),
@@ -54,5 +64,5 @@ func (e *APIError) String() string {
 	} else {
 		*e.Param = fmt.Sprintf("%q", *e.Param)
 	}
-	return fmt.Sprintf(`{"error":{"message":%q,"type":%q,"param":%s,"code":%v}}`, e.Type, e.Message, *e.Param, e.Code)
+	return fmt.Sprintf(`{"error":{"message":%q,"type":%q,"param":%s,"code":%v}}`, e.Message, e.Type, *e.Param, e.Code)
 }
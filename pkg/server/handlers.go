@@ -1,6 +1,7 @@
 package server
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -254,26 +255,26 @@ func (s *Server) ModifyAssistant(w http.ResponseWriter, r *http.Request, assista
 		}
 	}
 
-	if len(tools) == 0 {
-		// This request isn't updating the tools on the assistant.
-		// Therefore, get the tool from the existing assistant.
-		existingAssistant := &db.Assistant{
-			Metadata: db.Metadata{
-				Base: db.Base{ID: assistantID},
-			},
-		}
-		if err = db.Get(s.db.WithContext(r.Context()), existingAssistant, assistantID); err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				w.WriteHeader(http.StatusNotFound)
-				_, _ = w.Write([]byte(NewNotFoundError(existingAssistant).Error()))
-				return
-			}
-			slog.Error("Failed to get assistant", "id", assistantID, "err", err)
-			w.WriteHeader(http.StatusInternalServerError)
-			_, _ = w.Write([]byte(NewAPIError("Failed to get assistant.", InternalErrorType).Error()))
+	existingAssistant := &db.Assistant{
+		Metadata: db.Metadata{
+			Base: db.Base{ID: assistantID},
+		},
+	}
+	if err = db.Get(s.db.WithContext(r.Context()), existingAssistant, assistantID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(NewNotFoundError(existingAssistant).Error()))
 			return
 		}
+		slog.Error("Failed to get assistant", "id", assistantID, "err", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(NewAPIError("Failed to get assistant.", InternalErrorType).Error()))
+		return
+	}
 
+	if len(tools) == 0 {
+		// This request isn't updating the tools on the assistant.
+		// Therefore, get the tool from the existing assistant.
 		tools = existingAssistant.Tools
 	}
 
@@ -314,6 +315,18 @@ func (s *Server) ModifyAssistant(w http.ResponseWriter, r *http.Request, assista
 		Model:        model,
 		Name:         modifyAssistantRequest.Name,
 		Tools:        datatypes.NewJSONSlice(tools),
+		ToolsVersion: existingAssistant.ToolsVersion,
+	}
+
+	// Runs keep the tools they were created with, and the tools version tells which tools that was.
+	changed, err := toolsChanged(existingAssistant.Tools, tools)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(NewAPIError("Failed to process tool.", InvalidRequestErrorType).Error()))
+		return
+	}
+	if changed {
+		assistant.ToolsVersion++
 	}
 
 	modifyAndRespond(s.db.WithContext(r.Context()), w, assistant, assistant)
@@ -668,36 +681,150 @@ func (s *Server) CreateTranslation(w http.ResponseWriter, r *http.Request) {
 }
 
 func (s *Server) CreateChatCompletion(w http.ResponseWriter, r *http.Request) {
+	ccr, ok := s.createChatCompletionRequest(w, r, false)
+	if !ok {
+		return
+	}
+
+	// Kick the chat completion runner to check for new requests, and get the ready signal.
+	ready := s.triggers.ChatCompletion.Kick(ccr.ID)
+
+	gormDB := s.db.WithContext(r.Context())
+	if !z.Dereference(ccr.Stream) {
+		waitForAndWriteProjectedResponse(r.Context(), ready, w, gormDB, ccr.ID, new(db.CreateChatCompletionResponse), s.chatCompletionOmittedFields(ccr.Include))
+	} else {
+		waitForAndStreamResponse[*db.ChatCompletionResponseChunk](r.Context(), w, s.streamConfig.negotiate(r), gormDB, ccr.ID, 0)
+	}
+
+	if r.Context().Err() != nil {
+		// The client disconnected before the response was complete, so the request to the model provider can be aborted.
+		// The request context is done, so it can't be used to update the database.
+		if cancelled, err := db.CancelChatCompletion(s.db.WithContext(context.Background()), ccr.ID); err != nil {
+			slog.Error("Failed to cancel chat completion request", "id", ccr.ID, "err", err)
+		} else if cancelled {
+			// Kick the chat completion runner so that the agent processing the request checks for the cancellation
+			// without waiting for its next poll.
+			s.triggers.ChatCompletion.Kick(ccr.ID)
+		}
+	}
+}
+
+// createChatCompletionRequest reads and validates the chat completion request and stores it in the database so that it
+// is picked up by the chat completion agent. Async requests are polled for their result, so they cannot be streamed. If
+// the request cannot be created, then the error is written to the response and false is returned.
+func (s *Server) createChatCompletionRequest(w http.ResponseWriter, r *http.Request, async bool) (*db.CreateChatCompletionRequest, bool) {
 	createCompletionRequest := new(openai.CreateChatCompletionRequest)
 	if err := readObjectFromRequest(r, createCompletionRequest); err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		_, _ = w.Write([]byte(err.Error()))
-		return
+		return nil, false
+	}
+
+	if err := validateChatCompletionMessageNames(createCompletionRequest.Messages); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+		return nil, false
+	}
+
+	if err := validateChatCompletionTools(z.Dereference(createCompletionRequest.Tools)); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+		return nil, false
+	}
+
+	if err := validateChatCompletionToolChoice(createCompletionRequest.ToolChoice, z.Dereference(createCompletionRequest.Tools)); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+		return nil, false
+	}
+
+	if err := validateUser(createCompletionRequest.User); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+		return nil, false
+	}
+
+	if err := validateChatCompletionPrediction(createCompletionRequest.Prediction); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+		return nil, false
+	}
+
+	if err := validateChatCompletionInclude(z.Dereference(createCompletionRequest.Include)); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+		return nil, false
 	}
 
 	ccr := new(db.CreateChatCompletionRequest)
 	if err := ccr.FromPublic(createCompletionRequest); err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		_, _ = w.Write([]byte(NewAPIError("Failed to process request.", InvalidRequestErrorType).Error()))
-		return
+		return nil, false
 	}
 
-	gormDB := s.db.WithContext(r.Context())
-	if err := db.Create(gormDB, ccr); err != nil {
+	if ccr.Logprobs == nil && slices.Contains(ccr.Include, openai.ChoicesLogprobs) {
+		// The log probabilities can only be included if they are requested from the model.
+		ccr.Logprobs = z.Pointer(true)
+	}
+
+	if async && z.Dereference(ccr.Stream) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(NewAPIError("Async chat completions cannot be streamed.", InvalidRequestErrorType).Error()))
+		return nil, false
+	}
+
+	if err := validateMaxTokens(ccr.Model, ccr.MaxTokens); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+		return nil, false
+	}
+
+	if err := validateChatCompletionAudio(ccr.Modalities, ccr.Audio.Data(), z.Dereference(ccr.Stream)); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+		return nil, false
+	}
+
+	if err := validateChatCompletionResponseFormat(ccr.ResponseFormat, ccr.ResponseFormatJSONSchema.Data()); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+		return nil, false
+	}
+
+	priority, err := requestPriority(r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+		return nil, false
+	}
+	ccr.Priority = priority
+
+	if err := db.Create(s.db.WithContext(r.Context()), ccr); err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		_, _ = w.Write([]byte(NewAPIError("Failed to create chat completion request.", InternalErrorType).Error()))
-		return
+		return nil, false
 	}
 
-	// Kick the chat completion runner to check for new requests, and get the ready signal.
-	ready := s.triggers.ChatCompletion.Kick(ccr.ID)
+	return ccr, true
+}
 
-	if !z.Dereference(ccr.Stream) {
-		waitForAndWriteResponse(r.Context(), ready, w, gormDB, ccr.ID, new(db.CreateChatCompletionResponse))
-		return
+// chatCompletionOmittedFields returns the fields that are omitted from a chat completion response. The sections that the
+// request includes are never omitted. Only the fields that the server is configured to omit are omitted, so a request
+// that includes a section that isn't configured to be omitted gets the same response as one that doesn't include it.
+func (s *Server) chatCompletionOmittedFields(include []openai.CreateChatCompletionRequestInclude) []string {
+	if len(include) == 0 {
+		return s.chatCompletionOmitFields
 	}
 
-	waitForAndStreamResponse[*db.ChatCompletionResponseChunk](r.Context(), w, gormDB, ccr.ID, 0)
+	omit := make([]string, 0, len(s.chatCompletionOmitFields))
+	for _, field := range s.chatCompletionOmitFields {
+		if !slices.Contains(include, openai.CreateChatCompletionRequestInclude(field)) {
+			omit = append(omit, field)
+		}
+	}
+
+	return omit
 }
 
 func (s *Server) CreateCompletion(w http.ResponseWriter, _ *http.Request) {
@@ -713,6 +840,12 @@ func (s *Server) CreateEmbedding(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := validateUser(createEmbeddingRequest.User); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+		return
+	}
+
 	cer := new(db.CreateEmbeddingRequest)
 	if err := cer.FromPublic(createEmbeddingRequest); err != nil {
 		w.WriteHeader(http.StatusBadRequest)
@@ -1068,6 +1201,12 @@ func (s *Server) CreateThreadAndRun(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := validateMaxToolIterations(createThreadAndRunRequest.MaxToolIterations); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+		return
+	}
+
 	//nolint:govet
 	publicThread := &openai.ThreadObject{
 		// The first two fields will be set on create.
@@ -1169,6 +1308,7 @@ func (s *Server) CreateThreadAndRun(w http.ResponseWriter, r *http.Request) {
 		_, _ = w.Write([]byte(NewAPIError("Failed to process request.", InvalidRequestErrorType).Error()))
 		return
 	}
+	run.MaxToolIterations = createThreadAndRunRequest.MaxToolIterations
 
 	runCreatedEvent := &db.RunEvent{
 		EventName: string(openai.ThreadRunCreated),
@@ -1395,7 +1535,14 @@ func (s *Server) ListRuns(w http.ResponseWriter, r *http.Request, threadID strin
 		return
 	}
 
-	listAndRespond[*db.Run](gormDB.Where("thread_id = ?", threadID), w, limit)
+	gormDB, err = filterByMetadata(gormDB.Where("thread_id = ?", threadID), params.Metadata)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+		return
+	}
+
+	listAndRespond[*db.Run](gormDB, w, limit)
 }
 
 func (s *Server) CreateRun(w http.ResponseWriter, r *http.Request, threadID string) {
@@ -1444,6 +1591,12 @@ func (s *Server) CreateRun(w http.ResponseWriter, r *http.Request, threadID stri
 		return
 	}
 
+	if err := validateMaxToolIterations(createRunRequest.MaxToolIterations); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+		return
+	}
+
 	var tools []openai.RunObject_Tools_Item
 	if createRunRequest.Tools != nil {
 		tools = make([]openai.RunObject_Tools_Item, 0, len(*createRunRequest.Tools))
@@ -1487,6 +1640,8 @@ func (s *Server) CreateRun(w http.ResponseWriter, r *http.Request, threadID stri
 		_, _ = w.Write([]byte(NewAPIError("Failed to process request.", InvalidRequestErrorType).Error()))
 		return
 	}
+	run.AdditionalInstructions = createRunRequest.AdditionalInstructions
+	run.MaxToolIterations = createRunRequest.MaxToolIterations
 
 	runCreatedEvent := &db.RunEvent{
 		EventName: string(openai.ThreadRunCreated),
@@ -1536,7 +1691,7 @@ func (s *Server) CreateRun(w http.ResponseWriter, r *http.Request, threadID stri
 		return
 	}
 
-	waitForAndStreamResponse[*db.RunEvent](r.Context(), w, gormDB, run.ID, 0)
+	waitForAndStreamResponse[*db.RunEvent](r.Context(), w, s.streamConfig.negotiate(r), gormDB, run.ID, 0)
 }
 
 func (s *Server) GetRun(w http.ResponseWriter, r *http.Request, threadID string, runID string) {
@@ -1769,7 +1924,7 @@ func (s *Server) SubmitToolOuputsToRun(w http.ResponseWriter, r *http.Request, t
 	}
 
 	// Start streaming from the index we just created.
-	waitForAndStreamResponse[*db.RunEvent](r.Context(), w, s.db.WithContext(r.Context()), runID, eventIndexStart)
+	waitForAndStreamResponse[*db.RunEvent](r.Context(), w, s.streamConfig.negotiate(r), s.db.WithContext(r.Context()), runID, eventIndexStart)
 }
 
 func readObjectFromRequest(r *http.Request, obj any) error {
@@ -1843,6 +1998,28 @@ func createAndRespond(gormDB *gorm.DB, w http.ResponseWriter, obj Transformer, p
 	writeObjectToResponse(w, obj.ToPublic())
 }
 
+// filterByMetadata returns a query for the objects that have all the metadata key-value pairs. The filter has the same
+// limits as the metadata of the objects.
+func filterByMetadata(gormDB *gorm.DB, metadata *map[string]string) (*gorm.DB, error) {
+	if metadata == nil {
+		return gormDB, nil
+	}
+
+	filter := make(map[string]any, len(*metadata))
+	for key, value := range *metadata {
+		filter[key] = value
+	}
+	if err := validateMetadata(&filter); err != nil {
+		return nil, err
+	}
+
+	for key, value := range *metadata {
+		gormDB = gormDB.Where(datatypes.JSONQuery("metadata").Equals(value, key))
+	}
+
+	return gormDB, nil
+}
+
 func processAssistantsAPIListParams[O ~string](gormDB *gorm.DB, obj Transformer, limit *int, before, after *string, order *O, ensureExists ...db.Storer) (*gorm.DB, int, error) {
 	for _, e := range ensureExists {
 		if err := gormDB.First(e).Error; err != nil {
@@ -1873,6 +2050,20 @@ func processAssistantsAPIListParams[O ~string](gormDB *gorm.DB, obj Transformer,
 		alligator = ">"
 	}
 
+	// Objects created in the same second are ordered by ID, except for messages, which are ordered by their index in the
+	// thread so that they are listed in the order they were created. Messages created before they were indexed all have
+	// an index of 0, so their ties are still broken by ID.
+	tieBreakers := []string{"id"}
+	tieBreaker := func(op string) (string, []any) {
+		return fmt.Sprintf("id %s ?", op), []any{obj.GetID()}
+	}
+	if m, ok := obj.(*db.Message); ok {
+		tieBreakers = []string{"thread_index", "id"}
+		tieBreaker = func(op string) (string, []any) {
+			return fmt.Sprintf("(thread_index %[1]s ? OR (thread_index = ? AND id %[1]s ?))", op), []any{m.ThreadIndex, m.ThreadIndex, m.GetID()}
+		}
+	}
+
 	// TODO(thedadams): what happens if before/after are not valid object IDs?
 	// TODO(thedadams): what happens if before and after are set?
 	// TODO(thedadams): what happens if before/after are in the wrong order?
@@ -1883,7 +2074,8 @@ func processAssistantsAPIListParams[O ~string](gormDB *gorm.DB, obj Transformer,
 			return nil, 0, NewNotFoundError(obj)
 		}
 
-		gormDBInstance = gormDBInstance.Where(fmt.Sprintf("created_at %s ?", alligator), obj.GetCreatedAt()).Or(fmt.Sprintf("created_at %s= ? AND id %[1]s ?", alligator), obj.GetCreatedAt(), obj.GetID())
+		condition, values := tieBreaker(alligator)
+		gormDBInstance = gormDBInstance.Where(fmt.Sprintf("created_at %s ?", alligator), obj.GetCreatedAt()).Or(fmt.Sprintf("created_at %s= ? AND %s", alligator, condition), append([]any{obj.GetCreatedAt()}, values...)...)
 	}
 	if a := z.Dereference(after); a != "" {
 		obj.SetID(a)
@@ -1891,10 +2083,15 @@ func processAssistantsAPIListParams[O ~string](gormDB *gorm.DB, obj Transformer,
 			return nil, 0, NewNotFoundError(obj)
 		}
 
-		gormDBInstance = gormDBInstance.Where(fmt.Sprintf("? %s created_at", alligator), obj.GetCreatedAt()).Or(fmt.Sprintf("? %s= created_at AND ? %[1]s id", alligator), obj.GetCreatedAt(), obj.GetID())
+		// The object is on the left of the comparison here, so the tie-breaker columns must be compared the other way.
+		condition, values := tieBreaker(map[string]string{"<": ">", ">": "<"}[alligator])
+		gormDBInstance = gormDBInstance.Where(fmt.Sprintf("? %s created_at", alligator), obj.GetCreatedAt()).Or(fmt.Sprintf("? %s= created_at AND %s", alligator, condition), append([]any{obj.GetCreatedAt()}, values...)...)
 	}
 
-	gormDBInstance = gormDBInstance.Order("created_at " + ordering).Order("id " + ordering)
+	gormDBInstance = gormDBInstance.Order("created_at " + ordering)
+	for _, column := range tieBreakers {
+		gormDBInstance = gormDBInstance.Order(column + " " + ordering)
+	}
 
 	return gormDBInstance, *limit, nil
 }
@@ -2032,6 +2229,11 @@ func waitForResponse(ctx context.Context, readyIndicator <-chan struct{}, gormDB
 }
 
 func waitForAndWriteResponse(ctx context.Context, readyIndicator <-chan struct{}, w http.ResponseWriter, gormDB *gorm.DB, id string, respObj JobResponder) {
+	waitForAndWriteProjectedResponse(ctx, readyIndicator, w, gormDB, id, respObj, nil)
+}
+
+// waitForAndWriteProjectedResponse is like waitForAndWriteResponse, but the given fields are omitted from the response.
+func waitForAndWriteProjectedResponse(ctx context.Context, readyIndicator <-chan struct{}, w http.ResponseWriter, gormDB *gorm.DB, id string, respObj JobResponder, omit []string) {
 	if err := waitForResponse(ctx, readyIndicator, gormDB, id, respObj); err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		_, _ = w.Write([]byte(NewAPIError(fmt.Sprintf("Failed to get response: %v", err), InternalErrorType).Error()))
@@ -2040,23 +2242,35 @@ func waitForAndWriteResponse(ctx context.Context, readyIndicator <-chan struct{}
 
 	if errStr := respObj.GetErrorString(); errStr != "" {
 		code := respObj.GetStatusCode()
-		errorType := InternalErrorType
-		if code < 500 {
-			errorType = InvalidRequestErrorType
-		}
 		w.WriteHeader(code)
-		_, _ = w.Write([]byte(NewAPIError(errStr, errorType).Error()))
-	} else {
-		writeObjectToResponse(w, respObj.ToPublic())
+		_, _ = w.Write([]byte(NewAPIError(errStr, errorTypeForStatusCode(code)).Error()))
+		return
+	}
+
+	obj, err := omitFields(respObj.ToPublic(), omit)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(NewAPIError("Failed to write object to response.", InternalErrorType).Error()))
+		return
 	}
+
+	writeObjectToResponse(w, obj)
 }
 
 // waitForAndStreamResponse waits for the stream responses to come through and will pass them as SSE to the client.
-func waitForAndStreamResponse[T JobRespondStreamer](ctx context.Context, w http.ResponseWriter, gormDB *gorm.DB, id string, index int) {
+// Keepalive comments are sent while the stream is idle, if configured. Clients ignore comments, so they never appear in
+// the streamed content. If the stream fails, then an error event is sent in place of the done message, so that clients
+// can tell a failed stream from a complete one. The stream is compressed if the config says so.
+func waitForAndStreamResponse[T JobRespondStreamer](ctx context.Context, w http.ResponseWriter, cfg streamConfig, gormDB *gorm.DB, id string, index int) {
 	w.Header().Set("Content-Type", "text/event-stream")
 	w.Header().Set("Cache-Control", "no-cache")
 	w.Header().Set("Connection", "keep-alive")
-	var printDoneEvent bool
+	var (
+		printDoneEvent bool
+		sw             = newStreamWriter(w, cfg)
+	)
+	defer sw.close()
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -2066,17 +2280,17 @@ func waitForAndStreamResponse[T JobRespondStreamer](ctx context.Context, w http.
 
 		respObj := *new(T)
 		if err := gormDB.Model(respObj).Where("request_id = ?", id).Where("response_idx >= ?", index).Order("response_idx asc").First(&respObj).Error; errors.Is(err, gorm.ErrRecordNotFound) {
+			sw.idle()
 			time.Sleep(time.Second)
 			continue
 		} else if err != nil {
 			slog.Error("Failed to get response chunk", "err", err)
-			w.WriteHeader(http.StatusInternalServerError)
-			_, _ = w.Write([]byte(NewAPIError(fmt.Sprintf("Failed streaming responses: %v", err), InternalErrorType).Error()))
-			break
+			sw.writeError(NewAPIError(fmt.Sprintf("Failed streaming responses: %v", err), InternalErrorType))
+			return
 		} else if errStr := respObj.GetErrorString(); errStr != "" {
 			slog.Error("Failed to get response chunk", "err", errStr)
-			_, _ = w.Write([]byte(fmt.Sprintf(`data: %v`, NewAPIError(errStr, InternalErrorType).Error())))
-			break
+			sw.writeError(NewAPIError(errStr, errorTypeForStatusCode(respObj.GetStatusCode())))
+			return
 		}
 
 		index = respObj.GetIndex() + 1
@@ -2088,9 +2302,8 @@ func waitForAndStreamResponse[T JobRespondStreamer](ctx context.Context, w http.
 		body, err := json.Marshal(respObj.ToPublic())
 		if err != nil {
 			slog.Error("Failed to marshal response", "err", err)
-			w.WriteHeader(http.StatusInternalServerError)
-			_, _ = w.Write([]byte(fmt.Sprintf(`data: %v`, NewAPIError(fmt.Sprintf("Failed to process streamed response: %v", err), InternalErrorType).Error())))
-			break
+			sw.writeError(NewAPIError(fmt.Sprintf("Failed to process streamed response: %v", err), InternalErrorType))
+			return
 		}
 
 		event := respObj.GetEvent()
@@ -2100,17 +2313,28 @@ func waitForAndStreamResponse[T JobRespondStreamer](ctx context.Context, w http.
 		}
 
 		d := make([]byte, 0, len(body)+len(event)+9)
-		_, _ = w.Write(append(append(append(append(d, []byte(event)...), []byte("data: ")...), body...), []byte("\n\n")...))
-		if f, ok := w.(http.Flusher); ok {
-			f.Flush()
-		}
+		sw.write(append(append(append(append(d, []byte(event)...), []byte("data: ")...), body...), []byte("\n\n")...))
 	}
 
 	doneMessage := "data: [DONE]\n\n"
 	if printDoneEvent {
 		doneMessage = "event: done\ndata: [DONE]\n\n"
 	}
-	_, _ = w.Write([]byte(doneMessage))
+	sw.write([]byte(doneMessage))
+}
+
+// toolsChanged returns true if the tools are not the same as the existing tools.
+func toolsChanged(existing, tools []openai.AssistantObject_Tools_Item) (bool, error) {
+	existingJSON, err := json.Marshal(existing)
+	if err != nil {
+		return false, err
+	}
+	toolsJSON, err := json.Marshal(tools)
+	if err != nil {
+		return false, err
+	}
+
+	return !bytes.Equal(existingJSON, toolsJSON), nil
 }
 
 // transposeObject will marshal the first object and unmarshal it into the second object.
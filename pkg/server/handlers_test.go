@@ -0,0 +1,860 @@
+package server
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/acorn-io/z"
+	"github.com/gptscript-ai/clicky-chats/pkg/agents"
+	"github.com/gptscript-ai/clicky-chats/pkg/db"
+	"github.com/gptscript-ai/clicky-chats/pkg/generated/openai"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// recordingWriter records everything written to the response.
+type recordingWriter struct {
+	http.ResponseWriter
+	body *bytes.Buffer
+}
+
+func (w *recordingWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *recordingWriter) Flush() {
+	w.ResponseWriter.(http.Flusher).Flush()
+}
+
+// flushRecorder records what is written to the response between each flush.
+type flushRecorder struct {
+	*httptest.ResponseRecorder
+	pending bytes.Buffer
+	flushed []string
+}
+
+func (w *flushRecorder) Write(b []byte) (int, error) {
+	w.pending.Write(b)
+	return w.ResponseRecorder.Write(b)
+}
+
+func (w *flushRecorder) Flush() {
+	w.flushed = append(w.flushed, w.pending.String())
+	w.pending.Reset()
+	w.ResponseRecorder.Flush()
+}
+
+// newTestDB returns a new, migrated database for a test.
+func newTestDB(t *testing.T) *db.DB {
+	t.Helper()
+
+	gdb, err := db.New("sqlite://"+filepath.Join(t.TempDir(), "clicky-chats.db"), true)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	if err = gdb.AutoMigrate(); err != nil {
+		t.Fatalf("failed to migrate database: %v", err)
+	}
+
+	return gdb
+}
+
+func TestStreamFlush(t *testing.T) {
+	gdb := newTestDB(t)
+
+	const id = "chatcmpl-test"
+	tx := gdb.WithContext(context.Background())
+	for i, content := range []string{"Hello", ", ", "world"} {
+		if err := db.Create(tx, &db.ChatCompletionResponseChunk{
+			Choices: []db.ChunkChoice{{
+				Delta: datatypes.NewJSONType(openai.ChatCompletionStreamResponseDelta{Content: z.Pointer(content)}),
+			}},
+			JobResponse: db.JobResponse{RequestID: id},
+			ResponseIdx: i,
+		}); err != nil {
+			t.Fatalf("failed to create chunk: %v", err)
+		}
+	}
+	if err := db.Create(tx, &db.ChatCompletionResponseChunk{
+		JobResponse: db.JobResponse{RequestID: id, Done: true},
+		ResponseIdx: 3,
+	}); err != nil {
+		t.Fatalf("failed to create chunk: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		cfg         streamConfig
+		wantFlushes int
+	}{
+		{
+			name: "flush per chunk",
+			// Each chunk and the done message are flushed separately.
+			wantFlushes: 4,
+		},
+		{
+			name:        "buffered",
+			cfg:         streamConfig{flushInterval: time.Hour, flushSize: 1 << 20},
+			wantFlushes: 1,
+		},
+		{
+			name: "buffered with small size",
+			// The size limit is reached with each chunk.
+			cfg:         streamConfig{flushInterval: time.Hour, flushSize: 1},
+			wantFlushes: 4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &flushRecorder{ResponseRecorder: httptest.NewRecorder()}
+			waitForAndStreamResponse[*db.ChatCompletionResponseChunk](context.Background(), w, tt.cfg, tx, id, 0)
+
+			if len(w.flushed) != tt.wantFlushes {
+				t.Errorf("got %d flushes, want %d: %q", len(w.flushed), tt.wantFlushes, w.flushed)
+			}
+			if w.pending.Len() != 0 {
+				t.Errorf("unflushed content at the end of the stream: %q", w.pending.String())
+			}
+			if got := strings.Join(w.flushed, ""); got != w.Body.String() {
+				t.Errorf("flushed content = %q, want %q", got, w.Body.String())
+			}
+			for _, content := range []string{"Hello", "world", "[DONE]"} {
+				if !strings.Contains(w.Body.String(), content) {
+					t.Errorf("stream is missing %q: %q", content, w.Body.String())
+				}
+			}
+		})
+	}
+}
+
+func TestStreamGzip(t *testing.T) {
+	gdb := newTestDB(t)
+
+	const id = "chatcmpl-test"
+	tx := gdb.WithContext(context.Background())
+	for i, content := range []string{"Hello", "world"} {
+		if err := db.Create(tx, &db.ChatCompletionResponseChunk{
+			Choices: []db.ChunkChoice{{
+				Delta: datatypes.NewJSONType(openai.ChatCompletionStreamResponseDelta{Content: z.Pointer(content)}),
+			}},
+			JobResponse: db.JobResponse{RequestID: id},
+			ResponseIdx: i,
+		}); err != nil {
+			t.Fatalf("failed to create chunk: %v", err)
+		}
+	}
+	if err := db.Create(tx, &db.ChatCompletionResponseChunk{
+		JobResponse: db.JobResponse{RequestID: id, Done: true},
+		ResponseIdx: 2,
+	}); err != nil {
+		t.Fatalf("failed to create chunk: %v", err)
+	}
+
+	for _, tt := range []struct {
+		acceptEncoding string
+		wantGzip       bool
+	}{
+		{acceptEncoding: "", wantGzip: false},
+		{acceptEncoding: "gzip;q=0, br", wantGzip: false},
+		{acceptEncoding: "deflate, gzip;q=0.5", wantGzip: true},
+		{acceptEncoding: "*", wantGzip: true},
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Accept-Encoding", tt.acceptEncoding)
+		if got := (streamConfig{}).negotiate(req).gzip; got != tt.wantGzip {
+			t.Errorf("gzip for Accept-Encoding %q = %t, want %t", tt.acceptEncoding, got, tt.wantGzip)
+		}
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	w := &flushRecorder{ResponseRecorder: httptest.NewRecorder()}
+	waitForAndStreamResponse[*db.ChatCompletionResponseChunk](context.Background(), w, streamConfig{}.negotiate(req), tx, id, 0)
+
+	if encoding := w.Header().Get("Content-Encoding"); encoding != "gzip" {
+		t.Fatalf("Content-Encoding = %q, want gzip", encoding)
+	}
+
+	// Every flush can be decompressed along with the flushes before it, so the client gets each event as soon as it is
+	// flushed, with the event-stream framing intact.
+	var compressed []byte
+	for i, wantEvent := range []string{"Hello", "world", "data: [DONE]\n\n"} {
+		if i >= len(w.flushed) {
+			t.Fatalf("got %d flushes, want at least %d", len(w.flushed), i+1)
+		}
+		compressed = append(compressed, w.flushed[i]...)
+
+		gz, err := gzip.NewReader(bytes.NewReader(compressed))
+		if err != nil {
+			t.Fatalf("failed to read gzip header: %v", err)
+		}
+		events, _ := io.ReadAll(gz)
+		if !strings.HasSuffix(string(events), "\n\n") || !strings.Contains(string(events), wantEvent) {
+			t.Errorf("events after flush %d = %q, want them to end with an event containing %q", i+1, events, wantEvent)
+		}
+	}
+
+	gz, err := gzip.NewReader(bytes.NewReader(w.Body.Bytes()))
+	if err != nil {
+		t.Fatalf("failed to read gzip header: %v", err)
+	}
+	events, err := io.ReadAll(gz)
+	if err != nil {
+		t.Fatalf("failed to decompress stream: %v", err)
+	}
+	if got := strings.Count(string(events), "data: "); got != 3 {
+		t.Errorf("got %d events, want 3: %q", got, events)
+	}
+}
+
+func TestStreamKeepAlive(t *testing.T) {
+	gdb := newTestDB(t)
+
+	const id = "chatcmpl-test"
+	body := new(bytes.Buffer)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		waitForAndStreamResponse[*db.ChatCompletionResponseChunk](r.Context(), &recordingWriter{ResponseWriter: w, body: body}, streamConfig{keepAlive: 100 * time.Millisecond}, gdb.WithContext(r.Context()), id, 0)
+	}))
+	defer srv.Close()
+
+	// The stream stalls before the first chunk is produced.
+	go func() {
+		time.Sleep(1500 * time.Millisecond)
+		tx := gdb.WithContext(context.Background())
+		for _, chunk := range []*db.ChatCompletionResponseChunk{
+			{
+				Choices: []db.ChunkChoice{{
+					Delta: datatypes.NewJSONType(openai.ChatCompletionStreamResponseDelta{Content: z.Pointer("Hello")}),
+				}},
+				JobResponse: db.JobResponse{RequestID: id},
+				ResponseIdx: 0,
+			},
+			{
+				JobResponse: db.JobResponse{RequestID: id, Done: true},
+				ResponseIdx: 1,
+			},
+		} {
+			if err := db.Create(tx, chunk); err != nil {
+				t.Errorf("failed to create chunk: %v", err)
+			}
+		}
+	}()
+
+	stream, err := agents.StreamChatCompletionRequest(context.Background(), slog.Default(), http.DefaultClient, srv.URL, "", new(db.CreateChatCompletionRequest))
+	if err != nil {
+		t.Fatalf("StreamChatCompletionRequest() error = %v", err)
+	}
+
+	var content string
+	for chunk := range stream {
+		if chunk.Error != nil {
+			t.Fatalf("unexpected stream error: %s", *chunk.Error)
+		}
+		for _, choice := range chunk.Choices {
+			content += z.Dereference(choice.Delta.Data().Content)
+		}
+	}
+
+	if !strings.Contains(body.String(), ": keepalive\n\n") {
+		t.Errorf("expected a keepalive comment during the stall, got %q", body.String())
+	}
+	if content != "Hello" {
+		t.Errorf("content = %q, want %q", content, "Hello")
+	}
+}
+
+func TestStreamErrorFrame(t *testing.T) {
+	gdb := newTestDB(t)
+
+	tests := []struct {
+		name       string
+		statusCode int
+		wantType   string
+	}{
+		{
+			name:       "provider failure",
+			statusCode: http.StatusBadGateway,
+			wantType:   InternalErrorType,
+		},
+		{
+			name:       "invalid request",
+			statusCode: http.StatusBadRequest,
+			wantType:   InvalidRequestErrorType,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The provider fails after the first chunk has been streamed.
+			id := "chatcmpl-" + strings.ReplaceAll(tt.name, " ", "-")
+			tx := gdb.WithContext(context.Background())
+			for _, chunk := range []*db.ChatCompletionResponseChunk{
+				{
+					Choices: []db.ChunkChoice{{
+						Delta: datatypes.NewJSONType(openai.ChatCompletionStreamResponseDelta{Content: z.Pointer("Hello")}),
+					}},
+					JobResponse: db.JobResponse{RequestID: id},
+					ResponseIdx: 0,
+				},
+				{
+					JobResponse: db.JobResponse{RequestID: id, Error: z.Pointer("stream interrupted"), StatusCode: tt.statusCode, Done: true},
+					ResponseIdx: 1,
+				},
+			} {
+				if err := db.Create(tx, chunk); err != nil {
+					t.Fatalf("failed to create chunk: %v", err)
+				}
+			}
+
+			w := &flushRecorder{ResponseRecorder: httptest.NewRecorder()}
+			waitForAndStreamResponse[*db.ChatCompletionResponseChunk](context.Background(), w, streamConfig{flushInterval: time.Hour, flushSize: 1 << 20}, tx, id, 0)
+
+			if w.pending.Len() != 0 {
+				t.Errorf("unflushed content at the end of the stream: %q", w.pending.String())
+			}
+
+			events := strings.Split(strings.TrimSuffix(w.Body.String(), "\n\n"), "\n\n")
+			if len(events) != 2 || !strings.Contains(events[0], "Hello") {
+				t.Fatalf("got events %q, want the chunk followed by an error event", events)
+			}
+
+			var frame struct {
+				Error APIError `json:"error"`
+			}
+			if err := json.Unmarshal([]byte(strings.TrimPrefix(events[1], "data: ")), &frame); err != nil {
+				t.Fatalf("failed to unmarshal error event %q: %v", events[1], err)
+			}
+			if frame.Error.Message != "stream interrupted" || frame.Error.Type != tt.wantType {
+				t.Errorf("error = %+v, want message %q and type %q", frame.Error, "stream interrupted", tt.wantType)
+			}
+		})
+	}
+}
+
+func TestOmitChatCompletionFields(t *testing.T) {
+	gdb := newTestDB(t)
+
+	const id = "chatcmpl-test"
+	tx := gdb.WithContext(context.Background())
+	if err := db.Create(tx, &db.CreateChatCompletionResponse{
+		JobResponse: db.JobResponse{RequestID: id, Done: true},
+		Choices: []db.Choice{{
+			FinishReason: "stop",
+			Logprobs:     datatypes.NewJSONType(db.Lobprob{Content: []openai.ChatCompletionTokenLogprob{{Token: "Hello", Logprob: -0.5}}}),
+			Message:      datatypes.NewJSONType(openai.ChatCompletionResponseMessage{Content: z.Pointer("Hello")}),
+		}},
+		Model:             "gpt-4",
+		SystemFingerprint: z.Pointer("fp_test"),
+	}); err != nil {
+		t.Fatalf("failed to create chat completion response: %v", err)
+	}
+
+	ready := make(chan struct{})
+	close(ready)
+
+	w := httptest.NewRecorder()
+	waitForAndWriteProjectedResponse(context.Background(), ready, w, tx, id, new(db.CreateChatCompletionResponse), []string{"choices.logprobs", "system_fingerprint"})
+
+	var body map[string]any
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("failed to unmarshal response %q: %v", w.Body.String(), err)
+	}
+	if _, ok := body["system_fingerprint"]; ok {
+		t.Errorf("response has system_fingerprint: %s", w.Body.String())
+	}
+	choices, _ := body["choices"].([]any)
+	if len(choices) != 1 {
+		t.Fatalf("response has %d choices, want 1: %s", len(choices), w.Body.String())
+	}
+	choice, _ := choices[0].(map[string]any)
+	if _, ok := choice["logprobs"]; ok {
+		t.Errorf("response has logprobs: %s", w.Body.String())
+	}
+	if choice["finish_reason"] != "stop" {
+		t.Errorf("finish_reason = %v, want stop", choice["finish_reason"])
+	}
+
+	stored := new(db.CreateChatCompletionResponse)
+	if err := tx.Where("request_id = ?", id).First(stored).Error; err != nil {
+		t.Fatalf("failed to get chat completion response: %v", err)
+	}
+	if len(stored.Choices) != 1 || len(stored.Choices[0].Logprobs.Data().Content) != 1 {
+		t.Errorf("stored response lost its logprobs: %+v", stored.Choices)
+	}
+	if z.Dereference(stored.SystemFingerprint) != "fp_test" {
+		t.Errorf("stored system_fingerprint = %q, want %q", z.Dereference(stored.SystemFingerprint), "fp_test")
+	}
+}
+
+func TestRunKeepsAssistantTools(t *testing.T) {
+	gdb := newTestDB(t)
+
+	s := NewServer(gdb, nil)
+	s.triggers = new(Triggers)
+	s.triggers.Complete()
+
+	call := func(handler func(http.ResponseWriter, *http.Request), body string, obj any) {
+		t.Helper()
+		w := httptest.NewRecorder()
+		handler(w, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+		if w.Code != http.StatusOK {
+			t.Fatalf("unexpected status code %d: %s", w.Code, w.Body.String())
+		}
+		if err := json.Unmarshal(w.Body.Bytes(), obj); err != nil {
+			t.Fatalf("failed to unmarshal response %q: %v", w.Body.String(), err)
+		}
+	}
+
+	assistant := new(openai.AssistantObject)
+	call(s.CreateAssistant, `{"model": "gpt-4", "tools": [{"type": "function", "function": {"name": "original"}}]}`, assistant)
+
+	thread := new(openai.ThreadObject)
+	call(s.CreateThread, `{}`, thread)
+
+	run := new(openai.RunObject)
+	call(func(w http.ResponseWriter, r *http.Request) { s.CreateRun(w, r, thread.Id) }, `{"assistant_id": "`+assistant.Id+`"}`, run)
+
+	call(func(w http.ResponseWriter, r *http.Request) { s.ModifyAssistant(w, r, assistant.Id) }, `{"tools": [{"type": "function", "function": {"name": "changed"}}]}`, assistant)
+
+	tx := gdb.WithContext(context.Background())
+	dbAssistant := new(db.Assistant)
+	if err := db.Get(tx, dbAssistant, assistant.Id); err != nil {
+		t.Fatalf("failed to get assistant: %v", err)
+	}
+	dbRun := new(db.Run)
+	if err := db.Get(tx, dbRun, run.Id); err != nil {
+		t.Fatalf("failed to get run: %v", err)
+	}
+
+	if dbAssistant.ToolsVersion != 1 {
+		t.Errorf("assistant tools version = %d, want 1", dbAssistant.ToolsVersion)
+	}
+	if dbRun.AssistantToolsVersion == nil || *dbRun.AssistantToolsVersion != 0 {
+		t.Errorf("run assistant tools version = %v, want 0", dbRun.AssistantToolsVersion)
+	}
+
+	runTools, err := dbRun.AssistantTools()
+	if err != nil {
+		t.Fatalf("AssistantTools() error = %v", err)
+	}
+	for _, tt := range []struct {
+		name  string
+		tools []openai.AssistantObject_Tools_Item
+		want  string
+	}{
+		{name: "assistant", tools: dbAssistant.Tools, want: "changed"},
+		{name: "run", tools: runTools, want: "original"},
+	} {
+		tools, err := (&db.Assistant{Tools: tt.tools}).ToolsToChatCompletionTools(nil, nil)
+		if err != nil {
+			t.Fatalf("ToolsToChatCompletionTools() error = %v", err)
+		}
+		if len(tools) != 1 || tools[0].Function.Name != tt.want {
+			t.Errorf("%s tools = %+v, want the %s function", tt.name, tools, tt.want)
+		}
+	}
+}
+
+func TestListRunsByMetadata(t *testing.T) {
+	gdb := newTestDB(t)
+
+	s := NewServer(gdb, nil)
+	s.triggers = new(Triggers)
+	s.triggers.Complete()
+	h := openai.Handler(s)
+
+	call := func(method, path, body string, obj any) int {
+		t.Helper()
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(method, path, strings.NewReader(body)))
+		if w.Code == http.StatusOK {
+			if err := json.Unmarshal(w.Body.Bytes(), obj); err != nil {
+				t.Fatalf("failed to unmarshal response %q: %v", w.Body.String(), err)
+			}
+		}
+		return w.Code
+	}
+
+	assistant := new(openai.AssistantObject)
+	call(http.MethodPost, "/assistants", `{"model": "gpt-4"}`, assistant)
+	thread := new(openai.ThreadObject)
+	call(http.MethodPost, "/threads", `{}`, thread)
+
+	runs := make(map[string]string)
+	for _, metadata := range []string{
+		`{"project": "apollo", "team": "search"}`,
+		`{"project": "apollo", "team": "billing"}`,
+		`{"project": "gemini"}`,
+	} {
+		run := new(openai.RunObject)
+		if code := call(http.MethodPost, "/threads/"+thread.Id+"/runs", `{"assistant_id": "`+assistant.Id+`", "metadata": `+metadata+`}`, run); code != http.StatusOK {
+			t.Fatalf("failed to create run: status code %d", code)
+		}
+		runs[run.Id] = metadata
+
+		// The thread is unlocked so that another run can be created on it.
+		if err := gdb.WithContext(context.Background()).Model(new(db.Thread)).Where("id = ?", thread.Id).Update("locked_by_run_id", nil).Error; err != nil {
+			t.Fatalf("failed to unlock thread: %v", err)
+		}
+	}
+
+	for _, tt := range []struct {
+		name     string
+		query    string
+		wantCode int
+		want     []string
+	}{
+		{name: "No filter", wantCode: http.StatusOK, want: []string{"apollo", "apollo", "gemini"}},
+		{name: "One key", query: "?metadata[project]=apollo", wantCode: http.StatusOK, want: []string{"apollo", "apollo"}},
+		{name: "All keys must match", query: "?metadata[project]=apollo&metadata[team]=billing", wantCode: http.StatusOK, want: []string{"apollo"}},
+		{name: "No match", query: "?metadata[project]=mercury", wantCode: http.StatusOK},
+		{name: "Key too long", query: "?metadata[" + strings.Repeat("k", 65) + "]=apollo", wantCode: http.StatusBadRequest},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			list := new(openai.ListRunsResponse)
+			if code := call(http.MethodGet, "/threads/"+thread.Id+"/runs"+tt.query, "", list); code != tt.wantCode {
+				t.Fatalf("status code = %d, want %d", code, tt.wantCode)
+			}
+
+			projects := make([]string, 0, len(list.Data))
+			for _, run := range list.Data {
+				if _, ok := runs[run.Id]; !ok {
+					t.Errorf("listed unknown run %s", run.Id)
+				}
+				projects = append(projects, z.Dereference(run.Metadata)["project"].(string))
+			}
+			slices.Sort(projects)
+			if !slices.Equal(projects, tt.want) {
+				t.Errorf("listed runs for projects %v, want %v", projects, tt.want)
+			}
+		})
+	}
+}
+
+func TestListUnindexedMessages(t *testing.T) {
+	gdb := newTestDB(t)
+
+	s := NewServer(gdb, nil)
+	s.triggers = new(Triggers)
+	s.triggers.Complete()
+	h := openai.Handler(s)
+
+	call := func(method, path, body string, obj any) int {
+		t.Helper()
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(method, path, strings.NewReader(body)))
+		if w.Code == http.StatusOK {
+			if err := json.Unmarshal(w.Body.Bytes(), obj); err != nil {
+				t.Fatalf("failed to unmarshal response %q: %v", w.Body.String(), err)
+			}
+		}
+		return w.Code
+	}
+
+	thread := new(openai.ThreadObject)
+	call(http.MethodPost, "/threads", `{}`, thread)
+
+	for range 3 {
+		if code := call(http.MethodPost, "/threads/"+thread.Id+"/messages", `{"role": "user", "content": "hello"}`, new(openai.MessageObject)); code != http.StatusOK {
+			t.Fatalf("failed to create message: status code %d", code)
+		}
+	}
+
+	// Messages created before messages were indexed all have an index of 0 and may have been created in the same second.
+	if err := gdb.WithContext(context.Background()).Model(new(db.Message)).Where("thread_id = ?", thread.Id).Updates(map[string]any{"thread_index": 0, "created_at": 1}).Error; err != nil {
+		t.Fatalf("failed to reset messages: %v", err)
+	}
+
+	all := new(openai.ListMessagesResponse)
+	if code := call(http.MethodGet, "/threads/"+thread.Id+"/messages", "", all); code != http.StatusOK {
+		t.Fatalf("failed to list messages: status code %d", code)
+	}
+	if len(all.Data) != 3 {
+		t.Fatalf("listed %d messages, want 3", len(all.Data))
+	}
+
+	ids := make([]string, 0, len(all.Data))
+	for _, m := range all.Data {
+		ids = append(ids, m.Id)
+	}
+
+	for i, id := range ids {
+		for cursor, want := range map[string][]string{"before": ids[:i], "after": ids[i+1:]} {
+			page := new(openai.ListMessagesResponse)
+			if code := call(http.MethodGet, "/threads/"+thread.Id+"/messages?"+cursor+"="+id, "", page); code != http.StatusOK {
+				t.Fatalf("failed to list messages: status code %d", code)
+			}
+
+			got := make([]string, 0, len(page.Data))
+			for _, m := range page.Data {
+				got = append(got, m.Id)
+			}
+			if !slices.Equal(got, want) {
+				t.Errorf("listed messages %s %s = %v, want %v", cursor, id, got, want)
+			}
+		}
+	}
+}
+
+func TestAsyncChatCompletion(t *testing.T) {
+	gdb := newTestDB(t)
+
+	s := NewServer(gdb, nil)
+	s.triggers = new(Triggers)
+	s.triggers.Complete()
+
+	w := httptest.NewRecorder()
+	s.XCreateAsyncChatCompletion(w, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"model": "gpt-4", "messages": [{"role": "user", "content": "Hello"}]}`)))
+	if w.Code != http.StatusOK {
+		t.Fatalf("unexpected status code %d: %s", w.Code, w.Body.String())
+	}
+
+	completion := new(openai.XAsyncChatCompletionObject)
+	if err := json.Unmarshal(w.Body.Bytes(), completion); err != nil {
+		t.Fatalf("failed to unmarshal response %q: %v", w.Body.String(), err)
+	}
+	if completion.Status != openai.Queued {
+		t.Fatalf("status = %q, want %q", completion.Status, openai.Queued)
+	}
+
+	poll := func() *openai.XAsyncChatCompletionObject {
+		t.Helper()
+		w := httptest.NewRecorder()
+		s.XGetAsyncChatCompletion(w, httptest.NewRequest(http.MethodGet, "/", nil), completion.Id)
+		if w.Code != http.StatusOK {
+			t.Fatalf("unexpected status code %d: %s", w.Code, w.Body.String())
+		}
+
+		polled := new(openai.XAsyncChatCompletionObject)
+		if err := json.Unmarshal(w.Body.Bytes(), polled); err != nil {
+			t.Fatalf("failed to unmarshal response %q: %v", w.Body.String(), err)
+		}
+		return polled
+	}
+
+	// Process the request the way an agent would, while the client polls for the result.
+	agentErr := make(chan error, 1)
+	go func() {
+		tx := gdb.WithContext(context.Background())
+		cc := new(db.CreateChatCompletionRequest)
+		if err := db.NewQueue("test-agent", 0).Claim(tx, cc); err != nil {
+			agentErr <- err
+			return
+		}
+		if err := tx.Model(cc).Where("id = ?", cc.ID).Update("status", string(openai.InProgress)).Error; err != nil {
+			agentErr <- err
+			return
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		agentErr <- tx.Transaction(func(tx *gorm.DB) error {
+			if err := db.Create(tx, &db.CreateChatCompletionResponse{
+				JobResponse: db.JobResponse{RequestID: cc.ID, Done: true},
+				Choices: []db.Choice{{
+					FinishReason: "stop",
+					Message:      datatypes.NewJSONType(openai.ChatCompletionResponseMessage{Content: z.Pointer("Hi")}),
+				}},
+				Model: "gpt-4",
+			}); err != nil {
+				return err
+			}
+			return tx.Model(cc).Where("id = ?", cc.ID).Updates(map[string]any{"done": true, "status": db.ChatCompletionDoneStatus(false)}).Error
+		})
+	}()
+
+	statuses := []openai.XAsyncChatCompletionObjectStatus{completion.Status}
+	deadline := time.Now().Add(5 * time.Second)
+	for completion.Status != openai.Completed && completion.Status != openai.Failed {
+		if time.Now().After(deadline) {
+			t.Fatalf("chat completion did not complete, statuses = %v", statuses)
+		}
+
+		time.Sleep(10 * time.Millisecond)
+		completion = poll()
+		if completion.Status != statuses[len(statuses)-1] {
+			statuses = append(statuses, completion.Status)
+		}
+	}
+
+	if err := <-agentErr; err != nil {
+		t.Fatalf("failed to process chat completion: %v", err)
+	}
+
+	want := []openai.XAsyncChatCompletionObjectStatus{openai.Queued, openai.InProgress, openai.Completed}
+	if !slices.Equal(statuses, want) {
+		t.Errorf("statuses = %v, want %v", statuses, want)
+	}
+	if completion.Completion == nil || len(completion.Completion.Choices) != 1 || z.Dereference(completion.Completion.Choices[0].Message.Content) != "Hi" {
+		t.Errorf("completion = %+v, want the response from the agent", completion.Completion)
+	}
+}
+
+func TestCancelAsyncChatCompletion(t *testing.T) {
+	gdb := newTestDB(t)
+
+	s := NewServer(gdb, nil)
+	s.triggers = new(Triggers)
+	s.triggers.Complete()
+
+	call := func(handler func(http.ResponseWriter, *http.Request, string), id string) *openai.XAsyncChatCompletionObject {
+		t.Helper()
+		w := httptest.NewRecorder()
+		if handler == nil {
+			s.XCreateAsyncChatCompletion(w, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"model": "gpt-4", "messages": [{"role": "user", "content": "Hello"}]}`)))
+		} else {
+			handler(w, httptest.NewRequest(http.MethodPost, "/", nil), id)
+		}
+		if w.Code != http.StatusOK {
+			t.Fatalf("unexpected status code %d: %s", w.Code, w.Body.String())
+		}
+
+		completion := new(openai.XAsyncChatCompletionObject)
+		if err := json.Unmarshal(w.Body.Bytes(), completion); err != nil {
+			t.Fatalf("failed to unmarshal response %q: %v", w.Body.String(), err)
+		}
+		return completion
+	}
+
+	// A queued chat completion is cancelled.
+	queued := call(nil, "")
+	if cancelled := call(s.XCancelAsyncChatCompletion, queued.Id); cancelled.Status != openai.Cancelled {
+		t.Errorf("status after cancelling a queued chat completion = %q, want %q", cancelled.Status, openai.Cancelled)
+	}
+	if polled := call(s.XGetAsyncChatCompletion, queued.Id); polled.Status != openai.Cancelled {
+		t.Errorf("status of the cancelled chat completion = %q, want %q", polled.Status, openai.Cancelled)
+	}
+
+	// A chat completion that has already completed is not changed.
+	completed := call(nil, "")
+	tx := gdb.WithContext(context.Background())
+	cc := new(db.CreateChatCompletionRequest)
+	// The cancelled chat completion is excluded so that the one that completes is claimed.
+	if err := db.NewQueue("test-agent", 0).Claim(tx, cc, queued.Id); err != nil {
+		t.Fatalf("failed to claim chat completion: %v", err)
+	}
+	if err := tx.Transaction(func(tx *gorm.DB) error {
+		if err := db.Create(tx, &db.CreateChatCompletionResponse{
+			JobResponse: db.JobResponse{RequestID: cc.ID, Done: true},
+			Model:       "gpt-4",
+		}); err != nil {
+			return err
+		}
+		return tx.Model(cc).Where("id = ?", cc.ID).Updates(map[string]any{"done": true, "status": db.ChatCompletionDoneStatus(false)}).Error
+	}); err != nil {
+		t.Fatalf("failed to complete chat completion: %v", err)
+	}
+
+	if cancelled := call(s.XCancelAsyncChatCompletion, completed.Id); cancelled.Status != openai.Completed {
+		t.Errorf("status after cancelling a completed chat completion = %q, want %q", cancelled.Status, openai.Completed)
+	}
+}
+
+func TestChatCompletionInclude(t *testing.T) {
+	gdb := newTestDB(t)
+
+	s := NewServer(gdb, nil)
+	s.triggers = new(Triggers)
+	s.triggers.Complete()
+	s.chatCompletionOmitFields = []string{"choices.logprobs", "system_fingerprint"}
+
+	create := func(include string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		s.XCreateAsyncChatCompletion(w, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"model": "gpt-4", "messages": [{"role": "user", "content": "Hello"}], "include": `+include+`}`)))
+		return w
+	}
+
+	if w := create(`["choices.message"]`); w.Code != http.StatusBadRequest {
+		t.Errorf("unknown include status code = %d, want %d: %s", w.Code, http.StatusBadRequest, w.Body.String())
+	}
+
+	w := create(`["choices.logprobs"]`)
+	if w.Code != http.StatusOK {
+		t.Fatalf("unexpected status code %d: %s", w.Code, w.Body.String())
+	}
+	completion := new(openai.XAsyncChatCompletionObject)
+	if err := json.Unmarshal(w.Body.Bytes(), completion); err != nil {
+		t.Fatalf("failed to unmarshal response %q: %v", w.Body.String(), err)
+	}
+
+	ccr := new(db.CreateChatCompletionRequest)
+	if err := db.Get(gdb.WithContext(context.Background()), ccr, completion.Id); err != nil {
+		t.Fatalf("failed to get chat completion request: %v", err)
+	}
+	if !z.Dereference(ccr.Logprobs) {
+		t.Errorf("logprobs = %v, want them to be requested when they are included", ccr.Logprobs)
+	}
+	if body, err := json.Marshal(ccr.ToPublic()); err != nil || strings.Contains(string(body), `"include"`) {
+		t.Errorf("request sent to the model provider = %s, want it to not have the include parameter", body)
+	}
+
+	for _, tt := range []struct {
+		name    string
+		include []openai.CreateChatCompletionRequestInclude
+		want    []string
+	}{
+		{name: "Nothing included", want: []string{"choices.logprobs", "system_fingerprint"}},
+		{name: "Omitted section included", include: []openai.CreateChatCompletionRequestInclude{openai.ChoicesLogprobs}, want: []string{"system_fingerprint"}},
+		{name: "Section that isn't omitted included", include: []openai.CreateChatCompletionRequestInclude{openai.Usage}, want: []string{"choices.logprobs", "system_fingerprint"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.chatCompletionOmittedFields(tt.include); !slices.Equal(got, tt.want) {
+				t.Errorf("chatCompletionOmittedFields() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChatCompletionIncludeWithDefaultConfig(t *testing.T) {
+	gdb := newTestDB(t)
+
+	s := NewServer(gdb, nil)
+	s.triggers = new(Triggers)
+	s.triggers.Complete()
+
+	for _, tt := range []struct {
+		name         string
+		include      string
+		wantLogprobs bool
+	}{
+		{name: "Nothing included", include: `[]`},
+		// The log probabilities are only in the response if they are requested from the model.
+		{name: "Logprobs included", include: `["choices.logprobs"]`, wantLogprobs: true},
+		{name: "Usage and system fingerprint included", include: `["usage", "system_fingerprint"]`},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			s.XCreateAsyncChatCompletion(w, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"model": "gpt-4", "messages": [{"role": "user", "content": "Hello"}], "include": `+tt.include+`}`)))
+			if w.Code != http.StatusOK {
+				t.Fatalf("unexpected status code %d: %s", w.Code, w.Body.String())
+			}
+			completion := new(openai.XAsyncChatCompletionObject)
+			if err := json.Unmarshal(w.Body.Bytes(), completion); err != nil {
+				t.Fatalf("failed to unmarshal response %q: %v", w.Body.String(), err)
+			}
+
+			ccr := new(db.CreateChatCompletionRequest)
+			if err := db.Get(gdb.WithContext(context.Background()), ccr, completion.Id); err != nil {
+				t.Fatalf("failed to get chat completion request: %v", err)
+			}
+			if z.Dereference(ccr.Logprobs) != tt.wantLogprobs {
+				t.Errorf("logprobs = %v, want %v", z.Dereference(ccr.Logprobs), tt.wantLogprobs)
+			}
+
+			// Nothing is omitted by default, so the usage and system fingerprint are always returned.
+			if omitted := s.chatCompletionOmittedFields(ccr.Include); len(omitted) != 0 {
+				t.Errorf("omitted fields = %v, want none", omitted)
+			}
+		})
+	}
+}
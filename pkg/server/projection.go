@@ -0,0 +1,46 @@
+package server
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// omitFields returns the JSON representation of obj without the given fields. Nested fields are separated by dots, and
+// the fields of the objects in an array are omitted from each object, so "choices.logprobs" omits the logprobs of every
+// choice. Fields that don't exist are ignored.
+func omitFields(obj any, fields []string) (any, error) {
+	if len(fields) == 0 {
+		return obj, nil
+	}
+
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var projected any
+	if err = json.Unmarshal(b, &projected); err != nil {
+		return nil, err
+	}
+
+	for _, field := range fields {
+		omitField(projected, strings.Split(field, "."))
+	}
+
+	return projected, nil
+}
+
+func omitField(obj any, path []string) {
+	switch o := obj.(type) {
+	case map[string]any:
+		if len(path) == 1 {
+			delete(o, path[0])
+		} else if v, ok := o[path[0]]; ok {
+			omitField(v, path[1:])
+		}
+	case []any:
+		for _, v := range o {
+			omitField(v, path)
+		}
+	}
+}
@@ -191,7 +191,7 @@ func (s *Server) XStreamRun(w http.ResponseWriter, r *http.Request, threadID str
 		return
 	}
 
-	waitForAndStreamResponse[*db.RunEvent](r.Context(), w, gormDB, runID, z.Dereference(params.Index))
+	waitForAndStreamResponse[*db.RunEvent](r.Context(), w, s.streamConfig.negotiate(r), gormDB, runID, z.Dereference(params.Index))
 }
 
 func (s *Server) XListRunStepEvents(w http.ResponseWriter, r *http.Request, threadID string, runID string, stepID string, params openai.XListRunStepEventsParams) {
@@ -231,7 +231,7 @@ func (s *Server) XListRunStepEvents(w http.ResponseWriter, r *http.Request, thre
 			return
 		}
 
-		waitForAndStreamResponse[*db.RunStepEvent](r.Context(), w, s.db.WithContext(r.Context()), stepID, z.Dereference(params.Index))
+		waitForAndStreamResponse[*db.RunStepEvent](r.Context(), w, s.streamConfig.negotiate(r), s.db.WithContext(r.Context()), stepID, z.Dereference(params.Index))
 		return
 	}
 
@@ -284,7 +284,7 @@ func (s *Server) XRunTool(w http.ResponseWriter, r *http.Request) {
 
 	s.triggers.RunTool.Kick(runTool.ID)
 
-	waitForAndStreamResponse[*db.RunStepEvent](r.Context(), w, s.db.WithContext(r.Context()), runTool.ID, 0)
+	waitForAndStreamResponse[*db.RunStepEvent](r.Context(), w, s.streamConfig.negotiate(r), s.db.WithContext(r.Context()), runTool.ID, 0)
 }
 
 func (s *Server) XConfirmToolRun(w http.ResponseWriter, r *http.Request, toolID string) {
@@ -345,7 +345,7 @@ func (s *Server) XConfirmToolRun(w http.ResponseWriter, r *http.Request, toolID
 		return
 	}
 
-	waitForAndStreamResponse[*db.RunStepEvent](r.Context(), w, s.db.WithContext(r.Context()), tool.ID, startingIndex)
+	waitForAndStreamResponse[*db.RunStepEvent](r.Context(), w, s.streamConfig.negotiate(r), s.db.WithContext(r.Context()), tool.ID, startingIndex)
 }
 
 func (s *Server) XInspectTool(w http.ResponseWriter, r *http.Request) {
@@ -466,8 +466,94 @@ func (s *Server) XConfirmRun(w http.ResponseWriter, r *http.Request, threadID st
 
 	if z.Dereference(confirmRunRequest.Stream) {
 		// Start streaming at the latest run event.
-		waitForAndStreamResponse[*db.RunEvent](r.Context(), w, gormDB, run.ID, run.EventIndex)
+		waitForAndStreamResponse[*db.RunEvent](r.Context(), w, s.streamConfig.negotiate(r), gormDB, run.ID, run.EventIndex)
 	}
 
 	writeObjectToResponse(w, run)
 }
+
+func (s *Server) XCreateAsyncChatCompletion(w http.ResponseWriter, r *http.Request) {
+	ccr, ok := s.createChatCompletionRequest(w, r, true)
+	if !ok {
+		return
+	}
+
+	// Kick the chat completion runner to check for new requests. The client polls for the result, so the ready signal
+	// isn't needed.
+	s.triggers.ChatCompletion.Kick(ccr.ID)
+
+	writeObjectToResponse(w, asyncChatCompletion(ccr, nil))
+}
+
+func (s *Server) XGetAsyncChatCompletion(w http.ResponseWriter, r *http.Request, completionID string) {
+	s.writeAsyncChatCompletion(w, r, completionID)
+}
+
+func (s *Server) XCancelAsyncChatCompletion(w http.ResponseWriter, r *http.Request, completionID string) {
+	cancelled, err := db.CancelQueuedChatCompletion(s.db.WithContext(r.Context()), completionID)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(NewAPIError(fmt.Sprintf("Failed to cancel chat completion: %v", err), InternalErrorType).Error()))
+		return
+	}
+	if cancelled {
+		// Kick the chat completion runner so that the cancelled request is claimed and skipped.
+		s.triggers.ChatCompletion.Kick(completionID)
+	}
+
+	// A chat completion that is no longer queued is returned with its current status.
+	s.writeAsyncChatCompletion(w, r, completionID)
+}
+
+// writeAsyncChatCompletion writes the chat completion that was created without waiting for it to complete to the
+// response.
+func (s *Server) writeAsyncChatCompletion(w http.ResponseWriter, r *http.Request, completionID string) {
+	gormDB := s.db.WithContext(r.Context())
+	ccr := new(db.CreateChatCompletionRequest)
+	if err := get(gormDB, ccr, completionID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(NewAPIError(fmt.Sprintf("No chat completion found with id '%s'.", completionID), InvalidRequestErrorType).Error()))
+			return
+		}
+
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(NewAPIError(fmt.Sprintf("Failed to get chat completion: %v", err), InternalErrorType).Error()))
+		return
+	}
+
+	resp := new(db.CreateChatCompletionResponse)
+	if err := gormDB.Model(resp).Where("request_id = ?", completionID).First(resp).Error; errors.Is(err, gorm.ErrRecordNotFound) {
+		resp = nil
+	} else if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(NewAPIError(fmt.Sprintf("Failed to get chat completion response: %v", err), InternalErrorType).Error()))
+		return
+	}
+
+	writeObjectToResponse(w, asyncChatCompletion(ccr, resp))
+}
+
+// asyncChatCompletion returns the public object for a chat completion request that is polled for its result. The status
+// is the one stored with the request, and the response is included once the request is completed or failed.
+func asyncChatCompletion(ccr *db.CreateChatCompletionRequest, resp *db.CreateChatCompletionResponse) *openai.XAsyncChatCompletionObject {
+	obj := &openai.XAsyncChatCompletionObject{
+		CreatedAt: ccr.CreatedAt,
+		Id:        ccr.ID,
+		Object:    openai.ChatCompletionAsync,
+		Status:    openai.XAsyncChatCompletionObjectStatus(ccr.Status),
+	}
+
+	if resp == nil {
+		return obj
+	}
+
+	switch obj.Status {
+	case openai.Failed:
+		obj.Error = z.Pointer(resp.GetErrorString())
+	case openai.Completed:
+		obj.Completion = resp.ToPublic().(*openai.CreateChatCompletionResponse)
+	}
+
+	return obj
+}
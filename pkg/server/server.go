@@ -54,12 +54,30 @@ func (t *Triggers) Complete() {
 type Config struct {
 	ServerURL, Port, APIBase string
 	Triggers                 *Triggers
+	// StreamKeepAliveInterval is how long a stream can go without sending an event before a keepalive comment is sent,
+	// so that proxies don't close the idle connection. If zero, then keepalive comments are not sent.
+	StreamKeepAliveInterval time.Duration
+	// StreamFlushInterval and StreamFlushSize control the buffering of streamed events. If StreamFlushInterval is zero,
+	// then each event is flushed to the client as soon as it is written. Otherwise, events are buffered until the
+	// interval has passed since the last flush, or at least StreamFlushSize bytes are buffered, to reduce the overhead
+	// of writing many small events.
+	StreamFlushInterval time.Duration
+	StreamFlushSize     int
+	// ChatCompletionOmitFields are the fields that are removed from the chat completion responses returned to clients,
+	// like "system_fingerprint" or "choices.logprobs". The stored responses keep all fields. Requests can still include
+	// the choices.logprobs, system_fingerprint and usage fields with the include parameter. No fields are omitted by
+	// default, so including system_fingerprint or usage only changes the response if they are omitted here. Including
+	// choices.logprobs also requests them from the model, so it adds them to the response either way.
+	ChatCompletionOmitFields []string
 }
 
 type Server struct {
-	db       *db.DB
-	kbm      *kb.KnowledgeBaseManager
-	triggers *Triggers
+	db           *db.DB
+	kbm          *kb.KnowledgeBaseManager
+	triggers     *Triggers
+	streamConfig streamConfig
+
+	chatCompletionOmitFields []string
 }
 
 func NewServer(db *db.DB, kbm *kb.KnowledgeBaseManager) *Server {
@@ -73,6 +91,12 @@ func (s *Server) Start(ctx context.Context, wg *sync.WaitGroup, config Config) e
 	// Setup triggers
 	config.Triggers.Complete()
 	s.triggers = config.Triggers
+	s.streamConfig = streamConfig{
+		keepAlive:     config.StreamKeepAliveInterval,
+		flushInterval: config.StreamFlushInterval,
+		flushSize:     config.StreamFlushSize,
+	}
+	s.chatCompletionOmitFields = config.ChatCompletionOmitFields
 
 	// Treat image/png as files during decoding.
 	// This is required to pass body validation for image and mask fields for the following endpoints:
@@ -0,0 +1,147 @@
+package server
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// streamConfig determines how responses are streamed to clients as server-sent events.
+type streamConfig struct {
+	// keepAlive is how long a stream can go without an event before a keepalive comment is sent. If zero, then keepalive
+	// comments are not sent.
+	keepAlive time.Duration
+	// flushInterval and flushSize determine how events are buffered. If flushInterval is zero, then each event is
+	// flushed as soon as it is written. Otherwise, events are buffered until flushInterval has passed since the last
+	// flush, or at least flushSize bytes are buffered.
+	flushInterval time.Duration
+	flushSize     int
+	// gzip compresses the stream. It is set for each request by negotiate.
+	gzip bool
+}
+
+// negotiate returns the stream config for the request, which compresses the stream if the client accepts gzip.
+func (c streamConfig) negotiate(r *http.Request) streamConfig {
+	c.gzip = acceptsGzip(r.Header.Values("Accept-Encoding"))
+	return c
+}
+
+// acceptsGzip returns true if the Accept-Encoding header values include gzip with a non-zero quality.
+func acceptsGzip(values []string) bool {
+	for _, value := range values {
+		for _, encoding := range strings.Split(value, ",") {
+			name, params, _ := strings.Cut(encoding, ";")
+			if name = strings.TrimSpace(name); name != "gzip" && name != "*" {
+				continue
+			}
+
+			q, ok := strings.CutPrefix(strings.TrimSpace(params), "q=")
+			if !ok {
+				return true
+			}
+			if quality, err := strconv.ParseFloat(q, 64); err == nil && quality > 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// streamWriter writes server-sent events to a client, flushing them according to the stream config. If the stream is
+// compressed, then each flush also flushes the compressed data, so that the client can decompress every event that has
+// been flushed without waiting for the rest of the stream.
+type streamWriter struct {
+	w   http.ResponseWriter
+	gz  *gzip.Writer
+	out io.Writer
+	cfg streamConfig
+	now func() time.Time
+
+	buffered            int
+	lastFlush, lastSent time.Time
+}
+
+func newStreamWriter(w http.ResponseWriter, cfg streamConfig) *streamWriter {
+	now := time.Now()
+	s := &streamWriter{
+		w:         w,
+		out:       w,
+		cfg:       cfg,
+		now:       time.Now,
+		lastFlush: now,
+		lastSent:  now,
+	}
+	if cfg.gzip {
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Header().Add("Vary", "Accept-Encoding")
+		w.Header().Del("Content-Length")
+		s.gz = gzip.NewWriter(w)
+		s.out = s.gz
+	}
+
+	return s
+}
+
+// write writes an event, flushing it if it shouldn't be buffered.
+func (s *streamWriter) write(event []byte) {
+	_, _ = s.out.Write(event)
+	s.buffered += len(event)
+	s.lastSent = s.now()
+
+	if s.cfg.flushInterval <= 0 || s.buffered >= s.cfg.flushSize || s.now().Sub(s.lastFlush) >= s.cfg.flushInterval {
+		s.flush()
+	}
+}
+
+// writeError writes an error event and flushes it, along with any buffered events, since it is the last event of the
+// stream.
+func (s *streamWriter) writeError(err *APIError) {
+	s.write([]byte("data: " + err.Error() + "\n\n"))
+	s.flush()
+}
+
+// idle is called when there is no event to write. Any buffered events are flushed, since there is nothing to coalesce
+// them with, and a keepalive comment is sent if the stream has been idle for too long.
+func (s *streamWriter) idle() {
+	if s.cfg.keepAlive > 0 && s.now().Sub(s.lastSent) >= s.cfg.keepAlive {
+		keepAlive := []byte(": keepalive\n\n")
+		_, _ = s.out.Write(keepAlive)
+		s.buffered += len(keepAlive)
+		s.lastSent = s.now()
+	}
+
+	s.flush()
+}
+
+// flush flushes the buffered events to the client.
+func (s *streamWriter) flush() {
+	if s.buffered == 0 {
+		return
+	}
+
+	if s.gz != nil {
+		_ = s.gz.Flush()
+	}
+	if f, ok := s.w.(http.Flusher); ok {
+		f.Flush()
+	}
+	s.buffered = 0
+	s.lastFlush = s.now()
+}
+
+// close flushes any buffered events and, if the stream is compressed, ends the compressed stream.
+func (s *streamWriter) close() {
+	s.flush()
+	if s.gz == nil {
+		return
+	}
+
+	_ = s.gz.Close()
+	if f, ok := s.w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
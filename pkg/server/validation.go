@@ -2,11 +2,40 @@ package server
 
 import (
 	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 
+	"github.com/acorn-io/z"
+	"github.com/gptscript-ai/clicky-chats/pkg/db"
+	"github.com/gptscript-ai/clicky-chats/pkg/generated/openai"
 	"github.com/gptscript-ai/clicky-chats/pkg/tools"
 )
 
+// maxUserLength is the maximum length of the end-user identifier that can be sent with a request.
+const maxUserLength = 256
+
+// maxNameLength is the maximum length of the names of messages, tools, and json_schema response formats.
+const maxNameLength = 64
+
+// namePattern matches the valid names of messages, tools, and json_schema response formats.
+var namePattern = regexp.MustCompile("^[a-zA-Z0-9_-]+$")
+
+// modelMaxOutputTokens are the maximum number of tokens that known models can generate for a chat completion.
+var modelMaxOutputTokens = map[string]int{
+	"gpt-3.5-turbo":       4096,
+	"gpt-3.5-turbo-16k":   4096,
+	"gpt-4":               8192,
+	"gpt-4-32k":           8192,
+	"gpt-4-turbo":         4096,
+	"gpt-4-turbo-preview": 4096,
+	"gpt-4o":              16384,
+	"gpt-4o-mini":         16384,
+}
+
 // validateToolFunctionName returns an error if the given function isn't valid.
 func validateToolFunctionName(name string) error {
 	if strings.HasPrefix(name, tools.GPTScriptToolNamePrefix) {
@@ -16,6 +45,325 @@ func validateToolFunctionName(name string) error {
 	return nil
 }
 
+// validateName returns an error if the name of the given field doesn't match namePattern or is longer than maxNameLength.
+// The model provider rejects these names, so they are rejected before the request is sent.
+func validateName(field, name string) error {
+	if len(name) > maxNameLength || !namePattern.MatchString(name) {
+		return NewAPIError(fmt.Sprintf("Invalid %s %q, it must match the pattern %q with a maximum length of %d.", field, name, namePattern, maxNameLength), InvalidRequestErrorType)
+	}
+
+	return nil
+}
+
+// validateChatCompletionMessageNames returns an error if a message of a chat completion request has an invalid name.
+// These are the participant names of system, user, and assistant messages, and the function names of function messages.
+func validateChatCompletionMessageNames(messages []openai.ChatCompletionRequestMessage) error {
+	for i, message := range messages {
+		var name *string
+		if system, err := message.AsChatCompletionRequestSystemMessage(); err == nil && system.Role == openai.ChatCompletionRequestSystemMessageRoleSystem {
+			name = system.Name
+		} else if user, err := message.AsChatCompletionRequestUserMessage(); err == nil && user.Role == openai.ChatCompletionRequestUserMessageRoleUser {
+			name = user.Name
+		} else if assistant, err := message.AsChatCompletionRequestAssistantMessage(); err == nil && assistant.Role == openai.ChatCompletionRequestAssistantMessageRoleAssistant {
+			name = assistant.Name
+		} else if function, err := message.AsChatCompletionRequestFunctionMessage(); err == nil && function.Role == openai.ChatCompletionRequestFunctionMessageRoleFunction {
+			name = &function.Name
+		}
+
+		if name == nil {
+			continue
+		}
+		if err := validateName(fmt.Sprintf("messages[%d].name", i), *name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateChatCompletionTools returns an error if the tools for a chat completion request are not valid.
+// The behavior of the model is undefined if two tools share the same function name, so these are rejected. The
+// function names must be valid names, and the parameters of strict functions must also be valid strict schemas.
+func validateChatCompletionTools(chatCompletionTools []openai.ChatCompletionTool) error {
+	names := make(map[string]struct{}, len(chatCompletionTools))
+	for i, tool := range chatCompletionTools {
+		if err := validateName(fmt.Sprintf("tools[%d].function.name", i), tool.Function.Name); err != nil {
+			return err
+		}
+		if _, ok := names[tool.Function.Name]; ok {
+			return NewAPIError(fmt.Sprintf("Duplicate tool name %q, tool names must be unique.", tool.Function.Name), InvalidRequestErrorType)
+		}
+		names[tool.Function.Name] = struct{}{}
+
+		if z.Dereference(tool.Function.Strict) {
+			if err := validateStrictSchema("parameters", z.Dereference(tool.Function.Parameters)); err != nil {
+				return NewAPIError(fmt.Sprintf("Invalid schema for strict function %q: %v.", tool.Function.Name, err), InvalidRequestErrorType)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateChatCompletionToolChoice returns an error if the tool choice requires tools that the request doesn't have.
+// Only none can be chosen without tools, and a named tool choice must name one of the tools.
+func validateChatCompletionToolChoice(toolChoice *openai.ChatCompletionToolChoiceOption, tools []openai.ChatCompletionTool) error {
+	if toolChoice == nil {
+		return nil
+	}
+
+	if choice, err := toolChoice.AsChatCompletionToolChoiceOption0(); err == nil {
+		if choice != openai.ChatCompletionToolChoiceOption0None && len(tools) == 0 {
+			return NewAPIError(fmt.Sprintf("Invalid value for 'tool_choice': 'tool_choice' is only allowed when 'tools' are specified, has %q.", choice), InvalidRequestErrorType)
+		}
+		return nil
+	}
+
+	named, err := toolChoice.AsChatCompletionNamedToolChoice()
+	if err != nil {
+		return NewAPIError(fmt.Sprintf("Invalid value for 'tool_choice': %v.", err), InvalidRequestErrorType)
+	}
+	if len(tools) == 0 {
+		return NewAPIError("Invalid value for 'tool_choice': 'tool_choice' is only allowed when 'tools' are specified.", InvalidRequestErrorType)
+	}
+	for _, tool := range tools {
+		if tool.Function.Name == named.Function.Name {
+			return nil
+		}
+	}
+
+	return NewAPIError(fmt.Sprintf("Invalid value for 'tool_choice': no tool named %q is specified in 'tools'.", named.Function.Name), InvalidRequestErrorType)
+}
+
+// validateStrictSchema returns an error if the JSON schema doesn't meet the constraints for strict function calling:
+// every object must set additionalProperties to false and require all of its properties. Nested schemas are checked
+// recursively and path is used to identify the offending schema in the error.
+func validateStrictSchema(path string, schema map[string]any) error {
+	if schema == nil {
+		return nil
+	}
+
+	properties, _ := schema["properties"].(map[string]any)
+	if schema["type"] == "object" || properties != nil {
+		if additionalProperties, ok := schema["additionalProperties"].(bool); !ok || additionalProperties {
+			return fmt.Errorf("%s must set additionalProperties to false", path)
+		}
+
+		required := make(map[string]struct{}, len(properties))
+		if r, ok := schema["required"].([]any); ok {
+			for _, name := range r {
+				if n, ok := name.(string); ok {
+					required[n] = struct{}{}
+				}
+			}
+		}
+
+		for _, name := range sortedKeys(properties) {
+			if _, ok := required[name]; !ok {
+				return fmt.Errorf("%s must list property %q as required", path, name)
+			}
+		}
+	}
+
+	for _, name := range sortedKeys(properties) {
+		if p, ok := properties[name].(map[string]any); ok {
+			if err := validateStrictSchema(path+".properties."+name, p); err != nil {
+				return err
+			}
+		}
+	}
+
+	if items, ok := schema["items"].(map[string]any); ok {
+		if err := validateStrictSchema(path+".items", items); err != nil {
+			return err
+		}
+	}
+
+	for _, keyword := range []string{"anyOf", "allOf"} {
+		subschemas, _ := schema[keyword].([]any)
+		for i, subschema := range subschemas {
+			if s, ok := subschema.(map[string]any); ok {
+				if err := validateStrictSchema(fmt.Sprintf("%s.%s[%d]", path, keyword, i), s); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	for _, keyword := range []string{"$defs", "definitions"} {
+		definitions, _ := schema[keyword].(map[string]any)
+		for _, name := range sortedKeys(definitions) {
+			if d, ok := definitions[name].(map[string]any); ok {
+				if err := validateStrictSchema(path+"."+keyword+"."+name, d); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// PriorityHeader is the header used to set the priority of a chat completion request. Requests with a higher priority,
+// such as interactive ones, are sent to the model provider before queued requests with a lower priority, such as batch
+// ones. The default priority is 0.
+const PriorityHeader = "X-Clicky-Chats-Priority"
+
+// requestPriority returns the priority set in the request's PriorityHeader, or an error if it isn't an integer.
+func requestPriority(r *http.Request) (int, error) {
+	value := r.Header.Get(PriorityHeader)
+	if value == "" {
+		return 0, nil
+	}
+
+	priority, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, NewAPIError(fmt.Sprintf("Invalid %s header %q, must be an integer.", PriorityHeader, value), InvalidRequestErrorType)
+	}
+
+	return priority, nil
+}
+
+// validateChatCompletionPrediction returns an error if the predicted output for a chat completion request is not valid.
+// The content of a prediction must be a string or a non-empty list of text content parts.
+func validateChatCompletionPrediction(prediction *db.ChatCompletionPrediction) error {
+	if prediction == nil {
+		return nil
+	}
+
+	if prediction.Type != openai.Content {
+		return NewAPIError(fmt.Sprintf("Invalid prediction type %q, only %q is supported.", prediction.Type, openai.Content), InvalidRequestErrorType)
+	}
+
+	if _, err := prediction.Content.AsCreateChatCompletionRequestPredictionContent0(); err == nil {
+		return nil
+	}
+
+	parts, err := prediction.Content.AsCreateChatCompletionRequestPredictionContent1()
+	if err != nil || len(parts) == 0 {
+		return NewAPIError("Invalid prediction content, must be a string or a non-empty array of text content parts.", InvalidRequestErrorType)
+	}
+
+	for _, part := range parts {
+		if part.Type != openai.ChatCompletionRequestMessageContentPartTextTypeText {
+			return NewAPIError(fmt.Sprintf("Invalid prediction content part type %q, only %q is supported.", part.Type, openai.ChatCompletionRequestMessageContentPartTextTypeText), InvalidRequestErrorType)
+		}
+	}
+
+	return nil
+}
+
+// validateChatCompletionAudio returns an error if the audio parameters of a chat completion request don't match the
+// requested modalities. Audio output must be configured if, and only if, it is requested, and streamed audio must use the
+// pcm16 format.
+func validateChatCompletionAudio(modalities []openai.CreateChatCompletionRequestModalities, audio *db.ChatCompletionAudio, stream bool) error {
+	if !slices.Contains(modalities, openai.CreateChatCompletionRequestModalitiesAudio) {
+		if audio != nil {
+			return NewAPIError(fmt.Sprintf("Invalid audio parameter, audio output must be requested with the %q modality.", openai.CreateChatCompletionRequestModalitiesAudio), InvalidRequestErrorType)
+		}
+		return nil
+	}
+
+	if audio == nil {
+		return NewAPIError(fmt.Sprintf("Missing audio parameter, it is required when the %q modality is requested.", openai.CreateChatCompletionRequestModalitiesAudio), InvalidRequestErrorType)
+	}
+	if audio.Voice == "" || audio.Format == "" {
+		return NewAPIError("Invalid audio parameter, voice and format are required.", InvalidRequestErrorType)
+	}
+	if stream && audio.Format != openai.CreateChatCompletionRequestAudioFormatPcm16 {
+		return NewAPIError(fmt.Sprintf("Invalid audio format %q, only %q is supported when streaming.", audio.Format, openai.CreateChatCompletionRequestAudioFormatPcm16), InvalidRequestErrorType)
+	}
+
+	return nil
+}
+
+// validateChatCompletionResponseFormat returns an error if the response format of a chat completion request is not
+// valid. A json_schema must be given if, and only if, the type is json_schema, and its name must only contain
+// alphanumeric characters, underscores, and dashes. The schema of a strict response format must be a valid strict schema.
+func validateChatCompletionResponseFormat(responseFormatType *string, jsonSchema *db.ChatCompletionResponseFormatJSONSchema) error {
+	if z.Dereference(responseFormatType) != string(openai.CreateChatCompletionRequestResponseFormatTypeJsonSchema) {
+		if jsonSchema != nil {
+			return NewAPIError(fmt.Sprintf("Invalid response_format parameter, json_schema can only be set when the type is %q.", openai.CreateChatCompletionRequestResponseFormatTypeJsonSchema), InvalidRequestErrorType)
+		}
+		return nil
+	}
+
+	if jsonSchema == nil {
+		return NewAPIError(fmt.Sprintf("Missing response_format.json_schema parameter, it is required when the type is %q.", openai.CreateChatCompletionRequestResponseFormatTypeJsonSchema), InvalidRequestErrorType)
+	}
+	if err := validateName("response_format.json_schema.name", jsonSchema.Name); err != nil {
+		return err
+	}
+	if z.Dereference(jsonSchema.Strict) {
+		if err := validateStrictSchema("schema", z.Dereference(jsonSchema.Schema)); err != nil {
+			return NewAPIError(fmt.Sprintf("Invalid schema for strict response format %q: %v.", jsonSchema.Name, err), InvalidRequestErrorType)
+		}
+	}
+
+	return nil
+}
+
+// validateChatCompletionInclude returns an error if a chat completion request asks to include an unknown response section.
+func validateChatCompletionInclude(include []openai.CreateChatCompletionRequestInclude) error {
+	for _, section := range include {
+		switch section {
+		case openai.ChoicesLogprobs, openai.SystemFingerprint, openai.Usage:
+		default:
+			return NewAPIError(fmt.Sprintf("Invalid include value %q, must be one of %q, %q, or %q.", section, openai.ChoicesLogprobs, openai.SystemFingerprint, openai.Usage), InvalidRequestErrorType)
+		}
+	}
+
+	return nil
+}
+
+// validateMaxTokens returns an error if the maximum number of tokens to generate for a chat completion is set and isn't
+// positive, or is more than the model can generate.
+func validateMaxTokens(model string, maxTokens *int) error {
+	if maxTokens == nil {
+		return nil
+	}
+
+	if *maxTokens <= 0 {
+		return NewAPIError(fmt.Sprintf("max_tokens should be greater than 0, has %d", *maxTokens), InvalidRequestErrorType)
+	}
+
+	if maxOutputTokens, ok := modelMaxOutputTokens[model]; ok && *maxTokens > maxOutputTokens {
+		return NewAPIError(fmt.Sprintf("max_tokens is too large: %d. Model %s supports at most %d completion tokens", *maxTokens, model, maxOutputTokens), InvalidRequestErrorType)
+	}
+
+	return nil
+}
+
+// validateMaxToolIterations returns an error if the maximum number of tool call round trips for a run is set and isn't
+// positive.
+func validateMaxToolIterations(maxToolIterations *int) error {
+	if maxToolIterations != nil && *maxToolIterations <= 0 {
+		return NewAPIError(fmt.Sprintf("max_tool_iterations should be greater than 0, has %d", *maxToolIterations), InvalidRequestErrorType)
+	}
+
+	return nil
+}
+
+// sortedKeys returns the keys of the map in sorted order, so that validation errors are deterministic.
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// validateUser returns an error if the end-user identifier sent with a request is too long.
+func validateUser(user *string) error {
+	if l := len(z.Dereference(user)); l > maxUserLength {
+		return NewAPIError(fmt.Sprintf("user length should be less than or equal to %d, has %d", maxUserLength, l), InvalidRequestErrorType)
+	}
+
+	return nil
+}
+
 // validateMetadata checks if the metadata is valid, according to the OpenAI API specification.
 // From the OpenAI documentation:
 // Set of 16 key-value pairs that can be attached to an object.
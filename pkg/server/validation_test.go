@@ -0,0 +1,498 @@
+package server
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/acorn-io/z"
+	"github.com/gptscript-ai/clicky-chats/pkg/db"
+	"github.com/gptscript-ai/clicky-chats/pkg/generated/openai"
+)
+
+func strictTool(parameters string) openai.ChatCompletionTool {
+	var fp openai.FunctionParameters
+	if err := json.Unmarshal([]byte(parameters), &fp); err != nil {
+		panic(err)
+	}
+
+	return openai.ChatCompletionTool{
+		Type: openai.ChatCompletionToolTypeFunction,
+		Function: openai.FunctionObject{
+			Name:       "search",
+			Parameters: &fp,
+			Strict:     z.Pointer(true),
+		},
+	}
+}
+
+func TestValidateChatCompletionTools(t *testing.T) {
+	type testCase struct {
+		name    string
+		tools   []openai.ChatCompletionTool
+		wantErr bool
+	}
+	tests := []testCase{
+		{
+			name:  "No tools",
+			tools: nil,
+		},
+		{
+			name: "Unique tool names",
+			tools: []openai.ChatCompletionTool{
+				{Type: openai.ChatCompletionToolTypeFunction, Function: openai.FunctionObject{Name: "search"}},
+				{Type: openai.ChatCompletionToolTypeFunction, Function: openai.FunctionObject{Name: "browse"}},
+			},
+		},
+		{
+			name: "Strict tool with valid schema",
+			tools: []openai.ChatCompletionTool{
+				strictTool(`{"type": "object", "properties": {"query": {"type": "string"}, "filters": {"type": "array", "items": {"type": "object", "properties": {"field": {"type": "string"}}, "required": ["field"], "additionalProperties": false}}}, "required": ["query", "filters"], "additionalProperties": false}`),
+			},
+		},
+		{
+			name: "Strict tool without additionalProperties false",
+			tools: []openai.ChatCompletionTool{
+				strictTool(`{"type": "object", "properties": {"query": {"type": "string"}}, "required": ["query"]}`),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Strict tool with optional property",
+			tools: []openai.ChatCompletionTool{
+				strictTool(`{"type": "object", "properties": {"query": {"type": "string"}, "limit": {"type": "integer"}}, "required": ["query"], "additionalProperties": false}`),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Strict tool with invalid nested schema",
+			tools: []openai.ChatCompletionTool{
+				strictTool(`{"type": "object", "properties": {"filters": {"type": "array", "items": {"type": "object", "properties": {"field": {"type": "string"}}, "required": ["field"]}}}, "required": ["filters"], "additionalProperties": false}`),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Non-strict tool without additionalProperties false",
+			tools: []openai.ChatCompletionTool{
+				{Type: openai.ChatCompletionToolTypeFunction, Function: openai.FunctionObject{Name: "search", Parameters: &openai.FunctionParameters{"type": "object", "properties": map[string]any{"query": map[string]any{"type": "string"}}}}},
+			},
+		},
+		{
+			name: "Tool name with spaces",
+			tools: []openai.ChatCompletionTool{
+				{Type: openai.ChatCompletionToolTypeFunction, Function: openai.FunctionObject{Name: "web search"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Tool name over 64 characters",
+			tools: []openai.ChatCompletionTool{
+				{Type: openai.ChatCompletionToolTypeFunction, Function: openai.FunctionObject{Name: strings.Repeat("a", maxNameLength+1)}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Duplicate tool names",
+			tools: []openai.ChatCompletionTool{
+				{Type: openai.ChatCompletionToolTypeFunction, Function: openai.FunctionObject{Name: "search"}},
+				{Type: openai.ChatCompletionToolTypeFunction, Function: openai.FunctionObject{Name: "search"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateChatCompletionTools(tt.tools); (err != nil) != tt.wantErr {
+				t.Errorf("validateChatCompletionTools() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateChatCompletionMessageNames(t *testing.T) {
+	type testCase struct {
+		name     string
+		messages string
+		wantErr  bool
+	}
+	tests := []testCase{
+		{
+			name:     "No names",
+			messages: `[{"role": "system", "content": "Be brief."}, {"role": "user", "content": "Hi"}, {"role": "tool", "tool_call_id": "call 1", "content": "done"}]`,
+		},
+		{
+			name:     "Valid names",
+			messages: `[{"role": "user", "name": "alice_1", "content": "Hi"}, {"role": "assistant", "name": "helper-bot", "content": "Hello"}]`,
+		},
+		{
+			name:     "User name with spaces",
+			messages: `[{"role": "user", "name": "alice smith", "content": "Hi"}]`,
+			wantErr:  true,
+		},
+		{
+			name:     "System name over 64 characters",
+			messages: `[{"role": "system", "name": "` + strings.Repeat("a", maxNameLength+1) + `", "content": "Be brief."}]`,
+			wantErr:  true,
+		},
+		{
+			name:     "Function name with spaces",
+			messages: `[{"role": "function", "name": "get weather", "content": "sunny"}]`,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var messages []openai.ChatCompletionRequestMessage
+			if err := json.Unmarshal([]byte(tt.messages), &messages); err != nil {
+				t.Fatalf("failed to unmarshal messages: %v", err)
+			}
+
+			if err := validateChatCompletionMessageNames(messages); (err != nil) != tt.wantErr {
+				t.Errorf("validateChatCompletionMessageNames() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateChatCompletionToolChoice(t *testing.T) {
+	tools := []openai.ChatCompletionTool{{Type: openai.ChatCompletionToolTypeFunction, Function: openai.FunctionObject{Name: "search"}}}
+
+	type testCase struct {
+		name       string
+		toolChoice string
+		tools      []openai.ChatCompletionTool
+		wantErr    bool
+	}
+	tests := []testCase{
+		{
+			name: "No tool choice",
+		},
+		{
+			name:       "None without tools",
+			toolChoice: `"none"`,
+		},
+		{
+			name:       "None with tools",
+			toolChoice: `"none"`,
+			tools:      tools,
+		},
+		{
+			name:       "Auto with tools",
+			toolChoice: `"auto"`,
+			tools:      tools,
+		},
+		{
+			name:       "Required with tools",
+			toolChoice: `"required"`,
+			tools:      tools,
+		},
+		{
+			name:       "Named tool",
+			toolChoice: `{"type": "function", "function": {"name": "search"}}`,
+			tools:      tools,
+		},
+		{
+			name:       "Auto without tools",
+			toolChoice: `"auto"`,
+			wantErr:    true,
+		},
+		{
+			name:       "Required without tools",
+			toolChoice: `"required"`,
+			wantErr:    true,
+		},
+		{
+			name:       "Named tool without tools",
+			toolChoice: `{"type": "function", "function": {"name": "search"}}`,
+			wantErr:    true,
+		},
+		{
+			name:       "Named tool that is not specified",
+			toolChoice: `{"type": "function", "function": {"name": "browse"}}`,
+			tools:      tools,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var toolChoice *openai.ChatCompletionToolChoiceOption
+			if tt.toolChoice != "" {
+				toolChoice = new(openai.ChatCompletionToolChoiceOption)
+				if err := toolChoice.UnmarshalJSON([]byte(tt.toolChoice)); err != nil {
+					t.Fatalf("failed to unmarshal tool choice: %v", err)
+				}
+			}
+
+			if err := validateChatCompletionToolChoice(toolChoice, tt.tools); (err != nil) != tt.wantErr {
+				t.Errorf("validateChatCompletionToolChoice() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateUser(t *testing.T) {
+	type testCase struct {
+		name    string
+		user    *string
+		wantErr bool
+	}
+	tests := []testCase{
+		{
+			name: "No user",
+			user: nil,
+		},
+		{
+			name: "Valid user",
+			user: z.Pointer("user-1234"),
+		},
+		{
+			name: "Maximum length user",
+			user: z.Pointer(strings.Repeat("a", maxUserLength)),
+		},
+		{
+			name:    "User too long",
+			user:    z.Pointer(strings.Repeat("a", maxUserLength+1)),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateUser(tt.user); (err != nil) != tt.wantErr {
+				t.Errorf("validateUser() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateMaxTokens(t *testing.T) {
+	type testCase struct {
+		name      string
+		model     string
+		maxTokens *int
+		wantErr   bool
+	}
+	tests := []testCase{
+		{
+			name:  "Unset max tokens",
+			model: "gpt-4",
+		},
+		{
+			name:      "Valid max tokens",
+			model:     "gpt-4",
+			maxTokens: z.Pointer(1024),
+		},
+		{
+			name:      "Max tokens for unknown model is not capped",
+			model:     "my-model",
+			maxTokens: z.Pointer(1 << 20),
+		},
+		{
+			name:      "Zero max tokens",
+			model:     "gpt-4",
+			maxTokens: z.Pointer(0),
+			wantErr:   true,
+		},
+		{
+			name:      "Negative max tokens",
+			model:     "gpt-4",
+			maxTokens: z.Pointer(-1),
+			wantErr:   true,
+		},
+		{
+			name:      "Max tokens above the model's output cap",
+			model:     "gpt-3.5-turbo",
+			maxTokens: z.Pointer(modelMaxOutputTokens["gpt-3.5-turbo"] + 1),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateMaxTokens(tt.model, tt.maxTokens); (err != nil) != tt.wantErr {
+				t.Errorf("validateMaxTokens() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateChatCompletionPrediction(t *testing.T) {
+	type testCase struct {
+		name       string
+		prediction string
+		wantErr    bool
+	}
+	tests := []testCase{
+		{
+			name:       "No prediction",
+			prediction: `null`,
+		},
+		{
+			name:       "String content",
+			prediction: `{"type": "content", "content": "func main() {}"}`,
+		},
+		{
+			name:       "Text content parts",
+			prediction: `{"type": "content", "content": [{"type": "text", "text": "func main() {}"}]}`,
+		},
+		{
+			name:       "Invalid type",
+			prediction: `{"type": "file", "content": "func main() {}"}`,
+			wantErr:    true,
+		},
+		{
+			name:       "Empty content parts",
+			prediction: `{"type": "content", "content": []}`,
+			wantErr:    true,
+		},
+		{
+			name:       "Non-text content part",
+			prediction: `{"type": "content", "content": [{"type": "image_url", "image_url": {"url": "https://example.com"}}]}`,
+			wantErr:    true,
+		},
+		{
+			name:       "Invalid content",
+			prediction: `{"type": "content", "content": 42}`,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var prediction *db.ChatCompletionPrediction
+			if err := json.Unmarshal([]byte(tt.prediction), &prediction); err != nil {
+				t.Fatalf("failed to unmarshal prediction: %v", err)
+			}
+
+			if err := validateChatCompletionPrediction(prediction); (err != nil) != tt.wantErr {
+				t.Errorf("validateChatCompletionPrediction() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateChatCompletionAudio(t *testing.T) {
+	type testCase struct {
+		name       string
+		modalities []openai.CreateChatCompletionRequestModalities
+		audio      *db.ChatCompletionAudio
+		stream     bool
+		wantErr    bool
+	}
+	tests := []testCase{
+		{
+			name: "Text only",
+		},
+		{
+			name:       "Audio output",
+			modalities: []openai.CreateChatCompletionRequestModalities{openai.CreateChatCompletionRequestModalitiesText, openai.CreateChatCompletionRequestModalitiesAudio},
+			audio:      &db.ChatCompletionAudio{Voice: openai.CreateChatCompletionRequestAudioVoiceAlloy, Format: openai.CreateChatCompletionRequestAudioFormatWav},
+		},
+		{
+			name:       "Streamed audio output",
+			modalities: []openai.CreateChatCompletionRequestModalities{openai.CreateChatCompletionRequestModalitiesText, openai.CreateChatCompletionRequestModalitiesAudio},
+			audio:      &db.ChatCompletionAudio{Voice: openai.CreateChatCompletionRequestAudioVoiceAlloy, Format: openai.CreateChatCompletionRequestAudioFormatPcm16},
+			stream:     true,
+		},
+		{
+			name:       "Audio output without audio parameters",
+			modalities: []openai.CreateChatCompletionRequestModalities{openai.CreateChatCompletionRequestModalitiesText, openai.CreateChatCompletionRequestModalitiesAudio},
+			wantErr:    true,
+		},
+		{
+			name:       "Audio parameters without audio output",
+			modalities: []openai.CreateChatCompletionRequestModalities{openai.CreateChatCompletionRequestModalitiesText},
+			audio:      &db.ChatCompletionAudio{Voice: openai.CreateChatCompletionRequestAudioVoiceAlloy, Format: openai.CreateChatCompletionRequestAudioFormatWav},
+			wantErr:    true,
+		},
+		{
+			name:       "Missing voice",
+			modalities: []openai.CreateChatCompletionRequestModalities{openai.CreateChatCompletionRequestModalitiesAudio},
+			audio:      &db.ChatCompletionAudio{Format: openai.CreateChatCompletionRequestAudioFormatWav},
+			wantErr:    true,
+		},
+		{
+			name:       "Streamed audio output that isn't pcm16",
+			modalities: []openai.CreateChatCompletionRequestModalities{openai.CreateChatCompletionRequestModalitiesText, openai.CreateChatCompletionRequestModalitiesAudio},
+			audio:      &db.ChatCompletionAudio{Voice: openai.CreateChatCompletionRequestAudioVoiceAlloy, Format: openai.CreateChatCompletionRequestAudioFormatMp3},
+			stream:     true,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateChatCompletionAudio(tt.modalities, tt.audio, tt.stream); (err != nil) != tt.wantErr {
+				t.Errorf("validateChatCompletionAudio() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateChatCompletionResponseFormat(t *testing.T) {
+	strictSchema := map[string]any{
+		"type":                 "object",
+		"properties":           map[string]any{"greeting": map[string]any{"type": "string"}},
+		"required":             []any{"greeting"},
+		"additionalProperties": false,
+	}
+
+	type testCase struct {
+		name               string
+		responseFormatType *string
+		jsonSchema         *db.ChatCompletionResponseFormatJSONSchema
+		wantErr            bool
+	}
+	tests := []testCase{
+		{
+			name: "No response format",
+		},
+		{
+			name:               "JSON object",
+			responseFormatType: z.Pointer("json_object"),
+		},
+		{
+			name:               "JSON schema",
+			responseFormatType: z.Pointer("json_schema"),
+			jsonSchema:         &db.ChatCompletionResponseFormatJSONSchema{Name: "greeting_v-1", Schema: &strictSchema, Strict: z.Pointer(true)},
+		},
+		{
+			name:               "JSON schema without schema",
+			responseFormatType: z.Pointer("json_schema"),
+			wantErr:            true,
+		},
+		{
+			name:               "JSON schema with another type",
+			responseFormatType: z.Pointer("json_object"),
+			jsonSchema:         &db.ChatCompletionResponseFormatJSONSchema{Name: "greeting"},
+			wantErr:            true,
+		},
+		{
+			name:               "Invalid name",
+			responseFormatType: z.Pointer("json_schema"),
+			jsonSchema:         &db.ChatCompletionResponseFormatJSONSchema{Name: "a greeting"},
+			wantErr:            true,
+		},
+		{
+			name:               "Empty name",
+			responseFormatType: z.Pointer("json_schema"),
+			jsonSchema:         &db.ChatCompletionResponseFormatJSONSchema{},
+			wantErr:            true,
+		},
+		{
+			name:               "Name too long",
+			responseFormatType: z.Pointer("json_schema"),
+			jsonSchema:         &db.ChatCompletionResponseFormatJSONSchema{Name: strings.Repeat("a", maxNameLength+1)},
+			wantErr:            true,
+		},
+		{
+			name:               "Strict with invalid schema",
+			responseFormatType: z.Pointer("json_schema"),
+			jsonSchema:         &db.ChatCompletionResponseFormatJSONSchema{Name: "greeting", Schema: &map[string]any{"type": "object", "properties": map[string]any{"greeting": map[string]any{"type": "string"}}}, Strict: z.Pointer(true)},
+			wantErr:            true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateChatCompletionResponseFormat(tt.responseFormatType, tt.jsonSchema); (err != nil) != tt.wantErr {
+				t.Errorf("validateChatCompletionResponseFormat() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}